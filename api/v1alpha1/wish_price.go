@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package v1alpha1
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxCurrencyLength mirrors the MaxLength of Spec.Currency.
+const maxCurrencyLength = 8
+
+// currencyWords maps currency abbreviations written as words to the symbol
+// stored in Spec.Currency. Three-letter uppercase ISO 4217 codes are kept as
+// written and need no entry.
+var currencyWords = map[string]string{
+	"руб":  "₽",
+	"руб.": "₽",
+	"р":    "₽",
+	"р.":   "₽",
+}
+
+// isoCurrencyCode matches an ISO 4217 code such as USD or RUB.
+var isoCurrencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ParsedPrice is the structured form of an MSRP string.
+type ParsedPrice struct {
+	// Amount is the price rounded to whole currency units.
+	Amount int64
+
+	// Currency is the symbol or code found next to the amount, if any.
+	Currency string
+
+	// Approximate reports that Amount was rounded from a fractional price.
+	Approximate bool
+}
+
+// ParseMSRP extracts an amount and currency from a free-form MSRP string
+// such as "₽ 19900", "$19.99", "1 299,50 €" or "USD 1,299". The currency
+// may precede or follow the amount. Spaces (including the no-break and thin
+// spaces some locales use) and apostrophes always group thousands, while "."
+// and "," are told apart by position: with both present the last one is the
+// decimal mark, and a lone one followed by exactly three digits groups
+// thousands ("19.900"). It reports false for anything it cannot read with
+// confidence, e.g. ranges, prose, negative amounts or unknown currency
+// words, so callers can leave such strings as they are.
+func ParseMSRP(msrp string) (ParsedPrice, bool) {
+	text := strings.TrimSpace(msrp)
+
+	start := strings.IndexFunc(text, unicode.IsDigit)
+	if start < 0 {
+		return ParsedPrice{}, false
+	}
+
+	end := strings.LastIndexFunc(text, unicode.IsDigit)
+	_, width := utf8.DecodeRuneInString(text[end:])
+	end += width
+
+	prefix := strings.TrimFunc(text[:start], unicode.IsSpace)
+	suffix := strings.TrimFunc(text[end:], unicode.IsSpace)
+
+	if prefix != "" && suffix != "" {
+		return ParsedPrice{}, false
+	}
+
+	currency, ok := parseCurrency(prefix + suffix)
+	if !ok {
+		return ParsedPrice{}, false
+	}
+
+	whole, fraction, ok := splitAmount(text[start:end])
+	if !ok {
+		return ParsedPrice{}, false
+	}
+
+	amount, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return ParsedPrice{}, false
+	}
+
+	price := ParsedPrice{Amount: amount, Currency: currency}
+
+	if strings.Trim(fraction, "0") != "" {
+		price.Approximate = true
+
+		if fraction[0] >= '5' {
+			if price.Amount == math.MaxInt64 {
+				return ParsedPrice{}, false
+			}
+
+			price.Amount++
+		}
+	}
+
+	return price, true
+}
+
+// parseCurrency validates the text found next to the amount: nothing, a run
+// of currency symbols, an ISO 4217 code or a known currency word.
+func parseCurrency(text string) (string, bool) {
+	switch {
+	case text == "":
+		return "", true
+	case isoCurrencyCode.MatchString(text):
+		return text, true
+	}
+
+	if symbol, ok := currencyWords[strings.ToLower(text)]; ok {
+		return symbol, true
+	}
+
+	if utf8.RuneCountInString(text) > maxCurrencyLength {
+		return "", false
+	}
+
+	for _, r := range text {
+		if !unicode.Is(unicode.Sc, r) {
+			return "", false
+		}
+	}
+
+	return text, true
+}
+
+// splitAmount separates a number written with grouping and decimal marks
+// into its whole digits and fractional digits.
+func splitAmount(number string) (string, string, bool) {
+	var digits strings.Builder
+
+	decimal := decimalMark(number)
+	whole, fraction := number, ""
+
+	if decimal >= 0 {
+		whole, fraction = number[:decimal], number[decimal+1:]
+	}
+
+	for _, r := range whole {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case unicode.IsSpace(r) || r == '\'' || r == '’' || r == '.' || r == ',':
+			// Grouping mark
+		default:
+			return "", "", false
+		}
+	}
+
+	for _, r := range fraction {
+		if r < '0' || r > '9' {
+			return "", "", false
+		}
+	}
+
+	return digits.String(), fraction, true
+}
+
+// decimalMark returns the byte index of the decimal mark in number, or -1 if
+// all of its marks group thousands.
+func decimalMark(number string) int {
+	dot, comma := strings.LastIndexByte(number, '.'), strings.LastIndexByte(number, ',')
+
+	switch {
+	case dot >= 0 && comma >= 0:
+		return max(dot, comma)
+	case dot >= 0 && strings.Count(number, ".") == 1 && len(number)-dot-1 != 3:
+		return dot
+	case comma >= 0 && strings.Count(number, ",") == 1 && len(number)-comma-1 != 3:
+		return comma
+	default:
+		return -1
+	}
+}
+
+// DefaultPrice fills the structured price from MSRP when neither bound is
+// set and MSRP can be parsed, rounding to whole units and marking rounded
+// prices as approximate. Currency is only filled in when empty. Returns true
+// if the spec was modified.
+func (w *Wish) DefaultPrice() bool {
+	if w.Spec.PriceMin != nil || w.Spec.PriceMax != nil || w.Spec.MSRP == "" {
+		return false
+	}
+
+	price, ok := ParseMSRP(w.Spec.MSRP)
+	if !ok {
+		return false
+	}
+
+	w.Spec.PriceMin = &price.Amount
+	w.Spec.Approximate = w.Spec.Approximate || price.Approximate
+
+	if w.Spec.Currency == "" {
+		w.Spec.Currency = price.Currency
+	}
+
+	return true
+}
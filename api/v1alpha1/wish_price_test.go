@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMSRP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		msrp   string
+		want   ParsedPrice
+		wantOK bool
+	}{
+		{"symbol prefix with space", "₽ 19900", ParsedPrice{Amount: 19900, Currency: "₽"}, true},
+		{"symbol prefix", "$19", ParsedPrice{Amount: 19, Currency: "$"}, true},
+		{"cents round up", "$19.99", ParsedPrice{Amount: 20, Currency: "$", Approximate: true}, true},
+		{"cents round down", "$19.20", ParsedPrice{Amount: 19, Currency: "$", Approximate: true}, true},
+		{"zero cents are exact", "$19.00", ParsedPrice{Amount: 19, Currency: "$"}, true},
+		{"comma thousands", "$1,299", ParsedPrice{Amount: 1299, Currency: "$"}, true},
+		{"comma thousands and dot decimal", "$1,299.50", ParsedPrice{Amount: 1300, Currency: "$", Approximate: true}, true},
+		{"dot thousands and comma decimal", "1.299,49 €", ParsedPrice{Amount: 1299, Currency: "€", Approximate: true}, true},
+		{"dot thousands", "19.900 €", ParsedPrice{Amount: 19900, Currency: "€"}, true},
+		{"repeated dot thousands", "1.299.000 ₽", ParsedPrice{Amount: 1299000, Currency: "₽"}, true},
+		{"comma decimal", "19,9 €", ParsedPrice{Amount: 20, Currency: "€", Approximate: true}, true},
+		{"space thousands", "1 299 ₽", ParsedPrice{Amount: 1299, Currency: "₽"}, true},
+		{"no-break space thousands", "1\u00a0299\u202f000 ₽", ParsedPrice{Amount: 1299000, Currency: "₽"}, true},
+		{"apostrophe thousands", "CHF 1'299", ParsedPrice{Amount: 1299, Currency: "CHF"}, true},
+		{"iso code suffix", "19900 RUB", ParsedPrice{Amount: 19900, Currency: "RUB"}, true},
+		{"russian abbreviation", "19 900 руб.", ParsedPrice{Amount: 19900, Currency: "₽"}, true},
+		{"short russian abbreviation", "500 Р", ParsedPrice{Amount: 500, Currency: "₽"}, true},
+		{"no currency", "19900", ParsedPrice{Amount: 19900}, true},
+		{"surrounding space", "  $5  ", ParsedPrice{Amount: 5, Currency: "$"}, true},
+		{"empty", "", ParsedPrice{}, false},
+		{"no digits", "ask me", ParsedPrice{}, false},
+		{"unknown word", "about 200", ParsedPrice{}, false},
+		{"unknown symbol", "#200", ParsedPrice{}, false},
+		{"lowercase code", "usd 200", ParsedPrice{}, false},
+		{"currency on both sides", "$200 USD", ParsedPrice{}, false},
+		{"range", "₽1500–₽2500", ParsedPrice{}, false},
+		{"negative", "-$5", ParsedPrice{}, false},
+		{"prose around", "from 5 to 10", ParsedPrice{}, false},
+		{"overflow", "$99999999999999999999", ParsedPrice{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := ParseMSRP(tt.msrp)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWish_DefaultPrice(t *testing.T) {
+	t.Parallel()
+
+	wish := &Wish{Spec: WishSpec{MSRP: "$19.99"}}
+	require.True(t, wish.DefaultPrice())
+	require.NotNil(t, wish.Spec.PriceMin)
+	assert.Equal(t, int64(20), *wish.Spec.PriceMin)
+	assert.Nil(t, wish.Spec.PriceMax)
+	assert.Equal(t, "$", wish.Spec.Currency)
+	assert.True(t, wish.Spec.Approximate)
+
+	assert.False(t, wish.DefaultPrice(), "structured price already set")
+
+	wish = &Wish{Spec: WishSpec{MSRP: "19900", Currency: "₽"}}
+	require.True(t, wish.DefaultPrice())
+	assert.Equal(t, "₽", wish.Spec.Currency, "an explicit currency is kept")
+	assert.False(t, wish.Spec.Approximate)
+
+	wish = &Wish{Spec: WishSpec{MSRP: "$19", PriceMax: int64Ptr(100)}}
+	assert.False(t, wish.DefaultPrice())
+	assert.Nil(t, wish.Spec.PriceMin)
+
+	wish = &Wish{Spec: WishSpec{MSRP: "ask me"}}
+	assert.False(t, wish.DefaultPrice())
+	assert.Equal(t, WishSpec{MSRP: "ask me"}, wish.Spec)
+}
@@ -4,6 +4,7 @@
 package v1alpha1
 
 import (
+	"slices"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,9 +24,93 @@ type Reservation struct {
 
 	// ExpiresAt is when this reservation will expire.
 	ExpiresAt metav1.Time `json:"expiresAt"`
+
+	// TokenHash is the SHA-256 hex digest of the reserver's token.
+	// It lets the reserver release the reservation later without storing the token itself.
+	// +optional
+	TokenHash string `json:"tokenHash,omitempty"`
+
+	// Note is an optional message from the giver to the owner, e.g. which variant they will buy.
+	// It is shown only in authenticated admin views.
+	// +optional
+	// +kubebuilder:validation:MaxLength=200
+	Note string `json:"note,omitempty"`
+
+	// PendingUntil is set while the reservation awaits confirmation. It holds
+	// its quantity meanwhile and is dropped if not confirmed by this time.
+	// +optional
+	PendingUntil *metav1.Time `json:"pendingUntil,omitempty"`
+
+	// PendingTokenHash is the SHA-256 hex digest of the token that confirms
+	// a pending reservation.
+	// +optional
+	PendingTokenHash string `json:"pendingTokenHash,omitempty"`
+
+	// ReservedFor names the giver the owner reserved on behalf of, e.g. a
+	// relative who called to say they will buy it. It is shown only in
+	// authenticated admin views.
+	// +optional
+	// +kubebuilder:validation:MaxLength=100
+	ReservedFor string `json:"reservedFor,omitempty"`
+
+	// AdminCreated marks a reservation the owner made through the admin API
+	// rather than a giver through the reserve form.
+	// +optional
+	AdminCreated bool `json:"adminCreated,omitempty"`
+}
+
+// IsPending reports whether the reservation still awaits confirmation.
+func (r *Reservation) IsPending() bool {
+	return r.PendingUntil != nil
+}
+
+// Reasons a reservation ended, as recorded in ReservationRecord.
+const (
+	// HistoryReasonExpired marks a reservation cleared after it expired.
+	HistoryReasonExpired = "Expired"
+
+	// HistoryReasonUnconfirmed marks a pending reservation that was never
+	// confirmed.
+	HistoryReasonUnconfirmed = "Unconfirmed"
+
+	// HistoryReasonReleased marks a reservation released because the wish's
+	// title or official URL changed under it.
+	HistoryReasonReleased = "Released"
+)
+
+// ReservationRecord is a past reservation the controller cleared.
+type ReservationRecord struct {
+	// Quantity is the number of items the reservation held.
+	Quantity int32 `json:"quantity"`
+
+	// CreatedAt is when the reservation was made.
+	CreatedAt metav1.Time `json:"createdAt"`
+
+	// EndedAt is when the controller cleared the reservation.
+	EndedAt metav1.Time `json:"endedAt"`
+
+	// Reason is why the reservation ended.
+	// +kubebuilder:validation:Enum=Expired;Unconfirmed;Released
+	Reason string `json:"reason"`
+}
+
+// Link is a labeled reference about a wish that is not a place to buy it,
+// such as a review or a spec sheet.
+type Link struct {
+	// Label is the link text. Empty uses the URL's host.
+	// +kubebuilder:validation:MaxLength=100
+	// +optional
+	Label string `json:"label,omitempty"`
+
+	// URL is the absolute http or https address of the link.
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
 }
 
 // WishSpec defines the desired state of Wish.
+// +kubebuilder:validation:XValidation:rule="!has(self.priceMin) || !has(self.priceMax) || self.priceMin <= self.priceMax",message="priceMin must not exceed priceMax"
+// +kubebuilder:validation:XValidation:rule="!has(self.fund) || !self.fund || (has(self.fundTarget) && self.fundTarget > 0)",message="fundTarget must be set when fund is enabled"
 type WishSpec struct {
 	// Title is the name of the desired item.
 	// +kubebuilder:validation:Required
@@ -40,14 +125,55 @@ type WishSpec struct {
 	// +optional
 	OfficialURL string `json:"officialURL,omitempty"`
 
+	// OwnerContact tells givers how to reach the owner with questions,
+	// either a URL (https:// or mailto:) or a plain handle (e.g., "@alice").
+	// +optional
+	OwnerContact string `json:"ownerContact,omitempty"`
+
 	// PurchaseURLs is a list of links where the item can be purchased.
 	// +optional
 	PurchaseURLs []string `json:"purchaseURLs,omitempty"`
 
+	// Links are labeled references about the wish that are not places to
+	// buy it, such as reviews or spec sheets.
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Links []Link `json:"links,omitempty"`
+
 	// MSRP is the price display string (e.g., "₽ 19900").
 	// +optional
 	MSRP string `json:"msrp,omitempty"`
 
+	// PriceMin is the lower bound of a structured price, in whole Currency units.
+	// When set together with PriceMax, the price is shown as a range. Takes precedence over MSRP.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	PriceMin *int64 `json:"priceMin,omitempty"`
+
+	// PriceMax is the upper bound of a structured price, in whole Currency units.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	PriceMax *int64 `json:"priceMax,omitempty"`
+
+	// Currency is the symbol or code shown before structured prices (e.g., "₽", "$").
+	// +kubebuilder:validation:MaxLength=8
+	// +optional
+	Currency string `json:"currency,omitempty"`
+
+	// Approximate marks the structured price as an estimate, shown with a leading tilde.
+	// +optional
+	Approximate bool `json:"approximate,omitempty"`
+
+	// Fund turns the wish into a contribution fund: givers pledge amounts
+	// towards FundTarget instead of reserving items.
+	// +optional
+	Fund bool `json:"fund,omitempty"`
+
+	// FundTarget is the amount to raise, in whole Currency units. Required when Fund is set.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FundTarget int64 `json:"fundTarget,omitempty"`
+
 	// Tags are category labels for the wish.
 	// +optional
 	Tags []string `json:"tags,omitempty"`
@@ -66,10 +192,16 @@ type WishSpec struct {
 	// +optional
 	Priority int32 `json:"priority,omitempty"`
 
-	// TTL defines how long the wish stays active.
+	// TTL defines how long the wish stays active. A zero TTL, like an unset
+	// one, never expires.
 	// +optional
 	TTL *metav1.Duration `json:"ttl,omitempty"`
 
+	// NeededBy is when the owner needs the gift. Once it passes while the
+	// wish is still active, the controller sets the Overdue condition.
+	// +optional
+	NeededBy *metav1.Time `json:"neededBy,omitempty"`
+
 	// Quantity is the total number of items available for this wish.
 	// Defaults to 1 if not specified (backwards compatible).
 	// Set to 0 for unlimited quantity (users can reserve as many as they want).
@@ -77,6 +209,42 @@ type WishSpec struct {
 	// +kubebuilder:default=1
 	// +optional
 	Quantity int32 `json:"quantity,omitempty"`
+
+	// Order sets an explicit display position (ascending) that takes precedence over Priority.
+	// Wishes without an order are listed after all ordered ones.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Order *int32 `json:"order,omitempty"`
+
+	// Unlisted hides the wish from the public list and archive while keeping it
+	// reachable by its permalink (/wishes/<name>) and in admin views.
+	// +optional
+	Unlisted bool `json:"unlisted,omitempty"`
+
+	// GroupGift lets several givers share the wish: the first reserver becomes
+	// its coordinator and later givers pledge to join instead of being turned away.
+	// +optional
+	GroupGift bool `json:"groupGift,omitempty"`
+
+	// AllowMultipleReservations lets any number of givers reserve the wish
+	// regardless of Quantity, such as a cash fund with a quantity of 1 meant
+	// for many small pledges. It takes precedence over tag policies.
+	// +optional
+	AllowMultipleReservations bool `json:"allowMultipleReservations,omitempty"`
+
+	// PartOfSet names a set of wishes that only make sense together, such as
+	// a console and a game for it. Wishes with the same set name are listed
+	// together.
+	// +optional
+	PartOfSet string `json:"partOfSet,omitempty"`
+
+	// Slug is a short, shareable name for the permalink /w/<slug>, for when
+	// the object name is not. It must be a DNS label and unique within the
+	// namespace; without it the permalink uses the object name.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Slug string `json:"slug,omitempty"`
 }
 
 // WishStatus defines the observed state of Wish.
@@ -85,10 +253,90 @@ type WishStatus struct {
 	// +optional
 	Reservations []Reservation `json:"reservations,omitempty"`
 
+	// History lists reservations the controller cleared, oldest first. It is
+	// pruned to the operator's configured retention.
+	// +optional
+	History []ReservationRecord `json:"history,omitempty"`
+
+	// NextReminderAt is when the giver of the confirmed reservation expiring
+	// soonest is due a reminder, a configured lead time before it expires, for
+	// external reminder jobs to act on. It is unset while none is running.
+	// +optional
+	NextReminderAt *metav1.Time `json:"nextReminderAt,omitempty"`
+
 	// Active indicates if the wish is within its TTL.
 	// +optional
 	Active bool `json:"active,omitempty"`
 
+	// FundRaised is the total pledged towards a fund wish's FundTarget.
+	// +optional
+	FundRaised int64 `json:"fundRaised,omitempty"`
+
+	// Fulfilled indicates a fund wish has reached its target, or every item
+	// of a wish has been purchased.
+	// +optional
+	Fulfilled bool `json:"fulfilled,omitempty"`
+
+	// PurchasedQuantity counts items already bought, e.g. 2 of 5. They are
+	// no longer available to reserve.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	PurchasedQuantity int32 `json:"purchasedQuantity,omitempty"`
+
+	// Coordinator is the token hash of the giver coordinating a group gift.
+	// +optional
+	Coordinator string `json:"coordinator,omitempty"`
+
+	// Pledgers are the token hashes of givers who joined a group gift.
+	// +optional
+	Pledgers []string `json:"pledgers,omitempty"`
+
+	// GroupClosed indicates the coordinator stopped accepting new pledgers.
+	// +optional
+	GroupClosed bool `json:"groupClosed,omitempty"`
+
+	// Received indicates the owner got the gift. Received wishes leave the
+	// public list and accept no further reservations.
+	// +optional
+	Received bool `json:"received,omitempty"`
+
+	// ReceivedAt is when the owner marked the wish as received.
+	// +optional
+	ReceivedAt *metav1.Time `json:"receivedAt,omitempty"`
+
+	// Summary describes the wish's state in one line, such as
+	// "Active, 3 of 5 available", in the operator's configured language.
+	// +optional
+	Summary string `json:"summary,omitempty"`
+
+	// EffectivePriority is Spec.Priority lowered with the wish's age when
+	// the operator runs with priority decay, and is what lists sort by.
+	// Zero means no decay applies.
+	// +optional
+	EffectivePriority int32 `json:"effectivePriority,omitempty"`
+
+	// Demand counts givers' interest in the wish: its reservations and
+	// group-gift pledgers plus the confirmed reservations kept in History.
+	// Public lists badge wishes at or above the web server's popular
+	// threshold.
+	// +optional
+	Demand int32 `json:"demand,omitempty"`
+
+	// PriceCheckedAt is when the owner last confirmed the listed price is
+	// still current. Nothing checks the price automatically.
+	// +optional
+	PriceCheckedAt *metav1.Time `json:"priceCheckedAt,omitempty"`
+
+	// KeyFieldsHash fingerprints the title and official URL last seen by a
+	// controller releasing reservations on edit, to tell when they change.
+	// +optional
+	KeyFieldsHash string `json:"keyFieldsHash,omitempty"`
+
+	// ObservedGeneration is the metadata.generation last reconciled into
+	// this status.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	// Conditions represent the current state of the Wish resource.
 	// +listType=map
 	// +listMapKey=type
@@ -116,8 +364,102 @@ type WishStatus struct {
 	ReservationExpires *metav1.Time `json:"reservationExpires,omitempty"`
 }
 
+// ConditionOverSubscribed is set when active reservations exceed the wish's
+// quantity, typically after the owner lowered it.
+const ConditionOverSubscribed = "OverSubscribed"
+
+// ConditionExpiringSoon is set when the wish's TTL is about to run out, so
+// the owner can extend it in time.
+const ConditionExpiringSoon = "ExpiringSoon"
+
+// ConditionOverdue is set when the wish's NeededBy date has passed while it
+// is still active and not received.
+const ConditionOverdue = "Overdue"
+
+// ConditionNoPurchaseLinks is set when a wish other than a fund has neither
+// an official URL nor purchase URLs, hinting the owner that givers have no
+// way to buy it.
+const ConditionNoPurchaseLinks = "NoPurchaseLinks"
+
+// Labels the controller keeps in step with the wish's state, so wishes can be
+// selected with `kubectl get wishes -l`. Values are "true" or "false".
+const (
+	// LabelActive mirrors status.active.
+	LabelActive = "wishlist.k8s.lex.la/active"
+
+	// LabelReserved is "true" while any quantity is reserved.
+	LabelReserved = "wishlist.k8s.lex.la/reserved"
+)
+
+// LabelOwner names whose wishlist a wish belongs to when several people share
+// a namespace, e.g. a family running a secret santa. Unlike the labels
+// above, it is set by hand.
+const LabelOwner = "wishlist.k8s.lex.la/owner"
+
+// AnnotationPaused set to "true" makes the controller leave the wish and its
+// status alone, e.g. during maintenance. Removing it resumes reconciling.
+const AnnotationPaused = "wishlist.k8s.lex.la/paused"
+
+// IsPaused reports whether reconciliation of the wish is paused.
+func (w *Wish) IsPaused() bool {
+	return w.Annotations[AnnotationPaused] == "true"
+}
+
+// AnnotationReleaseOnEdit set to "true" makes the controller release the
+// wish's reservations when its title or official URL changes, as when the
+// operator runs with --release-on-edit.
+const AnnotationReleaseOnEdit = "wishlist.k8s.lex.la/release-on-edit"
+
+// ReleasesOnEdit reports whether the wish opted in to releasing its
+// reservations on edit.
+func (w *Wish) ReleasesOnEdit() bool {
+	return w.Annotations[AnnotationReleaseOnEdit] == "true"
+}
+
+// AnnotationExplicitPriority set to "true" marks a zero priority as chosen
+// rather than unset, so the defaulting webhook keeps it.
+const AnnotationExplicitPriority = "wishlist.k8s.lex.la/explicit-priority"
+
+// DefaultPriority sets Spec.Priority to priority when it is zero and not
+// marked explicit with AnnotationExplicitPriority. Priorities outside 1-5
+// leave the wish alone. Returns true if the spec was modified.
+func (w *Wish) DefaultPriority(priority int32) bool {
+	if priority < 1 || priority > 5 || w.Spec.Priority != 0 || w.Annotations[AnnotationExplicitPriority] == "true" {
+		return false
+	}
+
+	w.Spec.Priority = priority
+
+	return true
+}
+
+// HasTTL reports whether the wish expires at all. A zero TTL means the same
+// as an unset one, so a wish written with "ttl: 0s" is not expired at birth.
+func (w *Wish) HasTTL() bool {
+	return w.Spec.TTL != nil && w.Spec.TTL.Duration != 0
+}
+
+// DefaultTTL drops a zero TTL, so stored wishes spell "never expires" one way.
+// Returns true if the spec was modified.
+func (w *Wish) DefaultTTL() bool {
+	if w.Spec.TTL == nil || w.HasTTL() {
+		return false
+	}
+
+	w.Spec.TTL = nil
+
+	return true
+}
+
+// SlugField selects wishes by Spec.Slug, both as a field selector and as the
+// name of the cache index.
+const SlugField = "spec.slug"
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:selectablefield:JSONPath=`.spec.slug`
+// +kubebuilder:printcolumn:name="Summary",type=string,JSONPath=`.status.summary`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Wish is the Schema for the wishes API
 type Wish struct {
@@ -150,6 +492,13 @@ type WishList struct {
 //
 // Deprecated: Use new Reservations slice instead.
 func (w *Wish) IsReservationExpired() bool {
+	return w.IsReservationExpiredAt(time.Now())
+}
+
+// IsReservationExpiredAt checks if the legacy reservation has expired at now.
+//
+// Deprecated: Use new Reservations slice instead.
+func (w *Wish) IsReservationExpiredAt(now time.Time) bool {
 	if !w.Status.Reserved {
 		return false
 	}
@@ -158,7 +507,7 @@ func (w *Wish) IsReservationExpired() bool {
 		return false
 	}
 
-	return time.Now().After(w.Status.ReservationExpires.Time)
+	return now.After(w.Status.ReservationExpires.Time)
 }
 
 // IsUnlimited returns true if the wish has unlimited quantity (quantity == 0).
@@ -166,6 +515,16 @@ func (w *Wish) IsUnlimited() bool {
 	return w.Spec.Quantity == 0
 }
 
+// SortPriority returns the priority lists order the wish by: its effective
+// priority when decay applies, otherwise Spec.Priority.
+func (w *Wish) SortPriority() int32 {
+	if w.Status.EffectivePriority > 0 {
+		return w.Status.EffectivePriority
+	}
+
+	return w.Spec.Priority
+}
+
 // GetQuantity returns the total quantity.
 // In real K8s with kubebuilder, default=1 ensures unset becomes 1.
 // Explicit 0 means unlimited. Negative values fallback to 1 (shouldn't happen due to validation).
@@ -187,15 +546,60 @@ func (w *Wish) TotalReserved() int32 {
 	return total
 }
 
-// AvailableQuantity returns how many items are available for reservation.
-// For unlimited wishes (quantity == 0), returns math.MaxInt32.
+// HeldQuantity returns the total quantity reserved under the given token hash.
+func (w *Wish) HeldQuantity(tokenHash string) int32 {
+	if tokenHash == "" {
+		return 0
+	}
+
+	var total int32
+
+	for _, r := range w.Status.Reservations {
+		if r.TokenHash == tokenHash {
+			total += r.Quantity
+		}
+	}
+
+	return total
+}
+
+// ReleaseQuantity gives back up to quantity items held under the given token
+// hash, starting with the most recent reservation. Entries that drop to zero
+// are removed. Returns the quantity actually released.
+func (w *Wish) ReleaseQuantity(tokenHash string, quantity int32) int32 {
+	if tokenHash == "" || quantity <= 0 {
+		return 0
+	}
+
+	var released int32
+
+	for i := len(w.Status.Reservations) - 1; i >= 0 && released < quantity; i-- {
+		r := &w.Status.Reservations[i]
+		if r.TokenHash != tokenHash {
+			continue
+		}
+
+		take := min(r.Quantity, quantity-released)
+		r.Quantity -= take
+		released += take
+
+		if r.Quantity == 0 {
+			w.Status.Reservations = append(w.Status.Reservations[:i], w.Status.Reservations[i+1:]...)
+		}
+	}
+
+	return released
+}
+
+// AvailableQuantity returns how many items are neither reserved nor
+// purchased. For unlimited wishes (quantity == 0), returns math.MaxInt32.
 func (w *Wish) AvailableQuantity() int32 {
 	if w.IsUnlimited() {
 		//nolint:mnd // MaxInt32 value for unlimited quantity
 		return 2147483647 // math.MaxInt32
 	}
 
-	available := w.GetQuantity() - w.TotalReserved()
+	available := w.GetQuantity() - w.TotalReserved() - w.Status.PurchasedQuantity
 	if available < 0 {
 		return 0
 	}
@@ -205,8 +609,11 @@ func (w *Wish) AvailableQuantity() int32 {
 
 // ActiveReservations returns reservations that have not yet expired.
 func (w *Wish) ActiveReservations() []Reservation {
-	now := time.Now()
+	return w.ActiveReservationsAt(time.Now())
+}
 
+// ActiveReservationsAt returns reservations that have not expired at now.
+func (w *Wish) ActiveReservationsAt(now time.Time) []Reservation {
 	var active []Reservation
 
 	for _, r := range w.Status.Reservations {
@@ -218,6 +625,27 @@ func (w *Wish) ActiveReservations() []Reservation {
 	return active
 }
 
+// ExpiringReservations returns reservations that have expired but are still
+// held because the controller keeps them for a grace period before clearing
+// them. They still count towards TotalReserved.
+func (w *Wish) ExpiringReservations() []Reservation {
+	return w.ExpiringReservationsAt(time.Now())
+}
+
+// ExpiringReservationsAt returns reservations that have expired at now but
+// are still held.
+func (w *Wish) ExpiringReservationsAt(now time.Time) []Reservation {
+	var expiring []Reservation
+
+	for _, r := range w.Status.Reservations {
+		if !r.ExpiresAt.After(now) {
+			expiring = append(expiring, r)
+		}
+	}
+
+	return expiring
+}
+
 // IsFullyReserved returns true if all items are reserved.
 // Unlimited wishes (quantity == 0) are never fully reserved.
 func (w *Wish) IsFullyReserved() bool {
@@ -228,6 +656,50 @@ func (w *Wish) IsFullyReserved() bool {
 	return w.AvailableQuantity() == 0
 }
 
+// FundRemaining returns how much is still needed to reach the fund target.
+func (w *Wish) FundRemaining() int64 {
+	return max(w.Spec.FundTarget-w.Status.FundRaised, 0)
+}
+
+// IsFullyFunded returns true if a fund wish has reached its target.
+// Wishes that are not funds are never fully funded.
+func (w *Wish) IsFullyFunded() bool {
+	return w.Spec.Fund && w.Spec.FundTarget > 0 && w.FundRemaining() == 0
+}
+
+// IsFullyPurchased returns true if every item has been purchased.
+// Unlimited wishes are never fully purchased.
+func (w *Wish) IsFullyPurchased() bool {
+	return !w.IsUnlimited() && w.Status.PurchasedQuantity >= w.GetQuantity()
+}
+
+// IsFulfilled computes the value kept in Status.Fulfilled: a fund wish has
+// reached its target or every item has been purchased.
+func (w *Wish) IsFulfilled() bool {
+	return w.IsFullyFunded() || w.IsFullyPurchased()
+}
+
+// HasPledger reports whether the token hash already joined the group gift.
+func (w *Wish) HasPledger(tokenHash string) bool {
+	return slices.Contains(w.Status.Pledgers, tokenHash)
+}
+
+// CurrentDemand computes the value kept in Status.Demand: one per
+// reservation, pending ones included, and per pledger, plus one per past
+// reservation in History that was confirmed. Quantities are not weighed, so
+// one giver taking five items counts once.
+func (w *Wish) CurrentDemand() int32 {
+	demand := len(w.Status.Reservations) + len(w.Status.Pledgers)
+
+	for _, record := range w.Status.History {
+		if record.Reason != HistoryReasonUnconfirmed {
+			demand++
+		}
+	}
+
+	return int32(demand) //nolint:gosec // bounded by the object size limit
+}
+
 // NextReservationExpiry returns the earliest expiration time among all reservations.
 // Returns nil if there are no reservations.
 func (w *Wish) NextReservationExpiry() *metav1.Time {
@@ -247,15 +719,41 @@ func (w *Wish) NextReservationExpiry() *metav1.Time {
 	return earliest
 }
 
+// ExpirationTime returns when the wish falls out of its TTL.
+// The second value is false if the wish has no TTL, or a zero one.
+func (w *Wish) ExpirationTime() (time.Time, bool) {
+	if !w.HasTTL() {
+		return time.Time{}, false
+	}
+
+	return w.CreationTimestamp.Add(w.Spec.TTL.Duration), true
+}
+
+// CapReservationExpiry returns expires, or the wish's expiration time if that
+// comes first, since a reservation never outlasts the wish. The second value
+// reports whether expires was shortened.
+func (w *Wish) CapReservationExpiry(expires time.Time) (time.Time, bool) {
+	expiration, ok := w.ExpirationTime()
+	if !ok || !expires.After(expiration) {
+		return expires, false
+	}
+
+	return expiration, true
+}
+
 // IsExpired checks if the wish has exceeded its TTL.
 func (w *Wish) IsExpired() bool {
-	if w.Spec.TTL == nil {
+	return w.IsExpiredAt(time.Now())
+}
+
+// IsExpiredAt checks if the wish has exceeded its TTL at now.
+func (w *Wish) IsExpiredAt(now time.Time) bool {
+	expirationTime, ok := w.ExpirationTime()
+	if !ok {
 		return false
 	}
 
-	expirationTime := w.CreationTimestamp.Add(w.Spec.TTL.Duration)
-
-	return time.Now().After(expirationTime)
+	return now.After(expirationTime)
 }
 
 func init() {
@@ -191,6 +191,26 @@ func TestWish_IsExpired(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "zero TTL - never expires",
+			wish: Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(time.Now().Add(-365 * 24 * time.Hour)),
+				},
+				Spec: WishSpec{TTL: &metav1.Duration{}},
+			},
+			expected: false,
+		},
+		{
+			name: "tiny TTL expired",
+			wish: Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Second)),
+				},
+				Spec: WishSpec{TTL: &metav1.Duration{Duration: time.Nanosecond}},
+			},
+			expected: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -201,6 +221,33 @@ func TestWish_IsExpired(t *testing.T) {
 	}
 }
 
+func TestWish_ExpiryAt(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	expires := metav1.NewTime(created.Add(48 * time.Hour))
+
+	wish := &Wish{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+		Spec:       WishSpec{TTL: &metav1.Duration{Duration: 24 * time.Hour}, Quantity: 2},
+		Status: WishStatus{Reservations: []Reservation{
+			{Quantity: 1, CreatedAt: metav1.NewTime(created), ExpiresAt: expires},
+		}},
+	}
+
+	before := created.Add(time.Hour)
+	assert.False(t, wish.IsExpiredAt(before))
+	assert.Len(t, wish.ActiveReservationsAt(before), 1)
+	assert.Empty(t, wish.ExpiringReservationsAt(before))
+
+	after := expires.Add(time.Minute)
+	assert.True(t, wish.IsExpiredAt(after))
+	assert.Empty(t, wish.ActiveReservationsAt(after))
+	assert.Len(t, wish.ExpiringReservationsAt(after), 1)
+
+	assert.False(t, wish.IsExpiredAt(created.Add(24*time.Hour)), "expiry is exclusive of the deadline")
+}
+
 func timePtr(t metav1.Time) *metav1.Time {
 	return &t
 }
@@ -291,6 +338,7 @@ func TestWish_AvailableQuantity(t *testing.T) {
 		name         string
 		quantity     int32
 		reservations []Reservation
+		purchased    int32
 		expected     int32
 	}{
 		{
@@ -330,6 +378,27 @@ func TestWish_AvailableQuantity(t *testing.T) {
 			},
 			expected: 0,
 		},
+		{
+			name:      "partially purchased",
+			quantity:  5,
+			purchased: 2,
+			expected:  3,
+		},
+		{
+			name:     "reserved and purchased",
+			quantity: 5,
+			reservations: []Reservation{
+				{Quantity: 1},
+			},
+			purchased: 2,
+			expected:  2,
+		},
+		{
+			name:      "fully purchased",
+			quantity:  5,
+			purchased: 5,
+			expected:  0,
+		},
 	}
 
 	for _, tt := range tests {
@@ -337,7 +406,7 @@ func TestWish_AvailableQuantity(t *testing.T) {
 			t.Parallel()
 			wish := &Wish{
 				Spec:   WishSpec{Quantity: tt.quantity},
-				Status: WishStatus{Reservations: tt.reservations},
+				Status: WishStatus{Reservations: tt.reservations, PurchasedQuantity: tt.purchased},
 			}
 			assert.Equal(t, tt.expected, wish.AvailableQuantity())
 		})
@@ -397,6 +466,26 @@ func TestWish_ActiveReservations(t *testing.T) {
 	}
 }
 
+func TestWish_ExpiringReservations(t *testing.T) {
+	t.Parallel()
+
+	future := metav1.NewTime(time.Now().Add(time.Hour))
+	past := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	wish := &Wish{
+		Spec: WishSpec{Quantity: 5},
+		Status: WishStatus{Reservations: []Reservation{
+			{Quantity: 1, ExpiresAt: future},
+			{Quantity: 2, ExpiresAt: past},
+		}},
+	}
+
+	expiring := wish.ExpiringReservations()
+	assert.Len(t, expiring, 1)
+	assert.Equal(t, int32(2), expiring[0].Quantity)
+	assert.Equal(t, int32(3), wish.TotalReserved(), "expiring reservations still count as reserved")
+}
+
 func TestWish_IsFullyReserved(t *testing.T) {
 	t.Parallel()
 
@@ -713,3 +802,302 @@ func TestWish_IsFullyReserved_Unlimited(t *testing.T) {
 		})
 	}
 }
+
+func TestWish_HeldQuantity(t *testing.T) {
+	t.Parallel()
+
+	now := metav1.Now()
+	futureExpiry := metav1.NewTime(now.Add(24 * time.Hour))
+
+	wish := &Wish{
+		Status: WishStatus{
+			Reservations: []Reservation{
+				{Quantity: 2, CreatedAt: now, ExpiresAt: futureExpiry, TokenHash: "a"},
+				{Quantity: 1, CreatedAt: now, ExpiresAt: futureExpiry, TokenHash: "b"},
+				{Quantity: 3, CreatedAt: now, ExpiresAt: futureExpiry, TokenHash: "a"},
+				{Quantity: 4, CreatedAt: now, ExpiresAt: futureExpiry},
+			},
+		},
+	}
+
+	assert.Equal(t, int32(5), wish.HeldQuantity("a"))
+	assert.Equal(t, int32(1), wish.HeldQuantity("b"))
+	assert.Equal(t, int32(0), wish.HeldQuantity("c"))
+	assert.Equal(t, int32(0), wish.HeldQuantity(""), "untokened reservations are not held by anyone")
+}
+
+func TestWish_ReleaseQuantity(t *testing.T) {
+	t.Parallel()
+
+	now := metav1.Now()
+	futureExpiry := metav1.NewTime(now.Add(24 * time.Hour))
+
+	newWish := func() *Wish {
+		return &Wish{
+			Status: WishStatus{
+				Reservations: []Reservation{
+					{Quantity: 2, CreatedAt: now, ExpiresAt: futureExpiry, TokenHash: "a"},
+					{Quantity: 1, CreatedAt: now, ExpiresAt: futureExpiry, TokenHash: "b"},
+					{Quantity: 3, CreatedAt: now, ExpiresAt: futureExpiry, TokenHash: "a"},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		token        string
+		quantity     int32
+		wantReleased int32
+		wantQty      []int32
+	}{
+		{"partial from latest entry", "a", 1, 1, []int32{2, 1, 2}},
+		{"drains latest entry and removes it", "a", 3, 3, []int32{2, 1}},
+		{"spans entries", "a", 4, 4, []int32{1, 1}},
+		{"full release", "a", 5, 5, []int32{1}},
+		{"capped at held", "a", 10, 5, []int32{1}},
+		{"other token untouched", "b", 1, 1, []int32{2, 3}},
+		{"unknown token", "c", 1, 0, []int32{2, 1, 3}},
+		{"zero quantity", "a", 0, 0, []int32{2, 1, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := newWish()
+			assert.Equal(t, tt.wantReleased, wish.ReleaseQuantity(tt.token, tt.quantity))
+
+			got := make([]int32, 0, len(wish.Status.Reservations))
+			for _, r := range wish.Status.Reservations {
+				got = append(got, r.Quantity)
+			}
+
+			assert.Equal(t, tt.wantQty, got)
+		})
+	}
+}
+
+func TestWish_ExpirationTime(t *testing.T) {
+	t.Parallel()
+
+	created := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	noTTL := &Wish{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created}}
+	_, ok := noTTL.ExpirationTime()
+	assert.False(t, ok)
+
+	withTTL := &Wish{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+		Spec:       WishSpec{TTL: &metav1.Duration{Duration: 48 * time.Hour}},
+	}
+	expiresAt, ok := withTTL.ExpirationTime()
+	require.True(t, ok)
+	assert.Equal(t, created.Add(48*time.Hour), expiresAt)
+
+	zeroTTL := &Wish{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+		Spec:       WishSpec{TTL: &metav1.Duration{}},
+	}
+	_, ok = zeroTTL.ExpirationTime()
+	assert.False(t, ok, "a zero TTL never expires")
+	assert.False(t, zeroTTL.HasTTL())
+
+	tinyTTL := &Wish{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+		Spec:       WishSpec{TTL: &metav1.Duration{Duration: time.Nanosecond}},
+	}
+	expiresAt, ok = tinyTTL.ExpirationTime()
+	require.True(t, ok)
+	assert.Equal(t, created.Add(time.Nanosecond), expiresAt)
+	assert.False(t, tinyTTL.IsExpiredAt(created.Time))
+	assert.True(t, tinyTTL.IsExpiredAt(created.Add(time.Millisecond)))
+}
+
+func TestWish_CapReservationExpiry(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	wish := &Wish{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+		Spec:       WishSpec{TTL: &metav1.Duration{Duration: 48 * time.Hour}},
+	}
+
+	got, shortened := wish.CapReservationExpiry(created.Add(24 * time.Hour))
+	assert.False(t, shortened)
+	assert.Equal(t, created.Add(24*time.Hour), got)
+
+	got, shortened = wish.CapReservationExpiry(created.Add(72 * time.Hour))
+	assert.True(t, shortened)
+	assert.Equal(t, created.Add(48*time.Hour), got)
+
+	got, shortened = (&Wish{}).CapReservationExpiry(created.Add(72 * time.Hour))
+	assert.False(t, shortened, "no TTL, no cap")
+	assert.Equal(t, created.Add(72*time.Hour), got)
+}
+
+func TestWish_DefaultTTL(t *testing.T) {
+	t.Parallel()
+
+	wish := &Wish{Spec: WishSpec{TTL: &metav1.Duration{}}}
+	assert.True(t, wish.DefaultTTL())
+	assert.Nil(t, wish.Spec.TTL)
+
+	assert.False(t, wish.DefaultTTL(), "no TTL to drop")
+
+	wish = &Wish{Spec: WishSpec{TTL: &metav1.Duration{Duration: time.Nanosecond}}}
+	assert.False(t, wish.DefaultTTL(), "a tiny TTL is kept")
+	assert.NotNil(t, wish.Spec.TTL)
+}
+
+func TestWish_FundProgress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		fund          bool
+		target        int64
+		raised        int64
+		wantRemaining int64
+		wantFunded    bool
+	}{
+		{"not a fund", false, 0, 0, 0, false},
+		{"nothing raised", true, 1000, 0, 1000, false},
+		{"partially raised", true, 1000, 400, 600, false},
+		{"target reached", true, 1000, 1000, 0, true},
+		{"raised beyond lowered target", true, 500, 800, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := &Wish{
+				Spec:   WishSpec{Fund: tt.fund, FundTarget: tt.target},
+				Status: WishStatus{FundRaised: tt.raised},
+			}
+			assert.Equal(t, tt.wantRemaining, wish.FundRemaining())
+			assert.Equal(t, tt.wantFunded, wish.IsFullyFunded())
+		})
+	}
+}
+
+func TestWish_IsPaused(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{name: "no annotations", annotations: nil, want: false},
+		{name: "paused", annotations: map[string]string{AnnotationPaused: "true"}, want: true},
+		{name: "explicitly not paused", annotations: map[string]string{AnnotationPaused: "false"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := &Wish{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+			assert.Equal(t, tt.want, wish.IsPaused())
+		})
+	}
+}
+
+func TestWish_CurrentDemand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status WishStatus
+		want   int32
+	}{
+		{name: "no activity", status: WishStatus{}, want: 0},
+		{
+			name: "reservations count once each regardless of quantity",
+			status: WishStatus{Reservations: []Reservation{
+				{Quantity: 5},
+				{Quantity: 1, PendingUntil: &metav1.Time{}},
+			}},
+			want: 2,
+		},
+		{name: "pledgers", status: WishStatus{Pledgers: []string{"a", "b", "c"}}, want: 3},
+		{
+			name: "history skips unconfirmed reservations",
+			status: WishStatus{History: []ReservationRecord{
+				{Quantity: 1, Reason: HistoryReasonExpired},
+				{Quantity: 1, Reason: HistoryReasonUnconfirmed},
+				{Quantity: 1, Reason: HistoryReasonExpired},
+			}},
+			want: 2,
+		},
+		{
+			name: "all sources add up",
+			status: WishStatus{
+				Reservations: []Reservation{{Quantity: 1}},
+				Pledgers:     []string{"a"},
+				History:      []ReservationRecord{{Quantity: 1, Reason: HistoryReasonExpired}},
+			},
+			want: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := &Wish{Status: tt.status}
+			assert.Equal(t, tt.want, wish.CurrentDemand())
+		})
+	}
+}
+
+func TestWish_DefaultPriority(t *testing.T) {
+	t.Parallel()
+
+	wish := &Wish{}
+	assert.True(t, wish.DefaultPriority(3))
+	assert.Equal(t, int32(3), wish.Spec.Priority)
+
+	wish = &Wish{Spec: WishSpec{Priority: 5}}
+	assert.False(t, wish.DefaultPriority(3), "an explicit priority is kept")
+	assert.Equal(t, int32(5), wish.Spec.Priority)
+
+	wish = &Wish{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationExplicitPriority: "true"}}}
+	assert.False(t, wish.DefaultPriority(3), "an annotated zero is kept")
+	assert.Zero(t, wish.Spec.Priority)
+
+	wish = &Wish{}
+	assert.False(t, wish.DefaultPriority(0), "no default configured")
+	assert.False(t, wish.DefaultPriority(6))
+	assert.Zero(t, wish.Spec.Priority)
+}
+
+func TestWish_IsFulfilled(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		wish Wish
+		want bool
+	}{
+		{name: "nothing purchased", wish: Wish{Spec: WishSpec{Quantity: 5}}, want: false},
+		{name: "partly purchased", wish: Wish{Spec: WishSpec{Quantity: 5}, Status: WishStatus{PurchasedQuantity: 2}}, want: false},
+		{name: "all purchased", wish: Wish{Spec: WishSpec{Quantity: 5}, Status: WishStatus{PurchasedQuantity: 5}}, want: true},
+		{name: "unlimited", wish: Wish{Status: WishStatus{PurchasedQuantity: 5}}, want: false},
+		{
+			name: "fund reached",
+			wish: Wish{Spec: WishSpec{Quantity: 1, Fund: true, FundTarget: 100}, Status: WishStatus{FundRaised: 100}},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.wish.IsFulfilled())
+		})
+	}
+}
@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Default server-side length limits for free-form text fields.
+const (
+	DefaultMaxTitleLength       = 200
+	DefaultMaxDescriptionLength = 2000
+)
+
+// LengthLimits configures the maximum lengths, in runes, of free-form text
+// fields. A zero or negative limit disables the check for that field.
+type LengthLimits struct {
+	MaxTitle       int
+	MaxDescription int
+}
+
+// DefaultLengthLimits returns the default text length limits.
+func DefaultLengthLimits() LengthLimits {
+	return LengthLimits{
+		MaxTitle:       DefaultMaxTitleLength,
+		MaxDescription: DefaultMaxDescriptionLength,
+	}
+}
+
+// ValidateLengths checks Title and Description against the given limits.
+// Lengths are counted in runes, not bytes, so multibyte scripts such as CJK
+// get the same allowance as ASCII.
+func (w *Wish) ValidateLengths(limits LengthLimits) field.ErrorList {
+	var errs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	if err := validateMaxRunes(specPath.Child("title"), w.Spec.Title, limits.MaxTitle); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateMaxRunes(specPath.Child("description"), w.Spec.Description, limits.MaxDescription); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// ValidationOptions configures the checks of Validate that depend on the
+// deployment rather than on the CRD.
+type ValidationOptions struct {
+	Lengths LengthLimits
+
+	// AllowedURLDomains restricts OfficialURL and PurchaseURLs, see
+	// ValidateURLDomains. Empty allows every domain.
+	AllowedURLDomains []string
+}
+
+// DefaultValidationOptions returns the options used when none are configured.
+func DefaultValidationOptions() ValidationOptions {
+	return ValidationOptions{Lengths: DefaultLengthLimits()}
+}
+
+// Validate runs the checks on the wish's own spec that both the validating
+// webhook and the admin create endpoint apply, so a wish is judged the same
+// way whichever path it takes into the cluster.
+func (w *Wish) Validate(opts ValidationOptions) field.ErrorList {
+	errs := w.ValidateLengths(opts.Lengths)
+	errs = append(errs, w.ValidatePrice()...)
+	errs = append(errs, w.ValidateLinks()...)
+	errs = append(errs, w.ValidateURLDomains(opts.AllowedURLDomains)...)
+
+	return errs
+}
+
+func validateMaxRunes(path *field.Path, value string, limit int) *field.Error {
+	if limit <= 0 {
+		return nil
+	}
+
+	if utf8.RuneCountInString(value) > limit {
+		return field.TooLongCharacters(path, value, limit)
+	}
+
+	return nil
+}
+
+// ValidatePrice checks that a structured price range is not inverted and that
+// fund wishes have a target. The CRD's CEL rules say the same; Validate runs
+// it too, so the validating webhook reports these problems together with the
+// rest of a wish's field errors.
+func (w *Wish) ValidatePrice() field.ErrorList {
+	var errs field.ErrorList
+
+	if w.Spec.PriceMin != nil && w.Spec.PriceMax != nil && *w.Spec.PriceMin > *w.Spec.PriceMax {
+		errs = append(errs, field.Invalid(
+			field.NewPath("spec", "priceMin"), *w.Spec.PriceMin, "must not exceed priceMax"))
+	}
+
+	if w.Spec.Fund && w.Spec.FundTarget <= 0 {
+		errs = append(errs, field.Required(
+			field.NewPath("spec", "fundTarget"), "must be set when fund is enabled"))
+	}
+
+	return errs
+}
+
+// ValidateSlug checks that Slug is a DNS label and that none of others, the
+// other wishes of the namespace, already uses it. An empty slug is valid.
+func (w *Wish) ValidateSlug(others []Wish) field.ErrorList {
+	if w.Spec.Slug == "" {
+		return nil
+	}
+
+	path := field.NewPath("spec", "slug")
+
+	var errs field.ErrorList
+
+	for _, msg := range validation.IsDNS1123Label(w.Spec.Slug) {
+		errs = append(errs, field.Invalid(path, w.Spec.Slug, msg))
+	}
+
+	for i := range others {
+		other := &others[i]
+		if other.Namespace == w.Namespace && other.Name != w.Name && other.Spec.Slug == w.Spec.Slug {
+			errs = append(errs, field.Duplicate(path, w.Spec.Slug))
+
+			break
+		}
+	}
+
+	return errs
+}
+
+// MaxLinkLabelLength is the longest Link label, in runes.
+const MaxLinkLabelLength = 100
+
+// ValidateLinks checks that every link has an absolute http or https URL
+// and a label of at most MaxLinkLabelLength runes. The CRD only checks the
+// URL's scheme prefix; this also requires a host.
+func (w *Wish) ValidateLinks() field.ErrorList {
+	var errs field.ErrorList
+
+	linksPath := field.NewPath("spec", "links")
+
+	for i, link := range w.Spec.Links {
+		path := linksPath.Index(i)
+
+		parsed, err := url.Parse(link.URL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+			errs = append(errs, field.Invalid(path.Child("url"), link.URL, "must be an absolute http or https URL"))
+		}
+
+		if err := validateMaxRunes(path.Child("label"), link.Label, MaxLinkLabelLength); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// ValidateURLDomains checks OfficialURL and PurchaseURLs against an allowlist
+// of domains. A domain also admits its subdomains, so "example.com" allows
+// "shop.example.com" but not "badexample.com". An empty allowlist allows
+// every domain.
+func (w *Wish) ValidateURLDomains(allowed []string) field.ErrorList {
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	var errs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	if w.Spec.OfficialURL != "" {
+		if err := validateURLDomain(specPath.Child("officialURL"), w.Spec.OfficialURL, allowed); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for i, raw := range w.Spec.PurchaseURLs {
+		if err := validateURLDomain(specPath.Child("purchaseURLs").Index(i), raw, allowed); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func validateURLDomain(path *field.Path, raw string, allowed []string) *field.Error {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Hostname() == "" {
+		return field.Invalid(path, raw, "must be an absolute URL with a host")
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+
+	for _, domain := range allowed {
+		domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "*.")
+		domain = strings.TrimSuffix(strings.TrimPrefix(domain, "."), ".")
+
+		if domain != "" && (host == domain || strings.HasSuffix(host, "."+domain)) {
+			return nil
+		}
+	}
+
+	return field.Invalid(path, raw, fmt.Sprintf("domain %q is not in the allowed list", host))
+}
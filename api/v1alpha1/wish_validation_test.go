@@ -0,0 +1,295 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestWish_ValidateLengths(t *testing.T) {
+	t.Parallel()
+
+	limits := LengthLimits{MaxTitle: 5, MaxDescription: 10}
+
+	tests := []struct {
+		name        string
+		title       string
+		description string
+		wantFields  []string
+	}{
+		{"within limits", "abc", "short", nil},
+		{"title at ascii boundary", "abcde", "", nil},
+		{"title over ascii boundary", "abcdef", "", []string{"spec.title"}},
+		//nolint:gosmopolitan // CJK input is the point of the test
+		{"title at cjk boundary", "愿望清单好", "", nil},
+		//nolint:gosmopolitan // CJK input is the point of the test
+		{"title over cjk boundary", "愿望清单好吗", "", []string{"spec.title"}},
+		{"description at cyrillic boundary", "ok", "желаниеabc", nil},
+		{"description over cyrillic boundary", "ok", "желаниеabcd", []string{"spec.description"}},
+		{"both too long", "abcdef", strings.Repeat("x", 11), []string{"spec.title", "spec.description"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := &Wish{Spec: WishSpec{Title: tt.title, Description: tt.description}}
+			errs := wish.ValidateLengths(limits)
+
+			require.Len(t, errs, len(tt.wantFields))
+
+			for i, want := range tt.wantFields {
+				assert.Equal(t, want, errs[i].Field)
+				assert.Equal(t, field.ErrorTypeTooLong, errs[i].Type)
+			}
+		})
+	}
+}
+
+func TestWish_ValidateLengths_Disabled(t *testing.T) {
+	t.Parallel()
+
+	wish := &Wish{Spec: WishSpec{
+		Title:       strings.Repeat("t", DefaultMaxTitleLength*2),
+		Description: strings.Repeat("d", DefaultMaxDescriptionLength*2),
+	}}
+
+	assert.Empty(t, wish.ValidateLengths(LengthLimits{}))
+	assert.Len(t, wish.ValidateLengths(DefaultLengthLimits()), 2)
+}
+
+func TestWish_Validate(t *testing.T) {
+	t.Parallel()
+
+	wish := &Wish{Spec: WishSpec{Title: strings.Repeat("t", DefaultMaxTitleLength+1)}}
+
+	errs := wish.Validate(DefaultValidationOptions())
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.title", errs[0].Field)
+
+	assert.Empty(t, wish.Validate(ValidationOptions{Lengths: LengthLimits{MaxTitle: DefaultMaxTitleLength + 1}}))
+
+	wish = &Wish{Spec: WishSpec{Title: "Lamp", PurchaseURLs: []string{"https://shop.example.com/lamp", "https://scam.test/lamp"}}}
+	errs = wish.Validate(ValidationOptions{AllowedURLDomains: []string{"example.com"}})
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.purchaseURLs[1]", errs[0].Field)
+	assert.Contains(t, errs[0].Detail, `"scam.test"`)
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func TestWish_ValidatePrice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		min     *int64
+		max     *int64
+		wantErr bool
+	}{
+		{"no price", nil, nil, false},
+		{"min only", int64Ptr(1500), nil, false},
+		{"max only", nil, int64Ptr(2500), false},
+		{"range", int64Ptr(1500), int64Ptr(2500), false},
+		{"equal bounds", int64Ptr(2000), int64Ptr(2000), false},
+		{"inverted range", int64Ptr(2500), int64Ptr(1500), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := &Wish{Spec: WishSpec{Title: "t", PriceMin: tt.min, PriceMax: tt.max}}
+			errs := wish.ValidatePrice()
+
+			if !tt.wantErr {
+				assert.Empty(t, errs)
+
+				return
+			}
+
+			require.Len(t, errs, 1)
+			assert.Equal(t, "spec.priceMin", errs[0].Field)
+			assert.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+		})
+	}
+}
+
+func TestWish_ValidatePrice_FundTarget(t *testing.T) {
+	t.Parallel()
+
+	fund := &Wish{Spec: WishSpec{Title: "t", Fund: true}}
+
+	errs := fund.ValidatePrice()
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.fundTarget", errs[0].Field)
+	assert.Equal(t, field.ErrorTypeRequired, errs[0].Type)
+
+	fund.Spec.FundTarget = 50000
+	assert.Empty(t, fund.ValidatePrice())
+}
+
+func TestWish_ValidateURLDomains(t *testing.T) {
+	t.Parallel()
+
+	allowed := []string{"example.com", "*.Shop.org", ".market.net."}
+
+	tests := []struct {
+		name       string
+		url        string
+		wantDetail string
+	}{
+		{"exact domain", "https://example.com/item", ""},
+		{"subdomain", "https://www.example.com/item", ""},
+		{"nested subdomain", "https://eu.store.example.com/item", ""},
+		{"case and trailing dot", "https://WWW.Example.COM./item", ""},
+		{"port ignored", "https://example.com:8443/item", ""},
+		{"wildcard entry", "https://shop.org/item", ""},
+		{"wildcard entry subdomain", "https://a.shop.org/item", ""},
+		{"dotted entry", "https://market.net/item", ""},
+		{"suffix without dot boundary", "https://badexample.com/item", `domain "badexample.com" is not in the allowed list`},
+		{"allowed domain as prefix", "https://example.com.evil.org/item", `domain "example.com.evil.org" is not in the allowed list`},
+		{"other domain", "https://evil.org/item", `domain "evil.org" is not in the allowed list`},
+		{"no host", "/relative/path", "must be an absolute URL with a host"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := &Wish{Spec: WishSpec{PurchaseURLs: []string{tt.url}}}
+			errs := wish.ValidateURLDomains(allowed)
+
+			if tt.wantDetail == "" {
+				assert.Empty(t, errs)
+
+				return
+			}
+
+			require.Len(t, errs, 1)
+			assert.Equal(t, "spec.purchaseURLs[0]", errs[0].Field)
+			assert.Equal(t, tt.wantDetail, errs[0].Detail)
+		})
+	}
+}
+
+func TestWish_ValidateURLDomains_OfficialURL(t *testing.T) {
+	t.Parallel()
+
+	wish := &Wish{Spec: WishSpec{
+		OfficialURL:  "https://vendor.example.com/product",
+		PurchaseURLs: []string{"https://example.com/a", "https://evil.org/b"},
+	}}
+
+	errs := wish.ValidateURLDomains([]string{"example.com"})
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.purchaseURLs[1]", errs[0].Field)
+
+	errs = wish.ValidateURLDomains([]string{"evil.org"})
+	require.Len(t, errs, 2)
+	assert.Equal(t, "spec.officialURL", errs[0].Field)
+	assert.Equal(t, "spec.purchaseURLs[0]", errs[1].Field)
+}
+
+func TestWish_ValidateURLDomains_EmptyAllowlist(t *testing.T) {
+	t.Parallel()
+
+	wish := &Wish{Spec: WishSpec{
+		OfficialURL:  "https://anything.example/product",
+		PurchaseURLs: []string{"https://evil.org/b"},
+	}}
+
+	assert.Empty(t, wish.ValidateURLDomains(nil))
+}
+
+func TestWish_ValidateLinks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		link       Link
+		wantFields []string
+	}{
+		{"labeled https", Link{Label: "Review", URL: "https://reviews.example.com/lamp"}, nil},
+		{"unlabeled http", Link{URL: "http://example.com/specs.pdf"}, nil},
+		{"relative", Link{URL: "/specs.pdf"}, []string{"spec.links[0].url"}},
+		{"no host", Link{URL: "https://"}, []string{"spec.links[0].url"}},
+		{"other scheme", Link{URL: "javascript:alert(1)"}, []string{"spec.links[0].url"}},
+		{"ftp", Link{URL: "ftp://example.com/specs.pdf"}, []string{"spec.links[0].url"}},
+		{
+			"label too long",
+			Link{Label: strings.Repeat("я", MaxLinkLabelLength+1), URL: "https://example.com"},
+			[]string{"spec.links[0].label"},
+		},
+		{"label at limit", Link{Label: strings.Repeat("я", MaxLinkLabelLength), URL: "https://example.com"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := &Wish{Spec: WishSpec{Title: "t", Links: []Link{tt.link}}}
+
+			var fields []string
+			for _, err := range wish.ValidateLinks() {
+				fields = append(fields, err.Field)
+			}
+
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestWish_ValidateSlug(t *testing.T) {
+	t.Parallel()
+
+	others := []Wish{
+		{ObjectMeta: metav1.ObjectMeta{Name: "lego-set", Namespace: "gifts"}, Spec: WishSpec{Slug: "lego"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "lamp", Namespace: "other"}, Spec: WishSpec{Slug: "lamp"}},
+	}
+
+	tests := []struct {
+		name     string
+		wishName string
+		slug     string
+		wantType field.ErrorType
+	}{
+		{"empty slug", "kettle", "", ""},
+		{"unique slug", "kettle", "kettle", ""},
+		{"own slug on update", "lego-set", "lego", ""},
+		{"slug of another namespace", "desk-lamp", "lamp", ""},
+		{"collision", "lego-bricks", "lego", field.ErrorTypeDuplicate},
+		{"not a DNS label", "kettle", "Kettle_2", field.ErrorTypeInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := &Wish{
+				ObjectMeta: metav1.ObjectMeta{Name: tt.wishName, Namespace: "gifts"},
+				Spec:       WishSpec{Slug: tt.slug},
+			}
+			errs := wish.ValidateSlug(others)
+
+			if tt.wantType == "" {
+				assert.Empty(t, errs)
+
+				return
+			}
+
+			require.NotEmpty(t, errs)
+			assert.Equal(t, "spec.slug", errs[0].Field)
+			assert.Equal(t, tt.wantType, errs[0].Type)
+		})
+	}
+}
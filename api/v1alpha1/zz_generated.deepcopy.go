@@ -12,11 +12,30 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Link) DeepCopyInto(out *Link) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Link.
+func (in *Link) DeepCopy() *Link {
+	if in == nil {
+		return nil
+	}
+	out := new(Link)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Reservation) DeepCopyInto(out *Reservation) {
 	*out = *in
 	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
 	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	if in.PendingUntil != nil {
+		in, out := &in.PendingUntil, &out.PendingUntil
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Reservation.
@@ -29,6 +48,23 @@ func (in *Reservation) DeepCopy() *Reservation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservationRecord) DeepCopyInto(out *ReservationRecord) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.EndedAt.DeepCopyInto(&out.EndedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationRecord.
+func (in *ReservationRecord) DeepCopy() *ReservationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Wish) DeepCopyInto(out *Wish) {
 	*out = *in
@@ -96,6 +132,21 @@ func (in *WishSpec) DeepCopyInto(out *WishSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]Link, len(*in))
+		copy(*out, *in)
+	}
+	if in.PriceMin != nil {
+		in, out := &in.PriceMin, &out.PriceMin
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PriceMax != nil {
+		in, out := &in.PriceMax, &out.PriceMax
+		*out = new(int64)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]string, len(*in))
@@ -111,6 +162,15 @@ func (in *WishSpec) DeepCopyInto(out *WishSpec) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.NeededBy != nil {
+		in, out := &in.NeededBy, &out.NeededBy
+		*out = (*in).DeepCopy()
+	}
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WishSpec.
@@ -133,6 +193,30 @@ func (in *WishStatus) DeepCopyInto(out *WishStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]ReservationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextReminderAt != nil {
+		in, out := &in.NextReminderAt, &out.NextReminderAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Pledgers != nil {
+		in, out := &in.Pledgers, &out.Pledgers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReceivedAt != nil {
+		in, out := &in.ReceivedAt, &out.ReceivedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PriceCheckedAt != nil {
+		in, out := &in.PriceCheckedAt, &out.PriceCheckedAt
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
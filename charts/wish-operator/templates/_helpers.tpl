@@ -58,3 +58,10 @@ Create the name of the service account to use
 {{- default "default" .Values.serviceAccount.name }}
 {{- end }}
 {{- end }}
+
+{{/*
+Namespace of the leader election lease
+*/}}
+{{- define "wish-operator.leaseNamespace" -}}
+{{- default .Release.Namespace .Values.operator.leaderElectionNamespace }}
+{{- end }}
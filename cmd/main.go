@@ -4,30 +4,44 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"crypto/tls"
 	"errors"
 	"flag"
+	"fmt"
+	"math"
 	"net/http"
 	"os"
+	"strings"
+	"time"
+	_ "time/tzdata" // the scratch image has no zoneinfo for --notify-time-zone
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
 	"github.com/lexfrei/wish-operator/internal/controller"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/notify"
 	"github.com/lexfrei/wish-operator/internal/web"
+	webhookv1alpha1 "github.com/lexfrei/wish-operator/internal/webhook/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -48,12 +62,61 @@ func main() {
 	var metricsAddr string
 	var metricsCertPath, metricsCertName, metricsCertKey string
 	var webhookCertPath, webhookCertName, webhookCertKey string
+	var enableWebhooks bool
+	var defaultPriority int
+	var maxTitleLength, maxDescriptionLength int
+	var allowedURLDomains string
 	var enableLeaderElection bool
 	var probeAddr string
 	var webAddr string
 	var webNamespace string
 	var rateLimit float64
 	var rateBurst int
+	var faviconPath string
+	var notifyWebhookURL string
+	var notifyQuietHours string
+	var notifyTimeZone string
+	var activeWebhookURL string
+	var activeWebhookTimeout time.Duration
+	var publicURL string
+	var imageProxy bool
+	var wholeSets bool
+	var hidePrices bool
+	var webReadOnly bool
+	var csvAdminOnly bool
+	var maxReservationsPerGiver int
+	var popularThreshold int
+	var pollInterval time.Duration
+	var publicReservationDelay time.Duration
+	var tagPolicies string
+	var hostNamespaces string
+	var rejectUnknownHosts bool
+	var rateLimitExempt string
+	var trustedProxies string
+	var priorityWeeks string
+	var languageFallbacks string
+	var summaryLanguage string
+	var minPriority int
+	var maxListedWishes int
+	var maxRequestBody int64
+	var requestTimeout time.Duration
+	var reserveConfirmTTL time.Duration
+	var staleCacheMaxAge time.Duration
+	var autoExtend controller.ReservationAutoExtend
+	var historyRetention controller.HistoryRetention
+	var backoff controller.ReconcileBackoff
+	var overSubscription string
+	var expiryMetrics string
+	var reservedTagMetrics string
+	var expiryWarning time.Duration
+	var reservationGrace time.Duration
+	var reminderLead time.Duration
+	var priorityDecay time.Duration
+	var releaseOnEdit bool
+	var reconcileStaleAfter time.Duration
+	var syncPeriod time.Duration
+	var leaderElectionNamespace string
+	var leaderElectionID string
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var tlsOpts []func(*tls.Config)
@@ -61,14 +124,158 @@ func main() {
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.StringVar(&webAddr, "web-bind-address", ":8080", "The address the web server binds to.")
-	flag.StringVar(&webNamespace, "web-namespace", "default", "The namespace to watch for Wish resources.")
+	flag.StringVar(&webNamespace, "web-namespace", "",
+		"The namespace to watch for Wish resources. Defaults to the operator's own namespace, "+
+			"or \"default\" outside a cluster.")
 	flag.Float64Var(&rateLimit, "rate-limit", 30, "Rate limit requests per minute per IP.")
 	flag.IntVar(&rateBurst, "rate-burst", 10, "Rate limit burst size.")
+	flag.StringVar(&rateLimitExempt, "rate-limit-exempt", "",
+		"Comma-separated CIDR ranges or addresses, such as monitoring systems, that bypass the rate limit.")
+	flag.StringVar(&trustedProxies, "trusted-proxies", "",
+		"Comma-separated CIDR ranges or addresses of reverse proxies, such as the ingress controller, whose "+
+			"X-Forwarded-For and X-Real-IP headers name the client. Other peers are known by their own address.")
+	flag.StringVar(&notifyWebhookURL, "notify-webhook-url", "",
+		"URL to POST outbound notifications (e.g. owner messages) to. Leave empty to disable.")
+	flag.StringVar(&notifyQuietHours, "notify-quiet-hours", "",
+		"Daily HH:MM-HH:MM window, e.g. 22:00-08:00, during which notifications to --notify-webhook-url are held "+
+			"and sent once it ends. HTTP responses are unaffected. Empty disables it.")
+	flag.StringVar(&notifyTimeZone, "notify-time-zone", "UTC",
+		"IANA time zone of --notify-quiet-hours, e.g. Europe/Moscow.")
+	flag.StringVar(&activeWebhookURL, "active-webhook-url", "",
+		"URL the controller POSTs a wish_active_changed event to whenever a wish turns active or inactive, "+
+			"retried a few times on failure. Leave empty to disable.")
+	flag.DurationVar(&activeWebhookTimeout, "active-webhook-timeout", 5*time.Second,
+		"How long each POST to --active-webhook-url may take.")
+	flag.StringVar(&publicURL, "public-url", "",
+		"Externally reachable base URL of the web UI, e.g. https://wishes.example.com, for links in notifications. "+
+			"Leave empty to send paths relative to the site.")
+	flag.Int64Var(&maxRequestBody, "max-request-body", 1<<20,
+		"Largest request body in bytes accepted by form endpoints; larger requests get 413.")
+	flag.DurationVar(&requestTimeout, "request-timeout", 30*time.Second,
+		"Longest a web request may take before it is cancelled with 503. Use 0 to disable.")
+	flag.DurationVar(&reserveConfirmTTL, "reserve-confirm-ttl", 0,
+		"Ask givers to confirm reservations, holding them this long until confirmed. "+
+			"0 keeps the single-step reserve form.")
+	flag.StringVar(&tagPolicies, "tag-policies", "",
+		"Comma-separated tag=policy pairs setting how many reservations tagged wishes accept: "+
+			"single (one reservation) or multi (any number, ignoring quantity). "+
+			"A wish follows its first tag listed here.")
+	flag.StringVar(&hostNamespaces, "host-namespaces", "",
+		"Comma-separated host=namespace pairs serving each host the wishlist of its namespace, "+
+			"e.g. alice.example.com=alice,bob.example.com=bob. Other hosts get --web-namespace.")
+	flag.BoolVar(&rejectUnknownHosts, "reject-unknown-hosts", false,
+		"Answer hosts missing from --host-namespaces with 404 instead of the --web-namespace wishlist.")
+	flag.StringVar(&priorityWeeks, "priority-weeks", "",
+		"Comma-separated priority=weeks pairs preselecting the reservation length for wishes of that priority, "+
+			"e.g. 5=1,4=2 so high-priority wishes come back sooner if the giver stalls. Other priorities default to 4.")
+	flag.StringVar(&languageFallbacks, "language-fallbacks", "",
+		"Comma-separated lang=chain pairs naming the languages tried, colon-separated and in order, "+
+			"when a translation is missing, before English, e.g. zh=ru. Other languages fall back to English.")
+	flag.StringVar(&summaryLanguage, "summary-language", i18n.DefaultLang,
+		"Language of the status.summary line shown by kubectl get wishes (en, ru or zh).")
+	flag.IntVar(&minPriority, "min-priority", 0,
+		"Hide wishes below this priority (0-5) from the public list; ?min_priority= overrides it per request.")
+	flag.IntVar(&maxListedWishes, "max-listed-wishes", 0,
+		"Most wishes the public list shows, keeping the first in list order, as a guard against huge pages. "+
+			"Use 0 for no limit.")
+	flag.BoolVar(&imageProxy, "image-proxy", false,
+		"Serve wish images through the web server instead of linking to the original hosts.")
+	flag.BoolVar(&wholeSets, "whole-sets", false,
+		"Reserve wishes that share a partOfSet together, refusing if any of them is unavailable.")
+	flag.BoolVar(&hidePrices, "hide-prices", false,
+		"Keep wish prices off public pages and the JSON API. Fund targets and progress are still shown.")
+	flag.BoolVar(&webReadOnly, "web-read-only", false,
+		"Serve listings and wish pages only, refusing reservations and admin changes with 405, "+
+			"for a public instance while writes go through a separate internal one.")
+	flag.BoolVar(&csvAdminOnly, "csv-admin-only", false,
+		"Serve the CSV export at /wishes.csv only to requests with the admin token ("+adminTokenEnv+").")
+	flag.IntVar(&maxReservationsPerGiver, "max-reservations-per-giver", 0,
+		"Most active reservations a single giver may hold across all wishes in the namespace. Use 0 for no limit.")
+	flag.DurationVar(&pollInterval, "poll-interval", 0,
+		"How often the wish list in the browser refreshes itself, at least 1s. Use 0 to disable.")
+	flag.DurationVar(&publicReservationDelay, "public-reservation-delay", 0,
+		"Show reservations on public pages only once they are this old, so last-minute reservations aren't "+
+			"obvious. Requests with the admin token and the giver who reserved see them right away. Use 0 to disable.")
+	flag.IntVar(&popularThreshold, "popular-threshold", 0,
+		"Badge wishes as popular once status.demand, their reservations and pledgers plus confirmed past "+
+			"reservations, reaches this. Use 0 for no badge.")
+	flag.DurationVar(&staleCacheMaxAge, "stale-cache-max-age", 5*time.Minute,
+		"How long the last good wish list may be served while the Kubernetes API is unreachable. Use 0 to disable.")
+	flag.DurationVar(&autoExtend.Step, "reservation-extend-step", 0,
+		"Keep reservations on active wishes alive by pushing their expiry this far ahead. "+
+			"Requires --reservation-max-hold; 0 disables.")
+	flag.DurationVar(&autoExtend.MaxHold, "reservation-max-hold", 0,
+		"Longest a reservation can be held through auto-extension, counted from when it was made.")
+	flag.IntVar(&historyRetention.MaxEntries, "history-max-entries", 20,
+		"Most cleared reservations kept in each wish's status.history, oldest dropped first. 0 keeps all.")
+	flag.DurationVar(&historyRetention.MaxAge, "history-max-age", 0,
+		"Drop status.history entries that ended longer ago than this. 0 keeps them regardless of age.")
+	flag.DurationVar(&backoff.BaseDelay, "reconcile-backoff-base", 0,
+		"Delay before retrying a failed reconcile, doubled on each further failure. "+
+			"Requires --reconcile-backoff-max; 0 keeps the controller-runtime default.")
+	flag.DurationVar(&backoff.MaxDelay, "reconcile-backoff-max", 0,
+		"Upper bound for the retry delay after repeated reconcile failures.")
+	flag.StringVar(&overSubscription, "over-subscription", string(controller.OverSubscriptionFlag),
+		"What to do when active reservations exceed a lowered quantity: "+
+			"flag (set the OverSubscribed condition) or trim (release the oldest reservations). "+
+			"Avoid trim together with multi tag policies, which reserve past quantity by design.")
+	flag.DurationVar(&expiryWarning, "expiry-warning", 0,
+		"How long before a wish's TTL runs out to set its ExpiringSoon condition and emit an event, "+
+			"so the owner can extend it. 0 disables the warning.")
+	flag.DurationVar(&reservationGrace, "reservation-grace", 0,
+		"How long an expired reservation keeps the wish reserved before it is cleared, "+
+			"so a giver mid-checkout doesn't lose it. 0 clears reservations on expiry.")
+	flag.DurationVar(&reminderLead, "reminder-lead", 48*time.Hour,
+		"How long before a reservation expires its giver is due a reminder, published as status.nextReminderAt "+
+			"for external reminder jobs. 0 disables it.")
+	flag.DurationVar(&priorityDecay, "priority-decay", 0,
+		"Lower a wish's effective priority by one for each period of age, down to 1, so fresh wishes surface "+
+			"on long-lived lists; e.g. 720h demotes monthly. Spec.priority is kept. 0 disables decay.")
+	flag.BoolVar(&releaseOnEdit, "release-on-edit", false,
+		"Release all reservations of a wish whose title or official URL changes, recording them in its history "+
+			"and posting a reservations_released event with their count to --notify-webhook-url. Givers are not told "+
+			"directly, since no contact of theirs is stored. Wishes annotated "+
+			wishlistv1alpha1.AnnotationReleaseOnEdit+"=true opt in on their own.")
+	flag.DurationVar(&reconcileStaleAfter, "reconcile-stale-after", 0,
+		"Fail the readiness probe once no Wish reconcile has succeeded for this long; keep it above --sync-period. "+
+			"0 disables the check.")
+	flag.StringVar(&expiryMetrics, "expiry-metrics", "",
+		"Export wish_seconds_until_expiry and wish_reservation_seconds_until_expiry gauges labeled per "+
+			"wish (name and namespace) or per namespace (soonest expiry, bounded cardinality). Empty disables them.")
+	flag.StringVar(&reservedTagMetrics, "reserved-tag-metrics", "",
+		"Comma-separated tags to export wish_reserved_by_tag_total for, counting wishes with an active "+
+			"reservation per tag and namespace. Other tags are not labeled. Empty disables the metric.")
+	flag.StringVar(&faviconPath, "favicon-path", "", "Path to a favicon file to serve instead of the built-in icon.")
+	flag.DurationVar(&syncPeriod, "sync-period", time.Hour,
+		"How often every Wish is re-reconciled even without changes. Use 0 for the controller-runtime default.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.StringVar(&leaderElectionNamespace, "leader-elect-namespace", "",
+		"Namespace of the leader election lease. Defaults to the namespace the operator runs in.")
+	flag.StringVar(&leaderElectionID, "leader-elect-id", defaultLeaderElectionID,
+		"Name of the leader election lease.")
 	flag.BoolVar(&secureMetrics, "metrics-secure", true,
 		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"Serve the Wish defaulting webhook, which fills the structured price from msrp when it is empty, "+
+			"and the validating webhook, which enforces --max-title-length, --max-description-length and "+
+			"--allowed-url-domains. "+
+			"Requires a serving certificate (--webhook-cert-path) and the config/webhook manifests.")
+	flag.IntVar(&defaultPriority, "default-priority", 0,
+		"Priority (1-5) the webhook gives wishes created without one; annotate a wish with "+
+			"wishlist.k8s.lex.la/explicit-priority=true to keep a 0. Use 0 to leave priorities unset. "+
+			"Needs --enable-webhooks.")
+	flag.IntVar(&maxTitleLength, "max-title-length", wishlistv1alpha1.DefaultMaxTitleLength,
+		"Longest wish title, in characters, that the validating webhook and POST /admin/wishes accept. "+
+			"Use 0 for no limit beyond the CRD's.")
+	flag.IntVar(&maxDescriptionLength, "max-description-length", wishlistv1alpha1.DefaultMaxDescriptionLength,
+		"Longest wish description, in characters, that the validating webhook and POST /admin/wishes accept. "+
+			"Use 0 for no limit beyond the CRD's.")
+	flag.StringVar(&allowedURLDomains, "allowed-url-domains", "",
+		"Comma-separated shop domains, such as ozon.ru,amazon.com, that wish official and purchase URLs must "+
+			"belong to, subdomains included; enforced by the validating webhook and POST /admin/wishes. "+
+			"Empty allows every domain.")
 	flag.StringVar(&webhookCertPath, "webhook-cert-path", "", "The directory that contains the webhook certificate.")
 	flag.StringVar(&webhookCertName, "webhook-cert-name", "tls.crt", "The name of the webhook certificate file.")
 	flag.StringVar(&webhookCertKey, "webhook-cert-key", "tls.key", "The name of the webhook key file.")
@@ -153,37 +360,149 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
-		Scheme:                 scheme,
-		Metrics:                metricsServerOptions,
-		WebhookServer:          webhookServer,
-		HealthProbeBindAddress: probeAddr,
-		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "b1249f94.k8s.lex.la",
-		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
-		// when the Manager ends. This requires the binary to immediately end when the
-		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
-		// speeds up voluntary leader transitions as the new leader don't have to wait
-		// LeaseDuration time first.
-		//
-		// In the default scaffold provided, the program ends immediately after
-		// the manager stops, so would be fine to enable this option. However,
-		// if you are doing or is intended to do any operation such as perform cleanups
-		// after the manager stops then its usage might be unsafe.
-		// LeaderElectionReleaseOnCancel: true,
-	})
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), managerConfig{
+		metrics:        metricsServerOptions,
+		webhookServer:  webhookServer,
+		probeAddr:      probeAddr,
+		leaderElection: enableLeaderElection,
+		leaseNamespace: leaderElectionNamespace,
+		leaseID:        leaderElectionID,
+		syncPeriod:     syncPeriod,
+	}.options())
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
+	if !i18n.IsSupported(summaryLanguage) {
+		setupLog.Error(fmt.Errorf("unsupported language %q", summaryLanguage), "invalid --summary-language")
+		os.Exit(1)
+	}
+
+	quietHours, err := notify.ParseQuietHours(notifyQuietHours, notifyTimeZone)
+	if err != nil {
+		setupLog.Error(err, "invalid --notify-quiet-hours or --notify-time-zone")
+		os.Exit(1)
+	}
+
+	// Owner and giver notifications from both the controller and the web
+	// server share one sender, so quiet hours hold them in a single queue
+	var notifier notify.Sender
+	if notifyWebhookURL != "" {
+		notifier = notify.NewWebhook(notifyWebhookURL)
+		if quietHours.Enabled() {
+			notifier = notify.NewQuietSender(notifier, quietHours, clock.RealClock{})
+		}
+	}
+
+	overSubscriptionMode, err := controller.ParseOverSubscriptionMode(overSubscription)
+	if err != nil {
+		setupLog.Error(err, "invalid --over-subscription")
+		os.Exit(1)
+	}
+
+	var metrics *controller.ExpiryMetrics
+	if expiryMetrics != "" {
+		labels, err := controller.ParseExpiryLabels(expiryMetrics)
+		if err != nil {
+			setupLog.Error(err, "invalid --expiry-metrics")
+			os.Exit(1)
+		}
+
+		metrics = controller.NewExpiryMetrics(labels)
+		if err := metrics.Register(ctrlmetrics.Registry); err != nil {
+			setupLog.Error(err, "unable to register expiry metrics")
+			os.Exit(1)
+		}
+	}
+
+	var tagMetrics *controller.ReservedTagMetrics
+	metricTags, err := controller.ParseMetricTags(reservedTagMetrics)
+	if err != nil {
+		setupLog.Error(err, "invalid --reserved-tag-metrics")
+		os.Exit(1)
+	}
+	if len(metricTags) > 0 {
+		tagMetrics = controller.NewReservedTagMetrics(metricTags)
+		if err := tagMetrics.Register(ctrlmetrics.Registry); err != nil {
+			setupLog.Error(err, "unable to register reserved tag metrics")
+			os.Exit(1)
+		}
+	}
+
+	health := controller.NewReconcileHealth()
+	if err := health.Register(ctrlmetrics.Registry); err != nil {
+		setupLog.Error(err, "unable to register reconcile health metric")
+		os.Exit(1)
+	}
+
+	var activeHook *controller.EventHook
+	if activeWebhookURL != "" {
+		if activeWebhookTimeout <= 0 {
+			setupLog.Error(fmt.Errorf("want a positive duration, got %s", activeWebhookTimeout),
+				"invalid --active-webhook-timeout")
+			os.Exit(1)
+		}
+		activeHook = &controller.EventHook{
+			Sender: notify.NewWebhookWithTimeout(activeWebhookURL, activeWebhookTimeout),
+		}
+	}
+
+	var releaseHook *controller.EventHook
+	if notifier != nil {
+		releaseHook = &controller.EventHook{Sender: notifier}
+	}
+
 	if err := (&controller.WishReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AutoExtend:       autoExtend,
+		HistoryRetention: historyRetention,
+		Backoff:          backoff,
+		OverSubscription: overSubscriptionMode,
+		Metrics:          metrics,
+		TagMetrics:       tagMetrics,
+		ExpiryWarning:    expiryWarning,
+		ReservationGrace: reservationGrace,
+		ReminderLead:     reminderLead,
+		PriorityDecay:    priorityDecay,
+		Health:           health,
+		SummaryLanguage:  strings.ToLower(summaryLanguage),
+		Recorder:         mgr.GetEventRecorder("wish-controller"),
+		ActiveHook:       activeHook,
+		ReleaseOnEdit:    releaseOnEdit,
+		ReleaseHook:      releaseHook,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Wish")
 		os.Exit(1)
 	}
+	if defaultPriority < 0 || defaultPriority > 5 {
+		setupLog.Error(fmt.Errorf("want 0 to 5, got %d", defaultPriority), "invalid --default-priority")
+		os.Exit(1)
+	}
+	if maxTitleLength < 0 {
+		setupLog.Error(fmt.Errorf("want 0 or more, got %d", maxTitleLength), "invalid --max-title-length")
+		os.Exit(1)
+	}
+	if maxDescriptionLength < 0 {
+		setupLog.Error(fmt.Errorf("want 0 or more, got %d", maxDescriptionLength), "invalid --max-description-length")
+		os.Exit(1)
+	}
+	domains, err := web.ParseURLDomains(allowedURLDomains)
+	if err != nil {
+		setupLog.Error(err, "invalid --allowed-url-domains")
+		os.Exit(1)
+	}
+	validation := wishlistv1alpha1.ValidationOptions{
+		Lengths:           wishlistv1alpha1.LengthLimits{MaxTitle: maxTitleLength, MaxDescription: maxDescriptionLength},
+		AllowedURLDomains: domains,
+	}
+	if enableWebhooks {
+		if err := webhookv1alpha1.SetupWishWebhookWithManager(mgr, int32(defaultPriority), validation); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Wish")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -194,9 +513,159 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if reconcileStaleAfter > 0 {
+		if err := mgr.AddReadyzCheck("reconcile", health.ReadyCheck(reconcileStaleAfter, mgr.Elected())); err != nil {
+			setupLog.Error(err, "unable to set up reconcile ready check")
+			os.Exit(1)
+		}
+	}
 
 	// Start web server
-	webServer := web.NewServer(mgr.GetClient(), webNamespace, rateLimit, rateBurst)
+	// The admin token and view password come from the environment so they stay out of the process arguments.
+	webOpts := []web.Option{
+		web.WithCachedReader(mgr.GetCache()),
+		web.WithFaviconPath(faviconPath),
+		web.WithAdminToken(os.Getenv(adminTokenEnv)),
+		web.WithViewPassword(os.Getenv(viewPasswordEnv)),
+		web.WithStaleCache(staleCacheMaxAge),
+		web.WithMaxRequestBody(maxRequestBody),
+		web.WithRequestTimeout(requestTimeout),
+		web.WithPublicURL(publicURL),
+		web.WithLengthLimits(validation.Lengths),
+		web.WithAllowedURLDomains(validation.AllowedURLDomains),
+	}
+	if notifier != nil {
+		webOpts = append(webOpts, web.WithNotifier(notifier))
+	}
+	if imageProxy {
+		webOpts = append(webOpts, web.WithImageProxy(nil))
+	}
+	if wholeSets {
+		webOpts = append(webOpts, web.WithWholeSets())
+	}
+	if hidePrices {
+		webOpts = append(webOpts, web.WithHiddenPrices())
+	}
+	if webReadOnly {
+		webOpts = append(webOpts, web.WithReadOnly())
+	}
+	if csvAdminOnly {
+		if os.Getenv(adminTokenEnv) == "" {
+			setupLog.Error(fmt.Errorf("needs %s to be set", adminTokenEnv), "invalid --csv-admin-only")
+			os.Exit(1)
+		}
+		webOpts = append(webOpts, web.WithAdminOnlyCSV())
+	}
+	if maxReservationsPerGiver < 0 {
+		setupLog.Error(fmt.Errorf("want 0 or more, got %d", maxReservationsPerGiver), "invalid --max-reservations-per-giver")
+		os.Exit(1)
+	}
+	if maxReservationsPerGiver > 0 {
+		webOpts = append(webOpts, web.WithReserverLimit(maxReservationsPerGiver))
+	}
+	if popularThreshold < 0 || popularThreshold > math.MaxInt32 {
+		setupLog.Error(fmt.Errorf("want 0 to %d, got %d", math.MaxInt32, popularThreshold), "invalid --popular-threshold")
+		os.Exit(1)
+	}
+	if popularThreshold > 0 {
+		webOpts = append(webOpts, web.WithPopularThreshold(int32(popularThreshold)))
+	}
+	if pollInterval < 0 || (pollInterval > 0 && pollInterval < time.Second) {
+		setupLog.Error(fmt.Errorf("want 0 or at least 1s, got %s", pollInterval), "invalid --poll-interval")
+		os.Exit(1)
+	}
+	if pollInterval > 0 {
+		webOpts = append(webOpts, web.WithPollInterval(pollInterval))
+	}
+	if publicReservationDelay < 0 {
+		setupLog.Error(fmt.Errorf("want 0 or more, got %s", publicReservationDelay), "invalid --public-reservation-delay")
+		os.Exit(1)
+	}
+	if publicReservationDelay > 0 {
+		webOpts = append(webOpts, web.WithReservationDelay(publicReservationDelay))
+	}
+	if reserveConfirmTTL > 0 {
+		webOpts = append(webOpts, web.WithReserveConfirmation(reserveConfirmTTL))
+	}
+	exempt, err := web.ParseCIDRs(rateLimitExempt)
+	if err != nil {
+		setupLog.Error(err, "invalid --rate-limit-exempt")
+		os.Exit(1)
+	}
+	if len(exempt) > 0 {
+		webOpts = append(webOpts, web.WithRateLimitExempt(exempt))
+	}
+	proxies, err := web.ParseCIDRs(trustedProxies)
+	if err != nil {
+		setupLog.Error(err, "invalid --trusted-proxies")
+		os.Exit(1)
+	}
+	if len(proxies) > 0 {
+		webOpts = append(webOpts, web.WithTrustedProxies(proxies))
+	}
+
+	policies, err := web.ParseTagPolicies(tagPolicies)
+	if err != nil {
+		setupLog.Error(err, "invalid --tag-policies")
+		os.Exit(1)
+	}
+	if len(policies) > 0 {
+		webOpts = append(webOpts, web.WithTagPolicies(policies))
+	}
+
+	hosts, err := web.ParseHostNamespaces(hostNamespaces)
+	if err != nil {
+		setupLog.Error(err, "invalid --host-namespaces")
+		os.Exit(1)
+	}
+	if len(hosts) > 0 {
+		webOpts = append(webOpts, web.WithHostNamespaces(hosts))
+	}
+	if rejectUnknownHosts {
+		webOpts = append(webOpts, web.WithUnknownHostsRejected())
+	}
+	if minPriority < 0 || minPriority > 5 {
+		setupLog.Error(fmt.Errorf("want 0 to 5, got %d", minPriority), "invalid --min-priority")
+		os.Exit(1)
+	}
+	if minPriority > 0 {
+		webOpts = append(webOpts, web.WithMinPriority(int32(minPriority)))
+	}
+	if maxListedWishes < 0 {
+		setupLog.Error(fmt.Errorf("want 0 or more, got %d", maxListedWishes), "invalid --max-listed-wishes")
+		os.Exit(1)
+	}
+	if maxListedWishes > 0 {
+		webOpts = append(webOpts, web.WithMaxListed(maxListedWishes))
+	}
+	weeksByPriority, err := web.ParsePriorityWeeks(priorityWeeks)
+	if err != nil {
+		setupLog.Error(err, "invalid --priority-weeks")
+		os.Exit(1)
+	}
+	if len(weeksByPriority) > 0 {
+		webOpts = append(webOpts, web.WithPriorityWeeks(weeksByPriority))
+	}
+
+	fallbackChains, err := i18n.ParseFallbacks(languageFallbacks)
+	if err == nil {
+		err = i18n.SetFallbacks(fallbackChains)
+	}
+	if err != nil {
+		setupLog.Error(err, "invalid --language-fallbacks")
+		os.Exit(1)
+	}
+
+	webNamespace = resolveNamespace(webNamespace, serviceAccountNamespaceFile)
+	if err := web.IndexSlug(context.Background(), mgr.GetFieldIndexer()); err != nil {
+		setupLog.Error(err, "unable to index wishes by slug")
+		os.Exit(1)
+	}
+	webServer := web.NewServer(mgr.GetClient(), webNamespace, rateLimit, rateBurst, webOpts...)
+	if err := webServer.ValidateTemplates(); err != nil {
+		setupLog.Error(err, "web templates failed to render")
+		os.Exit(1)
+	}
 	if err := mgr.Add(&webRunnable{addr: webAddr, handler: webServer.Handler()}); err != nil {
 		setupLog.Error(err, "unable to add web server")
 		os.Exit(1)
@@ -210,6 +679,90 @@ func main() {
 	}
 }
 
+// adminTokenEnv names the environment variable holding the bearer token for
+// the web server's /admin endpoints. Unset disables them.
+const adminTokenEnv = "WISH_ADMIN_TOKEN"
+
+// viewPasswordEnv names the environment variable holding the shared password
+// that gates the web UI behind Basic Auth. Unset leaves it open.
+const viewPasswordEnv = "WISH_VIEW_PASSWORD"
+
+// defaultLeaderElectionID is the lease name used when none is configured.
+const defaultLeaderElectionID = "b1249f94.k8s.lex.la"
+
+// serviceAccountNamespaceFile holds the pod's own namespace when running in-cluster.
+const serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// defaultWebNamespace is watched when no namespace is configured or discovered.
+const defaultWebNamespace = "default"
+
+// resolveNamespace returns the configured namespace, falling back to the one
+// in namespaceFile and then to defaultWebNamespace.
+func resolveNamespace(configured, namespaceFile string) string {
+	if configured != "" {
+		return configured
+	}
+
+	if data, err := os.ReadFile(namespaceFile); err == nil {
+		if namespace := strings.TrimSpace(string(data)); namespace != "" {
+			return namespace
+		}
+	}
+
+	return defaultWebNamespace
+}
+
+// managerConfig collects the flag-driven settings used to build the manager options.
+type managerConfig struct {
+	metrics        metricsserver.Options
+	webhookServer  webhook.Server
+	probeAddr      string
+	leaderElection bool
+	leaseNamespace string
+	leaseID        string
+	syncPeriod     time.Duration
+}
+
+// options builds the controller manager options.
+func (c managerConfig) options() ctrl.Options {
+	opts := ctrl.Options{
+		Scheme:                 scheme,
+		Metrics:                c.metrics,
+		WebhookServer:          c.webhookServer,
+		HealthProbeBindAddress: c.probeAddr,
+		LeaderElection:         c.leaderElection,
+		LeaderElectionID:       cmp.Or(c.leaseID, defaultLeaderElectionID),
+		// An empty namespace lets controller-runtime use the pod's own namespace.
+		LeaderElectionNamespace: c.leaseNamespace,
+		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
+		// when the Manager ends. This requires the binary to immediately end when the
+		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
+		// speeds up voluntary leader transitions as the new leader don't have to wait
+		// LeaseDuration time first.
+		//
+		// In the default scaffold provided, the program ends immediately after
+		// the manager stops, so would be fine to enable this option. However,
+		// if you are doing or is intended to do any operation such as perform cleanups
+		// after the manager stops then its usage might be unsafe.
+		// LeaderElectionReleaseOnCancel: true,
+
+		// The web server reads only its own secret santa ConfigMap, so
+		// ConfigMaps bypass the cache rather than being watched cluster-wide.
+		Client: client.Options{
+			Cache: &client.CacheOptions{DisableFor: []client.Object{&corev1.ConfigMap{}}},
+		},
+	}
+
+	// A periodic full resync re-queues every Wish so stale Active or
+	// reservation state self-corrects even if an event or requeue was missed.
+	if c.syncPeriod > 0 {
+		syncPeriod := c.syncPeriod
+		opts.Cache = cache.Options{SyncPeriod: &syncPeriod}
+	}
+
+	return opts
+}
+
 // webRunnable implements manager.Runnable for the web server.
 type webRunnable struct {
 	addr    string
@@ -238,3 +791,9 @@ func (w *webRunnable) Start(ctx context.Context) error {
 
 	return nil
 }
+
+// NeedLeaderElection reports false so every replica serves the web UI, not
+// only the elected leader.
+func (w *webRunnable) NeedLeaderElection() bool {
+	return false
+}
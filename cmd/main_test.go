@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagerConfig_SyncPeriod(t *testing.T) {
+	t.Parallel()
+
+	opts := managerConfig{syncPeriod: 30 * time.Minute}.options()
+
+	require.NotNil(t, opts.Cache.SyncPeriod)
+	assert.Equal(t, 30*time.Minute, *opts.Cache.SyncPeriod)
+}
+
+func TestManagerConfig_SyncPeriodDefault(t *testing.T) {
+	t.Parallel()
+
+	opts := managerConfig{}.options()
+
+	assert.Nil(t, opts.Cache.SyncPeriod, "zero keeps the controller-runtime default")
+}
+
+func TestManagerConfig_LeaderElection(t *testing.T) {
+	t.Parallel()
+
+	opts := managerConfig{
+		leaderElection: true,
+		leaseNamespace: "wish-system",
+		leaseID:        "wish-operator-lock",
+	}.options()
+
+	assert.True(t, opts.LeaderElection)
+	assert.Equal(t, "wish-system", opts.LeaderElectionNamespace)
+	assert.Equal(t, "wish-operator-lock", opts.LeaderElectionID)
+}
+
+func TestManagerConfig_LeaderElectionDefaults(t *testing.T) {
+	t.Parallel()
+
+	opts := managerConfig{}.options()
+
+	assert.False(t, opts.LeaderElection)
+	assert.Empty(t, opts.LeaderElectionNamespace, "empty uses the pod namespace")
+	assert.Equal(t, defaultLeaderElectionID, opts.LeaderElectionID)
+}
+
+func TestWebRunnable_RunsOnEveryReplica(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, (&webRunnable{}).NeedLeaderElection())
+}
+
+func TestResolveNamespace(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	present := filepath.Join(dir, "namespace")
+	require.NoError(t, os.WriteFile(present, []byte("wish-system\n"), 0o600))
+
+	blank := filepath.Join(dir, "blank")
+	require.NoError(t, os.WriteFile(blank, []byte("  \n"), 0o600))
+
+	missing := filepath.Join(dir, "missing")
+
+	tests := []struct {
+		name       string
+		configured string
+		file       string
+		want       string
+	}{
+		{"configured wins over file", "wishes", present, "wishes"},
+		{"file present", "", present, "wish-system"},
+		{"file blank", "", blank, defaultWebNamespace},
+		{"file absent", "", missing, defaultWebNamespace},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, resolveNamespace(tt.configured, tt.file))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package controller
+
+import (
+	"time"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// decayedPriority returns the wish's priority lowered by one for every full
+// rate of age, floored at 1, and when it next drops. The next time is zero
+// once the priority can drop no further. Wishes without a priority above 1,
+// or a rate of zero, do not decay: the result is then 0, leaving sorting to
+// Spec.Priority.
+func decayedPriority(wish *wishlistv1alpha1.Wish, rate time.Duration, now time.Time) (int32, time.Time) {
+	priority := wish.Spec.Priority
+	if rate <= 0 || priority <= 1 {
+		return 0, time.Time{}
+	}
+
+	age := now.Sub(wish.CreationTimestamp.Time)
+	if age < 0 {
+		age = 0
+	}
+
+	steps := int64(age / rate)
+	if steps >= int64(priority-1) {
+		return 1, time.Time{}
+	}
+
+	return priority - int32(steps), wish.CreationTimestamp.Add(time.Duration(steps+1) * rate)
+}
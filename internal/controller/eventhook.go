@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/notify"
+)
+
+const (
+	// eventHookAttempts is how many times an event is posted before it is
+	// dropped.
+	eventHookAttempts = 3
+
+	// eventHookBacklog bounds the events waiting for delivery; more are
+	// dropped rather than blocking reconciles.
+	eventHookBacklog = 100
+
+	// defaultEventHookRetryDelay is the wait before the first retry.
+	defaultEventHookRetryDelay = time.Second
+)
+
+// EventSender delivers outbound events, such as a notify.Webhook.
+type EventSender interface {
+	Send(ctx context.Context, event notify.Event) error
+}
+
+// EventHook posts wish events to external automation: Active transitions,
+// e.g. for something toggling a display, and reservations released on edit.
+// Delivery is best effort: events are posted in order by a single background
+// worker, each retried a few times with a doubling delay and then dropped,
+// and never fail the reconcile.
+type EventHook struct {
+	// Sender delivers the events.
+	Sender EventSender
+
+	// RetryDelay is the wait before the first retry, doubled for each
+	// further one. Zero uses defaultEventHookRetryDelay.
+	RetryDelay time.Duration
+
+	start sync.Once
+	queue chan notify.Event
+}
+
+// notify queues the wish's new Active state for delivery.
+func (h *EventHook) notify(wish *wishlistv1alpha1.Wish, now time.Time) {
+	if h == nil {
+		return
+	}
+
+	active := wish.Status.Active
+	h.enqueue(notify.Event{
+		Type:      notify.EventWishActiveChanged,
+		Namespace: wish.Namespace,
+		Wish:      wish.Name,
+		Title:     wish.Spec.Title,
+		Active:    &active,
+		Time:      now,
+	})
+}
+
+// released queues a reservations_released event for the count reservations
+// dropped when the wish was edited.
+func (h *EventHook) released(wish *wishlistv1alpha1.Wish, count int32, now time.Time) {
+	if h == nil {
+		return
+	}
+
+	h.enqueue(notify.Event{
+		Type:      notify.EventReservationsReleased,
+		Namespace: wish.Namespace,
+		Wish:      wish.Name,
+		Title:     wish.Spec.Title,
+		Released:  count,
+		Time:      now,
+	})
+}
+
+// enqueue hands the event to the delivery worker, starting it on first use.
+func (h *EventHook) enqueue(event notify.Event) {
+	h.start.Do(func() {
+		h.queue = make(chan notify.Event, eventHookBacklog)
+		go h.deliver()
+	})
+
+	select {
+	case h.queue <- event:
+	default:
+		logf.Log.WithName("event-hook").Info("Dropped notification, backlog full",
+			"type", event.Type, "wish", event.Wish, "namespace", event.Namespace)
+	}
+}
+
+// deliver posts queued events one at a time, for as long as the process runs.
+func (h *EventHook) deliver() {
+	log := logf.Log.WithName("event-hook")
+
+	for event := range h.queue {
+		delay := h.RetryDelay
+		if delay <= 0 {
+			delay = defaultEventHookRetryDelay
+		}
+
+		for attempt := 1; ; attempt++ {
+			err := h.Sender.Send(context.Background(), event)
+			if err == nil {
+				break
+			}
+
+			if attempt == eventHookAttempts {
+				log.Error(err, "Dropped notification",
+					"type", event.Type, "wish", event.Wish, "namespace", event.Namespace, "attempts", attempt)
+
+				break
+			}
+
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+}
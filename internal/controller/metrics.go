@@ -0,0 +1,370 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// neverExpires is reported for wishes without a TTL and wishes without
+// reservations, so dashboards can tell them apart from imminent expiry.
+const neverExpires = -1
+
+// ExpiryLabels selects how finely the expiry gauges are labeled.
+type ExpiryLabels string
+
+const (
+	// ExpiryLabelsWish labels the gauges by wish name and namespace: one
+	// series per wish.
+	ExpiryLabelsWish ExpiryLabels = "wish"
+
+	// ExpiryLabelsNamespace labels the gauges by namespace only, reporting
+	// the soonest expiry among the namespace's wishes. It keeps cardinality
+	// bounded on large lists.
+	ExpiryLabelsNamespace ExpiryLabels = "namespace"
+)
+
+// ParseExpiryLabels validates a labeling name.
+func ParseExpiryLabels(name string) (ExpiryLabels, error) {
+	switch labels := ExpiryLabels(name); labels {
+	case ExpiryLabelsWish, ExpiryLabelsNamespace:
+		return labels, nil
+	default:
+		return "", fmt.Errorf("unknown expiry metric labels %q (want wish or namespace)", name)
+	}
+}
+
+// ExpiryMetrics exposes how long until wishes and their reservations expire,
+// updated on every reconcile.
+type ExpiryMetrics struct {
+	labels ExpiryLabels
+
+	wish        *prometheus.GaugeVec
+	reservation *prometheus.GaugeVec
+
+	mu     sync.Mutex
+	latest map[types.NamespacedName][2]float64
+}
+
+// NewExpiryMetrics creates the expiry gauges with the given labeling. They
+// must be registered before they are scraped.
+func NewExpiryMetrics(labels ExpiryLabels) *ExpiryMetrics {
+	labelNames := []string{"namespace"}
+	if labels == ExpiryLabelsWish {
+		labelNames = []string{"name", "namespace"}
+	}
+
+	return &ExpiryMetrics{
+		labels: labels,
+		wish: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "wish_seconds_until_expiry",
+			Help: "Seconds until the wish's TTL runs out; -1 if it never expires.",
+		}, labelNames),
+		reservation: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "wish_reservation_seconds_until_expiry",
+			Help: "Seconds until the wish's next reservation expires; -1 if it has none.",
+		}, labelNames),
+		latest: make(map[types.NamespacedName][2]float64),
+	}
+}
+
+// Register adds the gauges to reg.
+func (m *ExpiryMetrics) Register(reg prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{m.wish, m.reservation} {
+		if err := reg.Register(collector); err != nil {
+			return fmt.Errorf("registering expiry metrics: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// observe records the wish's remaining TTL and next reservation expiry.
+func (m *ExpiryMetrics) observe(wish *wishlistv1alpha1.Wish, now time.Time) {
+	if m == nil {
+		return
+	}
+
+	ttl := float64(neverExpires)
+	if expiresAt, ok := wish.ExpirationTime(); ok {
+		ttl = max(expiresAt.Sub(now).Seconds(), 0)
+	}
+
+	reservation := float64(neverExpires)
+	if next := wish.NextReservationExpiry(); next != nil {
+		reservation = max(next.Sub(now).Seconds(), 0)
+	}
+
+	key := types.NamespacedName{Name: wish.Name, Namespace: wish.Namespace}
+
+	if m.labels == ExpiryLabelsWish {
+		m.wish.WithLabelValues(key.Name, key.Namespace).Set(ttl)
+		m.reservation.WithLabelValues(key.Name, key.Namespace).Set(reservation)
+
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.latest[key] = [2]float64{ttl, reservation}
+	m.refreshNamespace(key.Namespace)
+}
+
+// forget drops the series of a deleted wish.
+func (m *ExpiryMetrics) forget(key types.NamespacedName) {
+	if m == nil {
+		return
+	}
+
+	if m.labels == ExpiryLabelsWish {
+		m.wish.DeleteLabelValues(key.Name, key.Namespace)
+		m.reservation.DeleteLabelValues(key.Name, key.Namespace)
+
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.latest, key)
+	m.refreshNamespace(key.Namespace)
+}
+
+// refreshNamespace sets the namespace series to the soonest expiry among its
+// wishes, ignoring those that never expire. Callers hold m.mu.
+func (m *ExpiryMetrics) refreshNamespace(namespace string) {
+	soonest := [2]float64{neverExpires, neverExpires}
+	seen := false
+
+	for key, values := range m.latest {
+		if key.Namespace != namespace {
+			continue
+		}
+
+		seen = true
+
+		for i, value := range values {
+			if value != neverExpires && (soonest[i] == neverExpires || value < soonest[i]) {
+				soonest[i] = value
+			}
+		}
+	}
+
+	if !seen {
+		m.wish.DeleteLabelValues(namespace)
+		m.reservation.DeleteLabelValues(namespace)
+
+		return
+	}
+
+	m.wish.WithLabelValues(namespace).Set(soonest[0])
+	m.reservation.WithLabelValues(namespace).Set(soonest[1])
+}
+
+// maxMetricTags bounds the tags wish_reserved_by_tag_total may be labeled
+// with, so a typo'd flag can't blow up the series count.
+const maxMetricTags = 50
+
+// ParseMetricTags parses the comma-separated allowlist of tags to label
+// wish_reserved_by_tag_total with, dropping blanks and duplicates.
+func ParseMetricTags(value string) ([]string, error) {
+	var tags []string
+
+	for tag := range strings.SplitSeq(value, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || slices.Contains(tags, tag) {
+			continue
+		}
+
+		tags = append(tags, tag)
+	}
+
+	if len(tags) > maxMetricTags {
+		return nil, fmt.Errorf("too many metric tags: %d (at most %d)", len(tags), maxMetricTags)
+	}
+
+	return tags, nil
+}
+
+// ReservedTagMetrics exposes how many wishes with an active reservation carry
+// each tag, as the wish_reserved_by_tag_total gauge, updated on every
+// reconcile. Only allowlisted tags get a series, keeping cardinality bounded
+// whatever tags wishes carry.
+type ReservedTagMetrics struct {
+	allowed []string
+	gauge   *prometheus.GaugeVec
+
+	mu sync.Mutex
+	// reserved holds the allowlisted tags of each reserved wish.
+	reserved map[types.NamespacedName][]string
+}
+
+// NewReservedTagMetrics creates the per-tag gauge for the allowlisted tags.
+// It must be registered before it is scraped.
+func NewReservedTagMetrics(tags []string) *ReservedTagMetrics {
+	return &ReservedTagMetrics{
+		allowed: tags,
+		gauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "wish_reserved_by_tag_total",
+			Help: "Wishes with an active reservation carrying the tag.",
+		}, []string{"namespace", "tag"}),
+		reserved: make(map[types.NamespacedName][]string),
+	}
+}
+
+// Register adds the gauge to reg.
+func (m *ReservedTagMetrics) Register(reg prometheus.Registerer) error {
+	if err := reg.Register(m.gauge); err != nil {
+		return fmt.Errorf("registering reserved tag metrics: %w", err)
+	}
+
+	return nil
+}
+
+// observe records whether the wish is reserved, and under which tags.
+func (m *ReservedTagMetrics) observe(wish *wishlistv1alpha1.Wish, now time.Time) {
+	if m == nil {
+		return
+	}
+
+	var tags []string
+
+	if len(wish.ActiveReservationsAt(now)) > 0 {
+		for _, tag := range m.allowed {
+			if slices.Contains(wish.Spec.Tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	key := types.NamespacedName{Name: wish.Name, Namespace: wish.Namespace}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(tags) == 0 {
+		delete(m.reserved, key)
+	} else {
+		m.reserved[key] = tags
+	}
+
+	m.refreshNamespace(key.Namespace)
+}
+
+// forget drops a deleted wish from the counts.
+func (m *ReservedTagMetrics) forget(key types.NamespacedName) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.reserved, key)
+	m.refreshNamespace(key.Namespace)
+}
+
+// refreshNamespace recounts the namespace's reserved wishes per allowlisted
+// tag. Callers hold m.mu.
+func (m *ReservedTagMetrics) refreshNamespace(namespace string) {
+	counts := make(map[string]int, len(m.allowed))
+
+	for key, tags := range m.reserved {
+		if key.Namespace != namespace {
+			continue
+		}
+
+		for _, tag := range tags {
+			counts[tag]++
+		}
+	}
+
+	for _, tag := range m.allowed {
+		m.gauge.WithLabelValues(namespace, tag).Set(float64(counts[tag]))
+	}
+}
+
+// errReconcileStale is reported by the readiness check once reconciles have
+// stalled.
+var errReconcileStale = errors.New("no successful reconcile recently")
+
+// ReconcileHealth records when a reconcile last succeeded, exported as the
+// wish_last_reconcile_timestamp_seconds gauge, so a stuck controller can be
+// alerted on or taken out of readiness.
+type ReconcileHealth struct {
+	gauge prometheus.Gauge
+
+	// last holds the Unix time in nanoseconds, zero before the first success.
+	last atomic.Int64
+}
+
+// NewReconcileHealth creates the reconcile health gauge. It must be
+// registered before it is scraped.
+func NewReconcileHealth() *ReconcileHealth {
+	return &ReconcileHealth{
+		gauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "wish_last_reconcile_timestamp_seconds",
+			Help: "Unix time of the last successful Wish reconcile.",
+		}),
+	}
+}
+
+// Register adds the gauge to reg.
+func (h *ReconcileHealth) Register(reg prometheus.Registerer) error {
+	if err := reg.Register(h.gauge); err != nil {
+		return fmt.Errorf("registering reconcile health metric: %w", err)
+	}
+
+	return nil
+}
+
+// succeeded records a successful reconcile at now.
+func (h *ReconcileHealth) succeeded(now time.Time) {
+	if h == nil {
+		return
+	}
+
+	h.last.Store(now.UnixNano())
+	h.gauge.Set(float64(now.UnixNano()) / float64(time.Second))
+}
+
+// ReadyCheck returns a readiness check that fails once the last successful
+// reconcile is older than maxAge. Until elected is closed the replica is not
+// reconciling at all, so the check passes, as it does before the first
+// reconcile. maxAge should exceed the sync period, since an unchanged wish is
+// only reconciled that often.
+func (h *ReconcileHealth) ReadyCheck(maxAge time.Duration, elected <-chan struct{}) healthz.Checker {
+	return func(*http.Request) error {
+		select {
+		case <-elected:
+		default:
+			return nil
+		}
+
+		last := h.last.Load()
+		if last == 0 {
+			return nil
+		}
+
+		if age := time.Since(time.Unix(0, last)); age > maxAge {
+			return fmt.Errorf("%w: last one %s ago", errReconcileStale, age.Truncate(time.Second))
+		}
+
+		return nil
+	}
+}
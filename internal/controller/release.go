@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// keyFieldsHash fingerprints the spec fields a giver reserves against: the
+// title and the official URL. Other edits, e.g. to the priority or notes,
+// leave it unchanged.
+func keyFieldsHash(wish *wishlistv1alpha1.Wish) string {
+	sum := sha256.Sum256([]byte(wish.Spec.Title + "\x00" + wish.Spec.OfficialURL))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// releaseOnEdit drops every reservation, recording each in the history, when
+// edited (a generation not yet observed) changed the wish's key fields since
+// they were last fingerprinted. The first pass with the policy enabled only
+// takes the fingerprint, and a disabled policy forgets it, so enabling it
+// later never releases on an edit made in between. It returns how many
+// reservations were released and whether the status was modified.
+func releaseOnEdit(wish *wishlistv1alpha1.Wish, enabled, edited bool, now time.Time) (int32, bool) {
+	if !enabled {
+		if wish.Status.KeyFieldsHash == "" {
+			return 0, false
+		}
+
+		wish.Status.KeyFieldsHash = ""
+
+		return 0, true
+	}
+
+	hash := keyFieldsHash(wish)
+	if wish.Status.KeyFieldsHash == hash {
+		return 0, false
+	}
+
+	var released int32
+
+	if edited && wish.Status.KeyFieldsHash != "" {
+		for _, res := range wish.Status.Reservations {
+			recordHistory(wish, res, wishlistv1alpha1.HistoryReasonReleased, now)
+			released++
+		}
+
+		wish.Status.Reservations = nil
+	}
+
+	wish.Status.KeyFieldsHash = hash
+
+	return released, true
+}
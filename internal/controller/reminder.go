@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package controller
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// nextReminder returns when the giver of the confirmed reservation expiring
+// soonest after now should be reminded: lead before it expires. The time may
+// already have passed for reservations shorter than lead. It returns nil when
+// lead is zero, the wish was received, or no confirmed reservation is running.
+func nextReminder(wish *wishlistv1alpha1.Wish, lead time.Duration, now time.Time) *metav1.Time {
+	if lead <= 0 || wish.Status.Received {
+		return nil
+	}
+
+	var earliest *metav1.Time
+
+	for i := range wish.Status.Reservations {
+		res := &wish.Status.Reservations[i]
+		if res.IsPending() || !res.ExpiresAt.After(now) {
+			continue
+		}
+
+		if earliest == nil || res.ExpiresAt.Before(earliest) {
+			earliest = &res.ExpiresAt
+		}
+	}
+
+	if earliest == nil {
+		return nil
+	}
+
+	remindAt := metav1.NewTime(earliest.Add(-lead))
+
+	return &remindAt
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package controller
+
+import (
+	"fmt"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// summarize describes the wish's state in one line for `kubectl get`, e.g.
+// "Active, 3 of 5 available" or "Reserved until Jan 2, 2026", in lang. It
+// expects Status.Active to be up to date.
+func summarize(wish *wishlistv1alpha1.Wish, lang string) string {
+	switch {
+	case wish.Status.Received:
+		return i18n.T(lang, "summary_received")
+	case !wish.Status.Active:
+		return i18n.T(lang, "summary_expired")
+	case wish.IsUnlimited():
+		return i18n.T(lang, "summary_unlimited")
+	case wish.IsFullyReserved():
+		if next := wish.NextReservationExpiry(); next != nil {
+			return fmt.Sprintf(i18n.T(lang, "summary_reserved"), i18n.FormatDate(lang, next.Time))
+		}
+	}
+
+	return fmt.Sprintf(i18n.T(lang, "summary_active"),
+		i18n.FormatNumber(lang, int64(wish.AvailableQuantity())), i18n.FormatNumber(lang, int64(wish.GetQuantity())))
+}
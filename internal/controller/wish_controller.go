@@ -5,13 +5,25 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
 )
@@ -21,43 +33,225 @@ type WishReconciler struct {
 	client.Client
 
 	Scheme *runtime.Scheme
+
+	// AutoExtend keeps reservations on active wishes from expiring.
+	// The zero value disables it.
+	AutoExtend ReservationAutoExtend
+
+	// Backoff tunes the retry delay after failed reconciles.
+	// The zero value keeps the controller-runtime default.
+	Backoff ReconcileBackoff
+
+	// OverSubscription decides what happens when active reservations exceed
+	// a lowered quantity. The zero value flags the wish.
+	OverSubscription OverSubscriptionMode
+
+	// Metrics receives the expiry gauges after each reconcile. Nil disables them.
+	Metrics *ExpiryMetrics
+
+	// TagMetrics counts reserved wishes per allowlisted tag after each
+	// reconcile. Nil disables it.
+	TagMetrics *ReservedTagMetrics
+
+	// ExpiryWarning is how long before its TTL runs out a wish is marked
+	// ExpiringSoon. Zero disables the warning.
+	ExpiryWarning time.Duration
+
+	// Recorder emits an event when a wish starts expiring soon. Nil skips it.
+	Recorder events.EventRecorder
+
+	// ReservationGrace keeps expired reservations, still counted as
+	// reserved, for this long before they are cleared, so a giver finishing
+	// a purchase doesn't lose the item. Zero clears them on expiry.
+	ReservationGrace time.Duration
+
+	// Health records successful reconciles. Nil disables it.
+	Health *ReconcileHealth
+
+	// HistoryRetention bounds the history of cleared reservations.
+	// The zero value keeps it forever.
+	HistoryRetention HistoryRetention
+
+	// Clock is the time source for expiry decisions. Nil uses the real clock.
+	Clock clock.PassiveClock
+
+	// SummaryLanguage is the language of Status.Summary. Empty uses English.
+	SummaryLanguage string
+
+	// ReminderLead is how long before a reservation expires its giver is due
+	// a reminder, exposed as Status.NextReminderAt. Zero disables it.
+	ReminderLead time.Duration
+
+	// PriorityDecay lowers a wish's effective priority by one for each
+	// PriorityDecay of age, down to 1, exposed as Status.EffectivePriority
+	// for sorting. Zero disables it.
+	PriorityDecay time.Duration
+
+	// ActiveHook is told when a wish turns active or inactive. Nil disables it.
+	ActiveHook *EventHook
+
+	// ReleaseOnEdit releases all reservations of a wish whose title or
+	// official URL changes, since givers reserved something else. Wishes
+	// annotated with AnnotationReleaseOnEdit opt in on their own.
+	ReleaseOnEdit bool
+
+	// ReleaseHook is told how many reservations were released on edit, so
+	// the owner can let the givers know; no contact of theirs is stored to
+	// tell them directly. Nil disables it.
+	ReleaseHook *EventHook
+}
+
+// now returns the current time from the reconciler's clock.
+func (r *WishReconciler) now() time.Time {
+	if r.Clock == nil {
+		return time.Now()
+	}
+
+	return r.Clock.Now()
+}
+
+// OverSubscriptionMode selects how the reconciler handles a wish whose active
+// reservations exceed its quantity.
+type OverSubscriptionMode string
+
+const (
+	// OverSubscriptionFlag sets the OverSubscribed condition for the owner
+	// to resolve and leaves the reservations alone.
+	OverSubscriptionFlag OverSubscriptionMode = "flag"
+
+	// OverSubscriptionTrim releases the oldest reservations until the
+	// reserved total fits the quantity.
+	OverSubscriptionTrim OverSubscriptionMode = "trim"
+)
+
+// ParseOverSubscriptionMode validates a mode name; empty selects the default.
+func ParseOverSubscriptionMode(name string) (OverSubscriptionMode, error) {
+	switch mode := OverSubscriptionMode(name); mode {
+	case "", OverSubscriptionFlag:
+		return OverSubscriptionFlag, nil
+	case OverSubscriptionTrim:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown over-subscription mode %q (want flag or trim)", name)
+	}
+}
+
+// ReconcileBackoff configures per-item exponential backoff for requeues after
+// a reconcile error: the first retry waits BaseDelay, each further failure
+// doubles it, up to MaxDelay. Both fields must be positive to take effect.
+type ReconcileBackoff struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// Enabled reports whether a custom backoff is configured.
+func (b ReconcileBackoff) Enabled() bool {
+	return b.BaseDelay > 0 && b.MaxDelay > 0
+}
+
+// rateLimiter returns the workqueue rate limiter for the configured backoff,
+// or nil to let controller-runtime use its default.
+func (b ReconcileBackoff) rateLimiter() workqueue.TypedRateLimiter[reconcile.Request] {
+	if !b.Enabled() {
+		return nil
+	}
+
+	return workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](b.BaseDelay, b.MaxDelay)
+}
+
+// HistoryRetention bounds Status.History: entries beyond MaxEntries, oldest
+// first, and entries that ended more than MaxAge ago are pruned. A zero field
+// leaves that bound off.
+type HistoryRetention struct {
+	MaxEntries int
+	MaxAge     time.Duration
+}
+
+// ReservationAutoExtend configures automatic extension of reservations while
+// their wish stays active, so committed givers don't lose a reservation on a
+// long-running list. Both fields must be positive to enable it.
+type ReservationAutoExtend struct {
+	// Step is how far ahead of now a reservation's expiry is pushed. It is
+	// extended once less than half of Step remains.
+	Step time.Duration
+
+	// MaxHold caps the total hold, measured from the reservation's CreatedAt.
+	MaxHold time.Duration
+}
+
+// Enabled reports whether auto-extension is configured.
+func (c ReservationAutoExtend) Enabled() bool {
+	return c.Step > 0 && c.MaxHold > 0
 }
 
 // +kubebuilder:rbac:groups=wishlist.k8s.lex.la,resources=wishes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=wishlist.k8s.lex.la,resources=wishes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=wishlist.k8s.lex.la,resources=wishes/finalizers,verbs=update
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile handles the reconciliation of Wish resources.
-// It manages TTL expiration and reservation cleanup.
+// It manages TTL expiration and reservation cleanup, including pending
+// reservations left unconfirmed.
+func (r *WishReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	result, err := r.reconcileWish(ctx, req)
+	if err == nil {
+		r.Health.succeeded(r.now())
+	}
+
+	return result, err
+}
+
+// reconcileWish does the work of Reconcile.
 //
 //nolint:gocognit // Standard reconcile pattern with migration and cleanup logic
-func (r *WishReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *WishReconciler) reconcileWish(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
 	wish := &wishlistv1alpha1.Wish{}
 	if err := r.Get(ctx, req.NamespacedName, wish); err != nil {
 		if errors.IsNotFound(err) {
+			r.Metrics.forget(req.NamespacedName)
+			r.TagMetrics.forget(req.NamespacedName)
+
 			return ctrl.Result{}, nil
 		}
 
 		return ctrl.Result{}, err
 	}
 
+	// A paused wish is left exactly as it is; removing the annotation is an
+	// update, which triggers the next reconcile
+	if wish.IsPaused() {
+		log.Info("Wish is paused, skipping reconcile", "annotation", wishlistv1alpha1.AnnotationPaused)
+
+		return ctrl.Result{}, nil
+	}
+
 	statusChanged := false
 	var requeueAfter time.Duration
 
+	now := r.now()
+
 	// Check and update Active status based on TTL
-	isActive := !wish.IsExpired()
-	if wish.Status.Active != isActive {
+	isActive := !wish.IsExpiredAt(now)
+	activeChanged := wish.Status.Active != isActive
+	if activeChanged {
 		wish.Status.Active = isActive
 		statusChanged = true
 		log.Info("Updated Active status", "active", isActive)
 	}
 
+	// Keep Fulfilled in step with the fund target and the purchased count,
+	// which the owner may change
+	if fulfilled := wish.IsFulfilled(); wish.Status.Fulfilled != fulfilled {
+		wish.Status.Fulfilled = fulfilled
+		statusChanged = true
+		log.Info("Updated Fulfilled status", "fulfilled", fulfilled)
+	}
+
 	// Schedule requeue for TTL expiration if active and TTL is set
-	if isActive && wish.Spec.TTL != nil {
-		expiresAt := wish.CreationTimestamp.Add(wish.Spec.TTL.Duration)
-		ttlRemaining := time.Until(expiresAt)
+	if expiresAt, ok := wish.ExpirationTime(); isActive && ok {
+		ttlRemaining := expiresAt.Sub(now)
 		if ttlRemaining > 0 {
 			if requeueAfter == 0 || ttlRemaining < requeueAfter {
 				requeueAfter = ttlRemaining
@@ -65,39 +259,63 @@ func (r *WishReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		}
 	}
 
-	// Migration: convert legacy Reserved format to new Reservations slice
-	//nolint:staticcheck // Intentional use of deprecated fields for migration
-	if wish.Status.Reserved && len(wish.Status.Reservations) == 0 {
-		//nolint:staticcheck // Intentional use of deprecated fields for migration
-		if wish.Status.ReservedAt != nil && wish.Status.ReservationExpires != nil {
-			wish.Status.Reservations = []wishlistv1alpha1.Reservation{{
-				Quantity: 1,
-				//nolint:staticcheck // Intentional use of deprecated fields for migration
-				CreatedAt: *wish.Status.ReservedAt,
-				//nolint:staticcheck // Intentional use of deprecated fields for migration
-				ExpiresAt: *wish.Status.ReservationExpires,
-			}}
-			log.Info("Migrated legacy reservation to new format")
-		}
-
-		//nolint:staticcheck // Intentional use of deprecated fields for migration
-		wish.Status.Reserved = false
-		//nolint:staticcheck // Intentional use of deprecated fields for migration
-		wish.Status.ReservedAt = nil
-		//nolint:staticcheck // Intentional use of deprecated fields for migration
-		wish.Status.ReservationExpires = nil
+	// A spec change (e.g. an edited TTL) bumps the generation; record that
+	// this reconcile accounted for it
+	edited := wish.Status.ObservedGeneration != wish.Generation
+	if observeGeneration(wish) {
+		statusChanged = true
+		log.Info("Observed new generation", "generation", wish.Generation)
+	}
+
+	// Migration: fold legacy Reserved fields into the Reservations slice,
+	// or drop them where the slice already says otherwise
+	if migrateLegacyReservation(wish, now) {
+		statusChanged = true
+		log.Info("Normalized legacy reservation fields", "reservations", len(wish.Status.Reservations))
+	}
+
+	// Reservations made for a different title or product no longer hold
+	released, fingerprinted := releaseOnEdit(wish, r.ReleaseOnEdit || wish.ReleasesOnEdit(), edited, now)
+	if fingerprinted {
 		statusChanged = true
 	}
 
+	if released > 0 {
+		log.Info("Released reservations after an edit", "count", released)
+	}
+
+	// Extend reservations on active wishes before expired ones are dropped
+	if isActive && r.AutoExtend.Enabled() {
+		extended, nextDue := extendReservations(wish, r.AutoExtend, now)
+		if extended > 0 {
+			statusChanged = true
+			log.Info("Extended reservations", "count", extended)
+		}
+
+		if !nextDue.IsZero() {
+			if remaining := nextDue.Sub(now); requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+		}
+	}
+
 	// Clean up expired reservations from the slice
-	now := time.Now()
 	activeReservations := make([]wishlistv1alpha1.Reservation, 0, len(wish.Status.Reservations))
 
 	for _, res := range wish.Status.Reservations {
-		if res.ExpiresAt.After(now) {
+		switch {
+		case res.IsPending() && !res.PendingUntil.After(now):
+			statusChanged = true
+			recordHistory(wish, res, wishlistv1alpha1.HistoryReasonUnconfirmed, now)
+			log.Info("Removed unconfirmed reservation", "quantity", res.Quantity, "pendingUntil", res.PendingUntil)
+		case res.ExpiresAt.After(now):
 			activeReservations = append(activeReservations, res)
-		} else {
+		case res.ExpiresAt.Add(r.ReservationGrace).After(now):
+			// Expired, but still held during the grace period
+			activeReservations = append(activeReservations, res)
+		default:
 			statusChanged = true
+			recordHistory(wish, res, wishlistv1alpha1.HistoryReasonExpired, now)
 			log.Info("Removed expired reservation", "quantity", res.Quantity, "expiredAt", res.ExpiresAt)
 		}
 	}
@@ -106,9 +324,89 @@ func (r *WishReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		wish.Status.Reservations = activeReservations
 	}
 
-	// Schedule requeue for next reservation expiry
+	// Keep the history within its retention
+	pruned, nextPrune := pruneHistory(wish, r.HistoryRetention, now)
+	if pruned > 0 {
+		statusChanged = true
+		log.Info("Pruned reservation history", "count", pruned)
+	}
+
+	if !nextPrune.IsZero() {
+		if remaining := nextPrune.Sub(now); requeueAfter == 0 || remaining < requeueAfter {
+			requeueAfter = remaining
+		}
+	}
+
+	// Guard against more reservations than a lowered quantity allows
+	if r.guardOverSubscription(wish) {
+		statusChanged = true
+		log.Info("Handled over-subscription", "mode", r.OverSubscription,
+			"reserved", wish.TotalReserved(), "quantity", wish.Spec.Quantity)
+	}
+
+	// Warn the owner once the TTL is about to run out
+	wasExpiringSoon := meta.IsStatusConditionTrue(wish.Status.Conditions, wishlistv1alpha1.ConditionExpiringSoon)
+	expiringChanged, warnIn := r.checkExpiringSoon(wish, now)
+	if expiringChanged {
+		statusChanged = true
+	}
+
+	if warnIn > 0 && (requeueAfter == 0 || warnIn < requeueAfter) {
+		requeueAfter = warnIn
+	}
+
+	// Flag wishes still open past the date the owner needs them by
+	wasOverdue := meta.IsStatusConditionTrue(wish.Status.Conditions, wishlistv1alpha1.ConditionOverdue)
+	overdueChanged, overdueIn := checkOverdue(wish, now)
+	if overdueChanged {
+		statusChanged = true
+	}
+
+	if overdueIn > 0 && (requeueAfter == 0 || overdueIn < requeueAfter) {
+		requeueAfter = overdueIn
+	}
+
+	// Hint the owner at wishes givers have no link to buy from
+	if checkPurchaseLinks(wish) {
+		statusChanged = true
+	}
+
+	// Expose the next reservation reminder for external reminder jobs
+	if reminder := nextReminder(wish, r.ReminderLead, now); !wish.Status.NextReminderAt.Equal(reminder) {
+		wish.Status.NextReminderAt = reminder
+		statusChanged = true
+	}
+
+	// Demote long-listed wishes so fresh additions surface; Spec.Priority
+	// itself is left as the owner set it
+	effective, nextDecay := decayedPriority(wish, r.PriorityDecay, now)
+	if wish.Status.EffectivePriority != effective {
+		wish.Status.EffectivePriority = effective
+		statusChanged = true
+		log.Info("Updated effective priority", "priority", wish.Spec.Priority, "effective", effective)
+	}
+
+	if !nextDecay.IsZero() {
+		if remaining := nextDecay.Sub(now); requeueAfter == 0 || remaining < requeueAfter {
+			requeueAfter = remaining
+		}
+	}
+
+	// Track interest for the web server's popular badge
+	if demand := wish.CurrentDemand(); wish.Status.Demand != demand {
+		wish.Status.Demand = demand
+		statusChanged = true
+	}
+
+	// Keep the one-line summary shown by `kubectl get` current
+	if summary := summarize(wish, r.SummaryLanguage); wish.Status.Summary != summary {
+		wish.Status.Summary = summary
+		statusChanged = true
+	}
+
+	// Schedule requeue for when the next reservation is cleared
 	if next := wish.NextReservationExpiry(); next != nil {
-		remaining := time.Until(next.Time)
+		remaining := next.Add(r.ReservationGrace).Sub(now)
 		if remaining > 0 {
 			if requeueAfter == 0 || remaining < requeueAfter {
 				requeueAfter = remaining
@@ -116,6 +414,17 @@ func (r *WishReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		}
 	}
 
+	// Schedule requeue to drop pending reservations left unconfirmed
+	for _, res := range wish.Status.Reservations {
+		if !res.IsPending() {
+			continue
+		}
+
+		if remaining := res.PendingUntil.Sub(now); requeueAfter == 0 || remaining < requeueAfter {
+			requeueAfter = remaining
+		}
+	}
+
 	if statusChanged {
 		if err := r.Status().Update(ctx, wish); err != nil {
 			log.Error(err, "Failed to update Wish status")
@@ -124,6 +433,43 @@ func (r *WishReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		}
 	}
 
+	// Only once the new state is stored, so a failed update that is retried
+	// does not announce it twice
+	if activeChanged {
+		r.ActiveHook.notify(wish, now)
+	}
+
+	if released > 0 {
+		r.ReleaseHook.released(wish, released, now)
+
+		if r.Recorder != nil {
+			r.Recorder.Eventf(wish, nil, corev1.EventTypeNormal, "ReservationsReleased", "Release",
+				"Released %d reservation(s) after the title or official URL changed", released)
+		}
+	}
+
+	if !wasExpiringSoon && r.Recorder != nil &&
+		meta.IsStatusConditionTrue(wish.Status.Conditions, wishlistv1alpha1.ConditionExpiringSoon) {
+		expiresAt, _ := wish.ExpirationTime()
+		r.Recorder.Eventf(wish, nil, corev1.EventTypeWarning, wishlistv1alpha1.ConditionExpiringSoon, "Expire",
+			"Wish expires at %s; extend spec.ttl to keep it", expiresAt.Format(time.RFC3339))
+	}
+
+	if !wasOverdue && r.Recorder != nil &&
+		meta.IsStatusConditionTrue(wish.Status.Conditions, wishlistv1alpha1.ConditionOverdue) {
+		r.Recorder.Eventf(wish, nil, corev1.EventTypeWarning, wishlistv1alpha1.ConditionOverdue, "Overdue",
+			"Wish was needed by %s", wish.Spec.NeededBy.Format(time.RFC3339))
+	}
+
+	if err := r.syncStateLabels(ctx, wish); err != nil {
+		log.Error(err, "Failed to update Wish state labels")
+
+		return ctrl.Result{}, err
+	}
+
+	r.Metrics.observe(wish, now)
+	r.TagMetrics.observe(wish, now)
+
 	if requeueAfter > 0 {
 		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
@@ -131,10 +477,361 @@ func (r *WishReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	return ctrl.Result{}, nil
 }
 
+// syncStateLabels mirrors the wish's active and reserved state into its
+// labels. It patches metadata only, leaving the status subresource alone, and
+// skips the write when the labels already match, so the watch event caused
+// by a label change settles on the next reconcile.
+func (r *WishReconciler) syncStateLabels(ctx context.Context, wish *wishlistv1alpha1.Wish) error {
+	want := map[string]string{
+		wishlistv1alpha1.LabelActive:   strconv.FormatBool(wish.Status.Active),
+		wishlistv1alpha1.LabelReserved: strconv.FormatBool(wish.TotalReserved() > 0),
+	}
+
+	current := wish.GetLabels()
+	if current[wishlistv1alpha1.LabelActive] == want[wishlistv1alpha1.LabelActive] &&
+		current[wishlistv1alpha1.LabelReserved] == want[wishlistv1alpha1.LabelReserved] {
+		return nil
+	}
+
+	patch := client.MergeFrom(wish.DeepCopy())
+
+	labels := maps.Clone(current)
+	if labels == nil {
+		labels = make(map[string]string, len(want))
+	}
+
+	maps.Copy(labels, want)
+	wish.SetLabels(labels)
+
+	return r.Patch(ctx, wish, patch)
+}
+
+// observeGeneration records the wish's current generation in its status and
+// conditions. Everything else the reconciler derives from the spec is
+// recomputed on every pass, so this only needs to persist the marker.
+// Returns true if the status was modified.
+func observeGeneration(wish *wishlistv1alpha1.Wish) bool {
+	changed := wish.Status.ObservedGeneration != wish.Generation
+	wish.Status.ObservedGeneration = wish.Generation
+
+	for i := range wish.Status.Conditions {
+		if wish.Status.Conditions[i].ObservedGeneration != wish.Generation {
+			wish.Status.Conditions[i].ObservedGeneration = wish.Generation
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// migrateLegacyReservation converts the deprecated single-reservation fields
+// into an entry of the Reservations slice and clears them. The slice is
+// authoritative: a still valid legacy reservation is only moved into an
+// empty slice, while legacy fields next to a populated one are stale copies
+// and just dropped, as are expired or incomplete legacy data. It runs on
+// every reconcile and is idempotent. Returns true if the status was modified.
+//
+//nolint:staticcheck // Intentional use of deprecated fields for migration
+func migrateLegacyReservation(wish *wishlistv1alpha1.Wish, now time.Time) bool {
+	status := &wish.Status
+	if !status.Reserved && status.ReservedAt == nil && status.ReservationExpires == nil {
+		return false
+	}
+
+	if len(status.Reservations) == 0 && status.Reserved && status.ReservedAt != nil &&
+		status.ReservationExpires != nil && status.ReservationExpires.After(now) {
+		status.Reservations = []wishlistv1alpha1.Reservation{{
+			Quantity:  1,
+			CreatedAt: *status.ReservedAt,
+			ExpiresAt: *status.ReservationExpires,
+		}}
+	}
+
+	status.Reserved = false
+	status.ReservedAt = nil
+	status.ReservationExpires = nil
+
+	return true
+}
+
+// guardOverSubscription trims or flags a wish whose active reservations
+// exceed its quantity, depending on the configured mode, and clears the flag
+// once the wish fits again. Unlimited and fund wishes, and wishes that allow
+// multiple reservations, are never over-subscribed. Returns true if the
+// status was modified.
+func (r *WishReconciler) guardOverSubscription(wish *wishlistv1alpha1.Wish) bool {
+	excess := int32(0)
+	if !wish.IsUnlimited() && !wish.Spec.Fund && !wish.Spec.AllowMultipleReservations {
+		excess = wish.TotalReserved() - wish.GetQuantity()
+	}
+
+	trimmed := excess > 0 && r.OverSubscription == OverSubscriptionTrim
+	if trimmed {
+		trimReservations(wish, excess)
+
+		excess = 0
+	}
+
+	if excess > 0 {
+		return meta.SetStatusCondition(&wish.Status.Conditions, metav1.Condition{
+			Type:               wishlistv1alpha1.ConditionOverSubscribed,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: wish.Generation,
+			Reason:             "QuantityExceeded",
+			Message:            fmt.Sprintf("%d reserved but quantity is %d", wish.TotalReserved(), wish.GetQuantity()),
+		})
+	}
+
+	if !meta.IsStatusConditionTrue(wish.Status.Conditions, wishlistv1alpha1.ConditionOverSubscribed) {
+		return trimmed
+	}
+
+	meta.SetStatusCondition(&wish.Status.Conditions, metav1.Condition{
+		Type:               wishlistv1alpha1.ConditionOverSubscribed,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: wish.Generation,
+		Reason:             "WithinQuantity",
+		Message:            "Reservations fit the quantity",
+	})
+
+	return true
+}
+
+// checkExpiringSoon sets the ExpiringSoon condition while the wish is within
+// ExpiryWarning of its TTL, and clears it once the wish is out of the window
+// again, e.g. after the owner extended the TTL or the wish expired. It
+// reports whether the condition changed and how long until the window opens
+// (zero if there is nothing to wait for).
+func (r *WishReconciler) checkExpiringSoon(wish *wishlistv1alpha1.Wish, now time.Time) (bool, time.Duration) {
+	if r.ExpiryWarning <= 0 {
+		return false, 0
+	}
+
+	expiresAt, ok := wish.ExpirationTime()
+	warnAt := expiresAt.Add(-r.ExpiryWarning)
+
+	if ok && !now.Before(warnAt) && now.Before(expiresAt) {
+		return meta.SetStatusCondition(&wish.Status.Conditions, metav1.Condition{
+			Type:               wishlistv1alpha1.ConditionExpiringSoon,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: wish.Generation,
+			Reason:             "TTLEnding",
+			Message:            "Expires at " + expiresAt.Format(time.RFC3339),
+		}), 0
+	}
+
+	var wait time.Duration
+	if ok && now.Before(warnAt) {
+		wait = warnAt.Sub(now)
+	}
+
+	if !meta.IsStatusConditionTrue(wish.Status.Conditions, wishlistv1alpha1.ConditionExpiringSoon) {
+		return false, wait
+	}
+
+	meta.SetStatusCondition(&wish.Status.Conditions, metav1.Condition{
+		Type:               wishlistv1alpha1.ConditionExpiringSoon,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: wish.Generation,
+		Reason:             "OutsideWarningWindow",
+		Message:            "Outside the warning window before the TTL runs out",
+	})
+
+	return true, wait
+}
+
+// checkOverdue sets the Overdue condition once the wish's NeededBy date has
+// passed while it is active and not received. A NeededBy after the TTL runs
+// out can never be reached while the wish is active, so the condition is set
+// false with a reason pointing at the TTL instead. Otherwise an existing
+// condition is set false, e.g. after the owner moved the date. It reports
+// whether the condition changed and how long until the wish becomes overdue
+// (zero if there is nothing to wait for).
+func checkOverdue(wish *wishlistv1alpha1.Wish, now time.Time) (bool, time.Duration) {
+	neededBy := wish.Spec.NeededBy
+	expiresAt, hasTTL := wish.ExpirationTime()
+
+	switch {
+	case neededBy != nil && hasTTL && neededBy.After(expiresAt):
+		return meta.SetStatusCondition(&wish.Status.Conditions, metav1.Condition{
+			Type:               wishlistv1alpha1.ConditionOverdue,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: wish.Generation,
+			Reason:             "NeededAfterExpiry",
+			Message: fmt.Sprintf("Needed by %s, but the wish expires at %s; extend spec.ttl",
+				neededBy.Format(time.RFC3339), expiresAt.Format(time.RFC3339)),
+		}), 0
+	case neededBy != nil && wish.Status.Active && !wish.Status.Received && !now.Before(neededBy.Time):
+		return meta.SetStatusCondition(&wish.Status.Conditions, metav1.Condition{
+			Type:               wishlistv1alpha1.ConditionOverdue,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: wish.Generation,
+			Reason:             "PastNeededBy",
+			Message:            "Was needed by " + neededBy.Format(time.RFC3339),
+		}), 0
+	}
+
+	var wait time.Duration
+	if neededBy != nil && wish.Status.Active && !wish.Status.Received {
+		wait = neededBy.Sub(now)
+	}
+
+	if meta.FindStatusCondition(wish.Status.Conditions, wishlistv1alpha1.ConditionOverdue) == nil {
+		return false, wait
+	}
+
+	return meta.SetStatusCondition(&wish.Status.Conditions, metav1.Condition{
+		Type:               wishlistv1alpha1.ConditionOverdue,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: wish.Generation,
+		Reason:             "NotOverdue",
+		Message:            "Not past the needed-by date while open",
+	}), wait
+}
+
+// checkPurchaseLinks sets the NoPurchaseLinks condition while a wish has no
+// official or purchase URL. Funds take money rather than a bought item, and
+// received wishes are done with, so they never need one. Once links are
+// added, an existing condition is set false. It reports whether the
+// condition changed.
+func checkPurchaseLinks(wish *wishlistv1alpha1.Wish) bool {
+	if !wish.Spec.Fund && !wish.Status.Received && wish.Spec.OfficialURL == "" && len(wish.Spec.PurchaseURLs) == 0 {
+		return meta.SetStatusCondition(&wish.Status.Conditions, metav1.Condition{
+			Type:               wishlistv1alpha1.ConditionNoPurchaseLinks,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: wish.Generation,
+			Reason:             "NoLinks",
+			Message:            "Set spec.officialURL or spec.purchaseURLs so givers know where to buy it",
+		})
+	}
+
+	if meta.FindStatusCondition(wish.Status.Conditions, wishlistv1alpha1.ConditionNoPurchaseLinks) == nil {
+		return false
+	}
+
+	return meta.SetStatusCondition(&wish.Status.Conditions, metav1.Condition{
+		Type:               wishlistv1alpha1.ConditionNoPurchaseLinks,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: wish.Generation,
+		Reason:             "HasLinks",
+		Message:            "Givers have a link to buy it",
+	})
+}
+
+// recordHistory appends a cleared reservation to the wish's history.
+func recordHistory(wish *wishlistv1alpha1.Wish, res wishlistv1alpha1.Reservation, reason string, now time.Time) {
+	wish.Status.History = append(wish.Status.History, wishlistv1alpha1.ReservationRecord{
+		Quantity:  res.Quantity,
+		CreatedAt: res.CreatedAt,
+		EndedAt:   metav1.NewTime(now),
+		Reason:    reason,
+	})
+}
+
+// pruneHistory drops history entries outside the retention. It returns how
+// many were dropped and when the oldest remaining entry ages out (zero if
+// there is no age bound or nothing left).
+func pruneHistory(wish *wishlistv1alpha1.Wish, cfg HistoryRetention, now time.Time) (int, time.Time) {
+	history := wish.Status.History
+	before := len(history)
+
+	if cfg.MaxAge > 0 {
+		cutoff := now.Add(-cfg.MaxAge)
+		history = slices.DeleteFunc(history, func(rec wishlistv1alpha1.ReservationRecord) bool {
+			return !rec.EndedAt.After(cutoff)
+		})
+	}
+
+	if cfg.MaxEntries > 0 && len(history) > cfg.MaxEntries {
+		history = history[len(history)-cfg.MaxEntries:]
+	}
+
+	pruned := before - len(history)
+	if pruned > 0 {
+		wish.Status.History = history
+	}
+
+	var nextPrune time.Time
+	if cfg.MaxAge > 0 && len(history) > 0 {
+		nextPrune = history[0].EndedAt.Add(cfg.MaxAge)
+	}
+
+	return pruned, nextPrune
+}
+
+// trimReservations releases excess reserved units, oldest reservations
+// first. A reservation only partly needed to cover the excess keeps its
+// remaining quantity.
+func trimReservations(wish *wishlistv1alpha1.Wish, excess int32) {
+	slices.SortStableFunc(wish.Status.Reservations, func(a, b wishlistv1alpha1.Reservation) int {
+		return a.CreatedAt.Compare(b.CreatedAt.Time)
+	})
+
+	kept := wish.Status.Reservations[:0]
+
+	for _, res := range wish.Status.Reservations {
+		released := min(res.Quantity, excess)
+		excess -= released
+		res.Quantity -= released
+
+		if res.Quantity > 0 {
+			kept = append(kept, res)
+		}
+	}
+
+	wish.Status.Reservations = kept
+}
+
+// extendReservations pushes the expiry of each unexpired reservation that is
+// within half a step of expiring to now+Step, capped at CreatedAt+MaxHold and
+// at the wish's own expiry.
+// It returns how many reservations were extended and when the next one will
+// be due for extension (zero if none can be extended further).
+func extendReservations(wish *wishlistv1alpha1.Wish, cfg ReservationAutoExtend, now time.Time) (int, time.Time) {
+	extended := 0
+	threshold := cfg.Step / 2 //nolint:mnd // extend at the halfway point to avoid status churn
+
+	var nextDue time.Time
+
+	for i := range wish.Status.Reservations {
+		res := &wish.Status.Reservations[i]
+		if !res.ExpiresAt.After(now) || res.IsPending() {
+			continue
+		}
+
+		limit, _ := wish.CapReservationExpiry(res.CreatedAt.Add(cfg.MaxHold))
+		if !res.ExpiresAt.Time.Before(limit) {
+			continue
+		}
+
+		if res.ExpiresAt.Sub(now) <= threshold {
+			target := now.Add(cfg.Step)
+			if target.After(limit) {
+				target = limit
+			}
+
+			if target.After(res.ExpiresAt.Time) {
+				res.ExpiresAt = metav1.NewTime(target)
+				extended++
+			}
+		}
+
+		if res.ExpiresAt.Time.Before(limit) {
+			due := res.ExpiresAt.Add(-threshold)
+			if nextDue.IsZero() || due.Before(nextDue) {
+				nextDue = due
+			}
+		}
+	}
+
+	return extended, nextDue
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *WishReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&wishlistv1alpha1.Wish{}).
 		Named("wish").
+		WithOptions(controller.Options{RateLimiter: r.Backoff.rateLimiter()}).
 		Complete(r)
 }
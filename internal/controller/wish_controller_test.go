@@ -5,15 +5,29 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	clocktesting "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/notify"
 )
 
 const testMultiReservedGift = "Multi Reserved Gift"
@@ -141,6 +155,111 @@ var _ = Describe("Wish Controller", func() {
 				return !wish.Status.Active
 			}, timeout, interval).Should(BeTrue())
 		})
+
+		It("should set Active back to true once the TTL is extended", func() {
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			request := reconcile.Request{NamespacedName: typeNamespacedName}
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Active).To(BeFalse())
+
+			By("Extending the TTL past now")
+			wish.Spec.TTL = &metav1.Duration{Duration: time.Since(wish.CreationTimestamp.Time) + 24*time.Hour}
+			Expect(k8sClient.Update(ctx, wish)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Active).To(BeTrue())
+		})
+	})
+
+	Context("When reconciling Wishes with nil, zero and tiny TTLs", func() {
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+
+		ttls := map[string]*metav1.Duration{
+			"test-wish-ttl-nil":  nil,
+			"test-wish-ttl-zero": {},
+			"test-wish-ttl-tiny": {Duration: time.Millisecond},
+		}
+
+		BeforeEach(func() {
+			By("Creating a Wish per TTL")
+			for name, ttl := range ttls {
+				wish := &wishlistv1alpha1.Wish{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: wishNamespace,
+					},
+					Spec: wishlistv1alpha1.WishSpec{
+						Title: "TTL Gift",
+						TTL:   ttl,
+					},
+				}
+				Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resources")
+			for name := range ttls {
+				wish := &wishlistv1alpha1.Wish{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: wishNamespace}, wish)
+				if err == nil {
+					Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+				}
+			}
+		})
+
+		// reconcileAt reconciles the named wish with the clock at its creation
+		// plus offset and returns the result and the updated wish.
+		reconcileAt := func(name string, offset time.Duration) (reconcile.Result, *wishlistv1alpha1.Wish) {
+			key := types.NamespacedName{Name: name, Namespace: wishNamespace}
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, key, wish)).To(Succeed())
+
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+				Clock:  clocktesting.NewFakePassiveClock(wish.CreationTimestamp.Add(offset)),
+			}
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, key, wish)).To(Succeed())
+
+			return result, wish
+		}
+
+		It("should keep wishes without a TTL active without a requeue", func() {
+			for _, name := range []string{"test-wish-ttl-nil", "test-wish-ttl-zero"} {
+				result, wish := reconcileAt(name, 365*24*time.Hour)
+				Expect(wish.Status.Active).To(BeTrue(), name)
+				Expect(result.RequeueAfter).To(BeZero(), name)
+			}
+		})
+
+		It("should requeue a tiny TTL for its expiry and then deactivate it", func() {
+			result, wish := reconcileAt("test-wish-ttl-tiny", 0)
+			Expect(wish.Status.Active).To(BeTrue())
+			Expect(result.RequeueAfter).To(Equal(time.Millisecond))
+
+			result, wish = reconcileAt("test-wish-ttl-tiny", time.Second)
+			Expect(wish.Status.Active).To(BeFalse())
+			Expect(result.RequeueAfter).To(BeZero())
+		})
 	})
 
 	Context("When reconciling a Wish with legacy expired reservation", func() {
@@ -356,6 +475,2398 @@ var _ = Describe("Wish Controller", func() {
 		})
 	})
 
+	Context("When reconciling a Wish with a valid legacy reservation", func() {
+		const wishName = "test-wish-legacy-valid"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		reservedAt := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+		expiresAt := metav1.NewTime(time.Now().Add(7 * 24 * time.Hour).Truncate(time.Second))
+
+		BeforeEach(func() {
+			By("Creating a Wish with a legacy reservation that has not expired")
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title: "Legacy Reserved Gift",
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.Reserved = true                 //nolint:staticcheck // Testing legacy field migration
+			wish.Status.ReservedAt = &reservedAt        //nolint:staticcheck // Testing legacy field migration
+			wish.Status.ReservationExpires = &expiresAt //nolint:staticcheck // Testing legacy field migration
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should migrate the legacy reservation into the slice", func() {
+			By("Reconciling the resource with a valid legacy reservation")
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Checking that the reservation moved to the slice and legacy fields are cleared")
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			//nolint:staticcheck // Verifying legacy fields are cleared after migration
+			Expect(wish.Status.Reserved).To(BeFalse())
+			//nolint:staticcheck // Verifying legacy fields are cleared after migration
+			Expect(wish.Status.ReservedAt).To(BeNil())
+			//nolint:staticcheck // Verifying legacy fields are cleared after migration
+			Expect(wish.Status.ReservationExpires).To(BeNil())
+			Expect(wish.Status.Reservations).To(HaveLen(1))
+			Expect(wish.Status.Reservations[0].Quantity).To(Equal(int32(1)))
+			Expect(wish.Status.Reservations[0].CreatedAt.Unix()).To(Equal(reservedAt.Unix()))
+			Expect(wish.Status.Reservations[0].ExpiresAt.Unix()).To(Equal(expiresAt.Unix()))
+		})
+	})
+
+	Context("When reconciling a Wish with orphaned legacy fields next to reservations", func() {
+		const wishName = "test-wish-legacy-orphaned"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		BeforeEach(func() {
+			By("Creating a Wish with both a reservations slice and legacy fields")
+			now := metav1.NewTime(time.Now().Truncate(time.Second))
+			expiresAt := metav1.NewTime(now.Add(24 * time.Hour))
+			legacyExpires := metav1.NewTime(now.Add(48 * time.Hour))
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    testMultiReservedGift,
+					Quantity: 5,
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{
+				{Quantity: 2, CreatedAt: now, ExpiresAt: expiresAt},
+			}
+			wish.Status.Reserved = true                     //nolint:staticcheck // Testing legacy field migration
+			wish.Status.ReservedAt = &now                   //nolint:staticcheck // Testing legacy field migration
+			wish.Status.ReservationExpires = &legacyExpires //nolint:staticcheck // Testing legacy field migration
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should drop the legacy fields without adding a reservation", func() {
+			By("Reconciling the resource")
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			//nolint:staticcheck // Verifying legacy fields are cleared after migration
+			Expect(wish.Status.Reserved).To(BeFalse())
+			//nolint:staticcheck // Verifying legacy fields are cleared after migration
+			Expect(wish.Status.ReservedAt).To(BeNil())
+			Expect(wish.Status.Reservations).To(HaveLen(1))
+			Expect(wish.TotalReserved()).To(Equal(int32(2)))
+
+			By("Reconciling again leaves the reservations as they are")
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Reservations).To(HaveLen(1))
+		})
+	})
+
+	//nolint:staticcheck // Testing legacy field migration
+	Context("When normalizing legacy reservation fields", func() {
+		now := time.Now().Truncate(time.Second)
+		reservedAt := metav1.NewTime(now.Add(-time.Hour))
+		validUntil := metav1.NewTime(now.Add(24 * time.Hour))
+		expired := metav1.NewTime(now.Add(-time.Minute))
+
+		sliceEntry := wishlistv1alpha1.Reservation{Quantity: 2, CreatedAt: reservedAt, ExpiresAt: validUntil}
+
+		// normalize migrates the wish's status, checks the legacy fields are
+		// gone and that a second pass changes nothing.
+		normalize := func(status wishlistv1alpha1.WishStatus) wishlistv1alpha1.WishStatus {
+			wish := &wishlistv1alpha1.Wish{Status: status}
+
+			Expect(migrateLegacyReservation(wish, now)).To(BeTrue())
+			Expect(wish.Status.Reserved).To(BeFalse())
+			Expect(wish.Status.ReservedAt).To(BeNil())
+			Expect(wish.Status.ReservationExpires).To(BeNil())
+
+			converged := *wish.Status.DeepCopy()
+			Expect(migrateLegacyReservation(wish, now)).To(BeFalse())
+			Expect(wish.Status).To(Equal(converged))
+
+			return wish.Status
+		}
+
+		It("should move a valid legacy reservation into an empty slice", func() {
+			status := normalize(wishlistv1alpha1.WishStatus{
+				Reserved: true, ReservedAt: &reservedAt, ReservationExpires: &validUntil,
+			})
+			Expect(status.Reservations).To(ConsistOf(
+				wishlistv1alpha1.Reservation{Quantity: 1, CreatedAt: reservedAt, ExpiresAt: validUntil},
+			))
+		})
+
+		It("should drop legacy fields that duplicate a slice entry", func() {
+			status := normalize(wishlistv1alpha1.WishStatus{
+				Reserved: true, ReservedAt: &reservedAt, ReservationExpires: &validUntil,
+				Reservations: []wishlistv1alpha1.Reservation{sliceEntry},
+			})
+			Expect(status.Reservations).To(ConsistOf(sliceEntry))
+		})
+
+		It("should trust a populated slice over differing legacy fields", func() {
+			otherAt := metav1.NewTime(now.Add(-2 * time.Hour))
+			later := metav1.NewTime(now.Add(48 * time.Hour))
+			status := normalize(wishlistv1alpha1.WishStatus{
+				Reserved: true, ReservedAt: &otherAt, ReservationExpires: &later,
+				Reservations: []wishlistv1alpha1.Reservation{sliceEntry},
+			})
+			Expect(status.Reservations).To(ConsistOf(sliceEntry))
+		})
+
+		It("should drop an expired legacy reservation", func() {
+			status := normalize(wishlistv1alpha1.WishStatus{
+				Reserved: true, ReservedAt: &reservedAt, ReservationExpires: &expired,
+			})
+			Expect(status.Reservations).To(BeEmpty())
+		})
+
+		It("should drop an incomplete legacy reservation", func() {
+			status := normalize(wishlistv1alpha1.WishStatus{
+				Reserved: true, ReservationExpires: &validUntil,
+			})
+			Expect(status.Reservations).To(BeEmpty())
+		})
+
+		It("should drop legacy timestamps left without the reserved flag", func() {
+			status := normalize(wishlistv1alpha1.WishStatus{
+				ReservedAt: &reservedAt, ReservationExpires: &validUntil,
+			})
+			Expect(status.Reservations).To(BeEmpty())
+		})
+
+		It("should leave a status without legacy fields alone", func() {
+			wish := &wishlistv1alpha1.Wish{Status: wishlistv1alpha1.WishStatus{
+				Reservations: []wishlistv1alpha1.Reservation{sliceEntry},
+			}}
+			Expect(migrateLegacyReservation(wish, now)).To(BeFalse())
+			Expect(wish.Status.Reservations).To(ConsistOf(sliceEntry))
+		})
+	})
+
+	Context("When auto-extending reservations on an active Wish", func() {
+		const wishName = "test-wish-auto-extend"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		autoExtend := ReservationAutoExtend{Step: 24 * time.Hour, MaxHold: 72 * time.Hour}
+
+		BeforeEach(func() {
+			By("Creating a Wish with reservations close to expiry")
+			now := time.Now()
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    testMultiReservedGift,
+					Quantity: 5,
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{
+				// Young reservation about to expire: extended by a full step
+				{
+					Quantity:  1,
+					CreatedAt: metav1.NewTime(now.Add(-24 * time.Hour)),
+					ExpiresAt: metav1.NewTime(now.Add(time.Hour)),
+				},
+				// Near the max hold: extended only up to CreatedAt+MaxHold
+				{
+					Quantity:  2,
+					CreatedAt: metav1.NewTime(now.Add(-71 * time.Hour)),
+					ExpiresAt: metav1.NewTime(now.Add(10 * time.Minute)),
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should extend reservations while active and stop at the max hold", func() {
+			reconciler := &WishReconciler{
+				Client:     k8sClient,
+				Scheme:     k8sClient.Scheme(),
+				AutoExtend: autoExtend,
+			}
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Reservations).To(HaveLen(2))
+
+			By("Checking the young reservation was pushed a full step ahead")
+			young := wish.Status.Reservations[0]
+			Expect(young.ExpiresAt.Time).To(BeTemporally("~", time.Now().Add(autoExtend.Step), time.Minute))
+
+			By("Checking the old reservation was capped at the max hold")
+			old := wish.Status.Reservations[1]
+			Expect(old.ExpiresAt.Time).To(BeTemporally("~", old.CreatedAt.Add(autoExtend.MaxHold), time.Second))
+
+			By("Reconciling again leaves the capped reservation alone")
+			capped := old.ExpiresAt
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Reservations[1].ExpiresAt.Equal(&capped)).To(BeTrue())
+		})
+
+		It("should not extend reservations past the wish's TTL", func() {
+			By("Giving the Wish a TTL that ends before a full step")
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			wish.Spec.TTL = &metav1.Duration{Duration: 3 * time.Hour}
+			Expect(k8sClient.Update(ctx, wish)).To(Succeed())
+
+			reconciler := &WishReconciler{
+				Client:     k8sClient,
+				Scheme:     k8sClient.Scheme(),
+				AutoExtend: autoExtend,
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			expiration, ok := wish.ExpirationTime()
+			Expect(ok).To(BeTrue())
+			Expect(wish.Status.Reservations[0].ExpiresAt.Time).To(BeTemporally("~", expiration, time.Second))
+		})
+
+		It("should not extend reservations when auto-extend is off", func() {
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Reservations).To(HaveLen(2))
+			Expect(wish.Status.Reservations[0].ExpiresAt.Time).To(BeTemporally("<", time.Now().Add(2*time.Hour)))
+		})
+	})
+
+	Context("When reconciling a fund Wish whose target was lowered", func() {
+		const wishName = "test-wish-fund"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		BeforeEach(func() {
+			By("Creating a fund Wish with part of its target raised")
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:      "Fund Gift",
+					Fund:       true,
+					FundTarget: 1000,
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.FundRaised = 600
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+
+			wish.Spec.FundTarget = 500
+			Expect(k8sClient.Update(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should mark the wish as fulfilled", func() {
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Fulfilled).To(BeTrue())
+		})
+	})
+
+	Context("When reconciling a Wish with purchased items", func() {
+		const wishName = "test-wish-purchased"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		purchase := func(quantity int32) {
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+
+			wish.Status.PurchasedQuantity = quantity
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			By("Creating a Wish for 5 items")
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    testMultiReservedGift,
+					Quantity: 5,
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should reduce availability without fulfilling a partial purchase", func() {
+			purchase(2)
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.AvailableQuantity()).To(Equal(int32(3)))
+			Expect(wish.Status.Fulfilled).To(BeFalse())
+		})
+
+		It("should mark the wish fulfilled once every item is purchased", func() {
+			purchase(5)
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.AvailableQuantity()).To(BeZero())
+			Expect(wish.Status.Fulfilled).To(BeTrue())
+		})
+	})
+
+	Context("When reconciling a Wish without purchase links", func() {
+		const wishName = "test-wish-no-links"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		reconcileWish := func() *wishlistv1alpha1.Wish {
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+
+			return wish
+		}
+
+		BeforeEach(func() {
+			By("Creating a Wish with no official or purchase URL")
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title: "Linkless Gift",
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should set NoPurchaseLinks and clear it once a link is added", func() {
+			wish := reconcileWish()
+			Expect(meta.IsStatusConditionTrue(wish.Status.Conditions, wishlistv1alpha1.ConditionNoPurchaseLinks)).To(BeTrue())
+
+			By("Adding a purchase URL")
+			wish.Spec.PurchaseURLs = []string{"https://shop.example/gift"}
+			Expect(k8sClient.Update(ctx, wish)).To(Succeed())
+
+			wish = reconcileWish()
+			condition := meta.FindStatusCondition(wish.Status.Conditions, wishlistv1alpha1.ConditionNoPurchaseLinks)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("HasLinks"))
+		})
+	})
+
+	Context("When reconciling a Wish whose quantity was lowered below its reservations", func() {
+		const wishName = "test-wish-over-subscribed"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		BeforeEach(func() {
+			By("Creating a Wish with 4 of 5 units reserved")
+			now := time.Now()
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    testMultiReservedGift,
+					Quantity: 5,
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{
+				{
+					Quantity:  1,
+					CreatedAt: metav1.NewTime(now.Add(-time.Hour)),
+					ExpiresAt: metav1.NewTime(now.Add(24 * time.Hour)),
+				},
+				{
+					Quantity:  2,
+					CreatedAt: metav1.NewTime(now.Add(-3 * time.Hour)),
+					ExpiresAt: metav1.NewTime(now.Add(24 * time.Hour)),
+				},
+				{
+					Quantity:  1,
+					CreatedAt: metav1.NewTime(now.Add(-2 * time.Hour)),
+					ExpiresAt: metav1.NewTime(now.Add(24 * time.Hour)),
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+
+			By("Lowering the quantity to 2")
+			wish.Spec.Quantity = 2
+			Expect(k8sClient.Update(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should flag the wish and keep the reservations by default", func() {
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.TotalReserved()).To(Equal(int32(4)))
+			Expect(meta.IsStatusConditionTrue(wish.Status.Conditions, wishlistv1alpha1.ConditionOverSubscribed)).To(BeTrue())
+
+			By("Raising the quantity again clears the flag")
+			wish.Spec.Quantity = 4
+			Expect(k8sClient.Update(ctx, wish)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(meta.IsStatusConditionFalse(wish.Status.Conditions, wishlistv1alpha1.ConditionOverSubscribed)).To(BeTrue())
+		})
+
+		It("should trim the oldest reservations in trim mode", func() {
+			reconciler := &WishReconciler{
+				Client:           k8sClient,
+				Scheme:           k8sClient.Scheme(),
+				OverSubscription: OverSubscriptionTrim,
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.TotalReserved()).To(Equal(int32(2)))
+			Expect(meta.FindStatusCondition(wish.Status.Conditions, wishlistv1alpha1.ConditionOverSubscribed)).To(BeNil())
+
+			By("Checking the oldest reservation was released and the next one reduced")
+			Expect(wish.Status.Reservations).To(HaveLen(2))
+			Expect(wish.Status.Reservations[0].Quantity).To(Equal(int32(1)))
+			Expect(wish.Status.Reservations[0].CreatedAt.Time).To(BeTemporally("~", time.Now().Add(-2*time.Hour), time.Minute))
+			Expect(wish.Status.Reservations[1].Quantity).To(Equal(int32(1)))
+			Expect(wish.Status.Reservations[1].CreatedAt.Time).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+		})
+
+		It("should leave a wish that allows multiple reservations alone", func() {
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			wish.Spec.AllowMultipleReservations = true
+			Expect(k8sClient.Update(ctx, wish)).To(Succeed())
+
+			reconciler := &WishReconciler{
+				Client:           k8sClient,
+				Scheme:           k8sClient.Scheme(),
+				OverSubscription: OverSubscriptionTrim,
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.TotalReserved()).To(Equal(int32(4)))
+			Expect(wish.Status.Reservations).To(HaveLen(3))
+			Expect(meta.FindStatusCondition(wish.Status.Conditions, wishlistv1alpha1.ConditionOverSubscribed)).To(BeNil())
+		})
+	})
+
+	Context("When reconciling a Wish with pending reservations", func() {
+		const wishName = "test-wish-pending"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		BeforeEach(func() {
+			By("Creating a Wish with a lapsed and a fresh pending reservation")
+			now := time.Now()
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    testMultiReservedGift,
+					Quantity: 5,
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			lapsed := metav1.NewTime(now.Add(-time.Minute))
+			fresh := metav1.NewTime(now.Add(5 * time.Minute))
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{
+				{
+					Quantity:         2,
+					CreatedAt:        metav1.NewTime(now.Add(-11 * time.Minute)),
+					ExpiresAt:        metav1.NewTime(now.Add(7 * 24 * time.Hour)),
+					PendingUntil:     &lapsed,
+					PendingTokenHash: "lapsed",
+				},
+				{
+					Quantity:         1,
+					CreatedAt:        metav1.NewTime(now.Add(-5 * time.Minute)),
+					ExpiresAt:        metav1.NewTime(now.Add(7 * 24 * time.Hour)),
+					PendingUntil:     &fresh,
+					PendingTokenHash: "fresh",
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should drop unconfirmed reservations and requeue for the next one", func() {
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+			Expect(result.RequeueAfter).To(BeNumerically("<=", 5*time.Minute))
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Reservations).To(HaveLen(1))
+			Expect(wish.Status.Reservations[0].PendingTokenHash).To(Equal("fresh"))
+		})
+	})
+
+	Context("When reconciling a Wish with reservations in their grace period", func() {
+		const wishName = "test-wish-grace"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		BeforeEach(func() {
+			By("Creating a Wish with a just-expired and a long-expired reservation")
+			now := time.Now()
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    testMultiReservedGift,
+					Quantity: 5,
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{
+				{
+					Quantity:  2,
+					CreatedAt: metav1.NewTime(now.Add(-7 * 24 * time.Hour)),
+					ExpiresAt: metav1.NewTime(now.Add(-10 * time.Minute)),
+				},
+				{
+					Quantity:  1,
+					CreatedAt: metav1.NewTime(now.Add(-7 * 24 * time.Hour)),
+					ExpiresAt: metav1.NewTime(now.Add(-3 * time.Hour)),
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should keep a just-expired reservation within the grace period", func() {
+			reconciler := &WishReconciler{
+				Client:           k8sClient,
+				Scheme:           k8sClient.Scheme(),
+				ReservationGrace: time.Hour,
+			}
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", 50*time.Minute, time.Minute))
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Reservations).To(HaveLen(1))
+			Expect(wish.TotalReserved()).To(Equal(int32(2)))
+			Expect(wish.ExpiringReservations()).To(HaveLen(1))
+		})
+
+		It("should clear reservations once the grace period is over", func() {
+			reconciler := &WishReconciler{
+				Client:           k8sClient,
+				Scheme:           k8sClient.Scheme(),
+				ReservationGrace: 5 * time.Minute,
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Reservations).To(BeEmpty())
+		})
+	})
+
+	Context("When reconciling with an injected clock", func() {
+		const wishName = "test-wish-clock"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		start := time.Date(2030, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+		BeforeEach(func() {
+			By("Creating a Wish with a reservation expiring an hour after the fake start")
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    testMultiReservedGift,
+					Quantity: 3,
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{{
+				Quantity:  1,
+				CreatedAt: metav1.NewTime(start.Add(-time.Hour)),
+				ExpiresAt: metav1.NewTime(start.Add(time.Hour)),
+			}}
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should expire reservations by the clock's time", func() {
+			fakeClock := clocktesting.NewFakePassiveClock(start)
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+				Clock:  fakeClock,
+			}
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Hour))
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Reservations).To(HaveLen(1))
+
+			fakeClock.SetTime(start.Add(time.Hour + time.Second))
+
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Reservations).To(BeEmpty())
+			Expect(wish.Status.History).To(HaveLen(1))
+			Expect(wish.Status.History[0].EndedAt.Time).To(BeTemporally("==", start.Add(time.Hour+time.Second)))
+		})
+	})
+
+	Context("When a Wish's spec changes", func() {
+		const wishName = "test-wish-generation"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		BeforeEach(func() {
+			By("Creating a Wish with a long TTL and a condition")
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title: "Generation Gift",
+					TTL:   &metav1.Duration{Duration: 24 * time.Hour},
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			meta.SetStatusCondition(&wish.Status.Conditions, metav1.Condition{
+				Type:               wishlistv1alpha1.ConditionOverSubscribed,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: wish.Generation,
+				Reason:             "WithinQuantity",
+				Message:            "Reservations fit the quantity",
+			})
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should recompute the status for the new generation", func() {
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			request := reconcile.Request{NamespacedName: typeNamespacedName}
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Active).To(BeTrue())
+			Expect(wish.Status.ObservedGeneration).To(Equal(wish.Generation))
+
+			By("Shortening the TTL so the wish has already expired")
+			wish.Spec.TTL = &metav1.Duration{Duration: time.Millisecond}
+			Expect(k8sClient.Update(ctx, wish)).To(Succeed())
+			Expect(wish.Generation).To(BeNumerically(">", wish.Status.ObservedGeneration))
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Active).To(BeFalse())
+			Expect(wish.Status.ObservedGeneration).To(Equal(wish.Generation))
+
+			condition := meta.FindStatusCondition(wish.Status.Conditions, wishlistv1alpha1.ConditionOverSubscribed)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.ObservedGeneration).To(Equal(wish.Generation))
+		})
+	})
+
+	Context("When a Wish is about to fall out of its TTL", func() {
+		const wishName = "test-wish-expiring"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		BeforeEach(func() {
+			By("Creating a Wish with a one hour TTL")
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title: "Expiring Gift",
+					TTL:   &metav1.Duration{Duration: time.Hour},
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should warn once within the window", func() {
+			recorder := events.NewFakeRecorder(10)
+			reconciler := &WishReconciler{
+				Client:        k8sClient,
+				Scheme:        k8sClient.Scheme(),
+				ExpiryWarning: 2 * time.Hour,
+				Recorder:      recorder,
+			}
+			request := reconcile.Request{NamespacedName: typeNamespacedName}
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(wish.Status.Conditions,
+				wishlistv1alpha1.ConditionExpiringSoon)).To(BeTrue())
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(ContainSubstring("ExpiringSoon"))
+
+			By("Reconciling again within the window")
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).To(BeEmpty())
+
+			By("Extending the TTL past the window")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			wish.Spec.TTL = &metav1.Duration{Duration: 24 * time.Hour}
+			Expect(k8sClient.Update(ctx, wish)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", 22*time.Hour, time.Minute))
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(wish.Status.Conditions,
+				wishlistv1alpha1.ConditionExpiringSoon)).To(BeFalse())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should requeue at the warning threshold outside the window", func() {
+			recorder := events.NewFakeRecorder(10)
+			reconciler := &WishReconciler{
+				Client:        k8sClient,
+				Scheme:        k8sClient.Scheme(),
+				ExpiryWarning: 15 * time.Minute,
+				Recorder:      recorder,
+			}
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", 45*time.Minute, time.Minute))
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(meta.FindStatusCondition(wish.Status.Conditions,
+				wishlistv1alpha1.ConditionExpiringSoon)).To(BeNil())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	Context("When a Wish has a needed-by date", func() {
+		const wishName = "test-wish-needed-by"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		createWish := func(neededIn time.Duration) {
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    "Needed Gift",
+					TTL:      &metav1.Duration{Duration: 24 * time.Hour},
+					NeededBy: &metav1.Time{Time: time.Now().Add(neededIn).Truncate(time.Second)},
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+		}
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should mark the wish overdue once the date passes", func() {
+			createWish(2 * time.Hour)
+
+			fakeClock := clocktesting.NewFakePassiveClock(time.Now())
+			recorder := events.NewFakeRecorder(10)
+			reconciler := &WishReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+				Clock:    fakeClock,
+			}
+			request := reconcile.Request{NamespacedName: typeNamespacedName}
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", 2*time.Hour, time.Minute))
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(meta.FindStatusCondition(wish.Status.Conditions, wishlistv1alpha1.ConditionOverdue)).To(BeNil())
+
+			By("Passing the needed-by date")
+			fakeClock.SetTime(fakeClock.Now().Add(3 * time.Hour))
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(wish.Status.Conditions, wishlistv1alpha1.ConditionOverdue)).To(BeTrue())
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(ContainSubstring("Overdue"))
+
+			By("Reconciling again while overdue")
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).To(BeEmpty())
+
+			By("Marking the wish received")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			wish.Status.Received = true
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			condition := meta.FindStatusCondition(wish.Status.Conditions, wishlistv1alpha1.ConditionOverdue)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("NotOverdue"))
+		})
+
+		It("should point at the TTL when the date falls after expiry", func() {
+			createWish(48 * time.Hour)
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := &WishReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+			}
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", 24*time.Hour, time.Minute))
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			condition := meta.FindStatusCondition(wish.Status.Conditions, wishlistv1alpha1.ConditionOverdue)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("NeededAfterExpiry"))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	Context("When summarizing a Wish's status", func() {
+		const wishName = "test-wish-summary"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		createWish := func(quantity, reserved int32, reservedUntil time.Time) {
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    "Summarized Gift",
+					Quantity: quantity,
+					TTL:      &metav1.Duration{Duration: 24 * time.Hour},
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			if reserved > 0 {
+				wish.Status.Reservations = []wishlistv1alpha1.Reservation{
+					{
+						Quantity:  reserved,
+						CreatedAt: metav1.Now(),
+						ExpiresAt: metav1.NewTime(reservedUntil),
+					},
+				}
+				Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+			}
+		}
+
+		reconcileSummary := func(reconciler *WishReconciler) string {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+
+			return wish.Status.Summary
+		}
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should count what is still available on an active wish", func() {
+			createWish(5, 2, time.Now().Add(7*24*time.Hour))
+
+			reconciler := &WishReconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
+			Expect(reconcileSummary(reconciler)).To(Equal("Active, 3 of 5 available"))
+		})
+
+		It("should show when a fully reserved wish frees up", func() {
+			until := time.Now().Add(12 * time.Hour).Truncate(time.Second)
+			createWish(1, 1, until)
+
+			reconciler := &WishReconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
+			Expect(reconcileSummary(reconciler)).To(Equal("Reserved until " + until.Format("Jan 2, 2006")))
+
+			By("Localizing the summary")
+			reconciler.SummaryLanguage = i18n.LangRU
+			Expect(reconcileSummary(reconciler)).To(Equal("Забронировано до " + until.Format("02.01.2006")))
+		})
+
+		It("should report an expired wish", func() {
+			createWish(1, 0, time.Time{})
+
+			fakeClock := clocktesting.NewFakePassiveClock(time.Now())
+			reconciler := &WishReconciler{Client: k8sClient, Scheme: k8sClient.Scheme(), Clock: fakeClock}
+			Expect(reconcileSummary(reconciler)).To(Equal("Active, 1 of 1 available"))
+
+			By("Passing the TTL")
+			fakeClock.SetTime(fakeClock.Now().Add(48 * time.Hour))
+			Expect(reconcileSummary(reconciler)).To(Equal("Expired"))
+		})
+	})
+
+	Context("When scheduling reservation reminders", func() {
+		const wishName = "test-wish-reminder"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should remind the lead time before the soonest confirmed reservation expires", func() {
+			now := time.Now().Truncate(time.Second)
+			soonest := now.Add(5 * 24 * time.Hour)
+			pendingUntil := metav1.NewTime(now.Add(time.Hour))
+
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    "Reminded Gift",
+					Quantity: 3,
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{
+				{Quantity: 1, CreatedAt: metav1.NewTime(now), ExpiresAt: metav1.NewTime(now.Add(14 * 24 * time.Hour))},
+				{Quantity: 1, CreatedAt: metav1.NewTime(now), ExpiresAt: metav1.NewTime(soonest)},
+				{
+					Quantity:     1,
+					CreatedAt:    metav1.NewTime(now),
+					ExpiresAt:    metav1.NewTime(now.Add(2 * 24 * time.Hour)),
+					PendingUntil: &pendingUntil,
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+
+			reconciler := &WishReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				Clock:        clocktesting.NewFakePassiveClock(now),
+				ReminderLead: 48 * time.Hour,
+			}
+			request := reconcile.Request{NamespacedName: typeNamespacedName}
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.NextReminderAt).NotTo(BeNil())
+			Expect(wish.Status.NextReminderAt.Time).To(BeTemporally("==", soonest.Add(-48*time.Hour)))
+
+			By("Releasing the soonest reservation")
+			wish.Status.Reservations = wish.Status.Reservations[:1]
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.NextReminderAt).NotTo(BeNil())
+			Expect(wish.Status.NextReminderAt.Time).To(BeTemporally("==", now.Add(12*24*time.Hour)))
+
+			By("Releasing the last reservation")
+			wish.Status.Reservations = nil
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.NextReminderAt).To(BeNil())
+		})
+	})
+
+	Context("When decaying wish priorities", func() {
+		const wishName = "test-wish-decay"
+		const wishNamespace = "default"
+		const decay = 30 * 24 * time.Hour
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should lower the effective priority with age down to 1", func() {
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    "Aging Gift",
+					Priority: 5,
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+			created := wish.CreationTimestamp.Time
+
+			reconcileAt := func(age time.Duration) (reconcile.Result, *wishlistv1alpha1.Wish) {
+				reconciler := &WishReconciler{
+					Client:        k8sClient,
+					Scheme:        k8sClient.Scheme(),
+					Clock:         clocktesting.NewFakePassiveClock(created.Add(age)),
+					PriorityDecay: decay,
+				}
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+
+				got := &wishlistv1alpha1.Wish{}
+				Expect(k8sClient.Get(ctx, typeNamespacedName, got)).To(Succeed())
+
+				return result, got
+			}
+
+			By("Keeping the full priority while the wish is fresh")
+			result, got := reconcileAt(decay / 2)
+			Expect(got.Status.EffectivePriority).To(Equal(int32(5)))
+			Expect(result.RequeueAfter).To(Equal(decay / 2))
+
+			By("Dropping a star per decay period")
+			result, got = reconcileAt(2*decay + decay/4)
+			Expect(got.Status.EffectivePriority).To(Equal(int32(3)))
+			Expect(result.RequeueAfter).To(Equal(decay - decay/4))
+
+			By("Flooring at one star")
+			result, got = reconcileAt(10 * decay)
+			Expect(got.Status.EffectivePriority).To(Equal(int32(1)))
+			Expect(result.RequeueAfter).To(BeZero())
+			Expect(got.Spec.Priority).To(Equal(int32(5)))
+		})
+
+		It("should not decay wishes without a priority", func() {
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title: "Unranked Gift",
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			reconciler := &WishReconciler{
+				Client:        k8sClient,
+				Scheme:        k8sClient.Scheme(),
+				Clock:         clocktesting.NewFakePassiveClock(wish.CreationTimestamp.Add(10 * decay)),
+				PriorityDecay: decay,
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.EffectivePriority).To(BeZero())
+		})
+	})
+
+	Context("When tracking wish demand", func() {
+		const wishName = "test-wish-demand"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should count reservations and confirmed history", func() {
+			now := time.Now()
+
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    "Sought-after Gift",
+					Quantity: 5,
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{
+				{Quantity: 2, CreatedAt: metav1.NewTime(now), ExpiresAt: metav1.NewTime(now.Add(24 * time.Hour))},
+				{Quantity: 1, CreatedAt: metav1.NewTime(now), ExpiresAt: metav1.NewTime(now.Add(48 * time.Hour))},
+			}
+			wish.Status.History = []wishlistv1alpha1.ReservationRecord{
+				{
+					Quantity:  1,
+					CreatedAt: metav1.NewTime(now.Add(-14 * 24 * time.Hour)),
+					EndedAt:   metav1.NewTime(now.Add(-7 * 24 * time.Hour)),
+					Reason:    wishlistv1alpha1.HistoryReasonExpired,
+				},
+				{
+					Quantity:  1,
+					CreatedAt: metav1.NewTime(now.Add(-2 * time.Hour)),
+					EndedAt:   metav1.NewTime(now.Add(-time.Hour)),
+					Reason:    wishlistv1alpha1.HistoryReasonUnconfirmed,
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Demand).To(Equal(int32(3)))
+		})
+	})
+
+	Context("When notifying Active transitions", func() {
+		const wishName = "test-wish-active-hook"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should post only when the Active state changes", func() {
+			var (
+				mu       sync.Mutex
+				received []notify.Event
+			)
+
+			hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var event notify.Event
+				Expect(json.NewDecoder(r.Body).Decode(&event)).To(Succeed())
+
+				mu.Lock()
+				received = append(received, event)
+				mu.Unlock()
+
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			DeferCleanup(hook.Close)
+
+			events := func() []notify.Event {
+				mu.Lock()
+				defer mu.Unlock()
+
+				return slices.Clone(received)
+			}
+
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title: "Display Gift",
+					TTL:   &metav1.Duration{Duration: time.Hour},
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+			created := wish.CreationTimestamp.Time
+
+			activeHook := &EventHook{Sender: notify.NewWebhookWithTimeout(hook.URL, time.Second)}
+
+			reconcileAt := func(at time.Time) {
+				reconciler := &WishReconciler{
+					Client:     k8sClient,
+					Scheme:     k8sClient.Scheme(),
+					Clock:      clocktesting.NewFakePassiveClock(at),
+					ActiveHook: activeHook,
+				}
+
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			By("Announcing the wish turning active")
+			reconcileAt(created.Add(time.Minute))
+			Eventually(events, timeout, interval).Should(HaveLen(1))
+
+			first := events()[0]
+			Expect(first.Type).To(Equal(notify.EventWishActiveChanged))
+			Expect(first.Namespace).To(Equal(wishNamespace))
+			Expect(first.Wish).To(Equal(wishName))
+			Expect(first.Active).To(HaveValue(BeTrue()))
+
+			By("Staying quiet while the state holds")
+			reconcileAt(created.Add(2 * time.Minute))
+			reconcileAt(created.Add(30 * time.Minute))
+			Consistently(events, time.Second, interval).Should(HaveLen(1))
+
+			By("Announcing the wish expiring")
+			reconcileAt(created.Add(2 * time.Hour))
+			Eventually(events, timeout, interval).Should(HaveLen(2))
+			Expect(events()[1].Active).To(HaveValue(BeFalse()))
+
+			reconcileAt(created.Add(3 * time.Hour))
+			Consistently(events, time.Second, interval).Should(HaveLen(2))
+		})
+	})
+
+	Context("When a Wish is paused", func() {
+		const wishName = "test-wish-paused"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should leave the status untouched until the annotation is removed", func() {
+			now := time.Now()
+
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        wishName,
+					Namespace:   wishNamespace,
+					Annotations: map[string]string{wishlistv1alpha1.AnnotationPaused: "true"},
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title: "Paused Gift",
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			By("Storing an expired reservation the controller would normally clear")
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{{
+				Quantity:  1,
+				CreatedAt: metav1.NewTime(now.Add(-14 * 24 * time.Hour)),
+				ExpiresAt: metav1.NewTime(now.Add(-time.Hour)),
+			}}
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			request := reconcile.Request{NamespacedName: typeNamespacedName}
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Reservations).To(HaveLen(1))
+			Expect(wish.Status.Active).To(BeFalse())
+			Expect(wish.Status.ObservedGeneration).To(BeZero())
+			Expect(wish.Labels).NotTo(HaveKey(wishlistv1alpha1.LabelActive))
+
+			By("Removing the annotation")
+			delete(wish.Annotations, wishlistv1alpha1.AnnotationPaused)
+			Expect(k8sClient.Update(ctx, wish)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Reservations).To(BeEmpty())
+			Expect(wish.Status.Active).To(BeTrue())
+		})
+	})
+
+	Context("When reflecting Wish state into labels", func() {
+		const wishName = "test-wish-labels"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		BeforeEach(func() {
+			By("Creating an active Wish with a reservation and an unrelated label")
+			now := time.Now()
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+					Labels:    map[string]string{"owner": "alice"},
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    testMultiReservedGift,
+					Quantity: 2,
+					TTL:      &metav1.Duration{Duration: 24 * time.Hour},
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{{
+				Quantity:  1,
+				CreatedAt: metav1.NewTime(now),
+				ExpiresAt: metav1.NewTime(now.Add(7 * 24 * time.Hour)),
+			}}
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should keep the labels in step with status without further writes", func() {
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			request := reconcile.Request{NamespacedName: typeNamespacedName}
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Active).To(BeTrue())
+			Expect(wish.Labels).To(HaveKeyWithValue(wishlistv1alpha1.LabelActive, "true"))
+			Expect(wish.Labels).To(HaveKeyWithValue(wishlistv1alpha1.LabelReserved, "true"))
+			Expect(wish.Labels).To(HaveKeyWithValue("owner", "alice"))
+
+			By("Reconciling again without changes")
+			resourceVersion := wish.ResourceVersion
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.ResourceVersion).To(Equal(resourceVersion))
+
+			By("Releasing the reservation")
+			wish.Status.Reservations = nil
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Labels).To(HaveKeyWithValue(wishlistv1alpha1.LabelReserved, "false"))
+			Expect(wish.Labels).To(HaveKeyWithValue(wishlistv1alpha1.LabelActive, "true"))
+		})
+	})
+
+	Context("When exporting expiry metrics", func() {
+		const wishName = "test-wish-metrics"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		BeforeEach(func() {
+			By("Creating a Wish with a TTL and a reservation")
+			now := time.Now()
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title: "Metered Gift",
+					TTL:   &metav1.Duration{Duration: 48 * time.Hour},
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{{
+				Quantity:  1,
+				CreatedAt: metav1.NewTime(now),
+				ExpiresAt: metav1.NewTime(now.Add(2 * time.Hour)),
+			}}
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should report the remaining TTL and reservation time per wish", func() {
+			metrics := NewExpiryMetrics(ExpiryLabelsWish)
+			Expect(metrics.Register(prometheus.NewRegistry())).To(Succeed())
+
+			reconciler := &WishReconciler{
+				Client:  k8sClient,
+				Scheme:  k8sClient.Scheme(),
+				Metrics: metrics,
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			ttl := testutil.ToFloat64(metrics.wish.WithLabelValues(wishName, wishNamespace))
+			Expect(ttl).To(BeNumerically("~", (48 * time.Hour).Seconds(), 60))
+
+			reservation := testutil.ToFloat64(metrics.reservation.WithLabelValues(wishName, wishNamespace))
+			Expect(reservation).To(BeNumerically("~", (2 * time.Hour).Seconds(), 60))
+
+			By("Dropping the series once the wish is deleted")
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testutil.CollectAndCount(metrics.wish)).To(Equal(0))
+		})
+
+		It("should report the soonest expiry per namespace and -1 for never-expiring wishes", func() {
+			metrics := NewExpiryMetrics(ExpiryLabelsNamespace)
+			now := time.Now()
+
+			eternal := &wishlistv1alpha1.Wish{ObjectMeta: metav1.ObjectMeta{Name: "eternal", Namespace: "gifts"}}
+			metrics.observe(eternal, now)
+			Expect(testutil.ToFloat64(metrics.wish.WithLabelValues("gifts"))).To(Equal(float64(neverExpires)))
+			Expect(testutil.ToFloat64(metrics.reservation.WithLabelValues("gifts"))).To(Equal(float64(neverExpires)))
+
+			soon := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{Name: "soon", Namespace: "gifts", CreationTimestamp: metav1.NewTime(now)},
+				Spec:       wishlistv1alpha1.WishSpec{TTL: &metav1.Duration{Duration: time.Hour}},
+			}
+			later := soon.DeepCopy()
+			later.Name = "later"
+			later.Spec.TTL = &metav1.Duration{Duration: 3 * time.Hour}
+
+			metrics.observe(later, now)
+			metrics.observe(soon, now)
+			Expect(testutil.ToFloat64(metrics.wish.WithLabelValues("gifts"))).To(Equal(time.Hour.Seconds()))
+
+			metrics.forget(types.NamespacedName{Name: "soon", Namespace: "gifts"})
+			Expect(testutil.ToFloat64(metrics.wish.WithLabelValues("gifts"))).To(Equal((3 * time.Hour).Seconds()))
+		})
+
+		It("should parse the labeling", func() {
+			labels, err := ParseExpiryLabels("namespace")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labels).To(Equal(ExpiryLabelsNamespace))
+
+			_, err = ParseExpiryLabels("pod")
+			Expect(err).To(MatchError(ContainSubstring("pod")))
+		})
+	})
+
+	Context("When exporting reserved tag metrics", func() {
+		const wishName = "test-wish-tag-metrics"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		BeforeEach(func() {
+			By("Creating a tagged Wish with a reservation")
+			now := time.Now()
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title: "Tagged Gift",
+					Tags:  []string{"books", "rare"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{{
+				Quantity:  1,
+				CreatedAt: metav1.NewTime(now),
+				ExpiresAt: metav1.NewTime(now.Add(2 * time.Hour)),
+			}}
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should count reserved wishes under allowlisted tags only", func() {
+			metrics := NewReservedTagMetrics([]string{"books", "games"})
+			Expect(metrics.Register(prometheus.NewRegistry())).To(Succeed())
+
+			reconciler := &WishReconciler{
+				Client:     k8sClient,
+				Scheme:     k8sClient.Scheme(),
+				TagMetrics: metrics,
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(testutil.ToFloat64(metrics.gauge.WithLabelValues(wishNamespace, "books"))).To(Equal(1.0))
+			Expect(testutil.ToFloat64(metrics.gauge.WithLabelValues(wishNamespace, "games"))).To(Equal(0.0))
+			Expect(testutil.CollectAndCount(metrics.gauge)).To(Equal(2), "rare is not allowlisted")
+
+			By("Dropping the wish from the counts once it is deleted")
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testutil.ToFloat64(metrics.gauge.WithLabelValues(wishNamespace, "books"))).To(Equal(0.0))
+		})
+
+		It("should count only wishes with an active reservation", func() {
+			metrics := NewReservedTagMetrics([]string{"books"})
+			now := time.Now()
+
+			reserved := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{Name: "reserved", Namespace: "gifts"},
+				Spec:       wishlistv1alpha1.WishSpec{Tags: []string{"books"}},
+				Status: wishlistv1alpha1.WishStatus{Reservations: []wishlistv1alpha1.Reservation{{
+					Quantity:  1,
+					ExpiresAt: metav1.NewTime(now.Add(time.Hour)),
+				}}},
+			}
+			expired := reserved.DeepCopy()
+			expired.Name = "expired"
+			expired.Status.Reservations[0].ExpiresAt = metav1.NewTime(now.Add(-time.Minute))
+			free := reserved.DeepCopy()
+			free.Name = "free"
+			free.Status.Reservations = nil
+			other := reserved.DeepCopy()
+			other.Namespace = "elsewhere"
+
+			for _, wish := range []*wishlistv1alpha1.Wish{reserved, expired, free, other} {
+				metrics.observe(wish, now)
+			}
+			Expect(testutil.ToFloat64(metrics.gauge.WithLabelValues("gifts", "books"))).To(Equal(1.0))
+			Expect(testutil.ToFloat64(metrics.gauge.WithLabelValues("elsewhere", "books"))).To(Equal(1.0))
+
+			By("Recounting once the reservation is released")
+			reserved.Status.Reservations = nil
+			metrics.observe(reserved, now)
+			Expect(testutil.ToFloat64(metrics.gauge.WithLabelValues("gifts", "books"))).To(Equal(0.0))
+		})
+
+		It("should parse the tag allowlist", func() {
+			tags, err := ParseMetricTags(" books, games,,books ")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tags).To(Equal([]string{"books", "games"}))
+
+			tags, err = ParseMetricTags("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tags).To(BeEmpty())
+
+			many := make([]string, maxMetricTags+1)
+			for i := range many {
+				many[i] = fmt.Sprintf("tag-%d", i)
+			}
+			_, err = ParseMetricTags(strings.Join(many, ","))
+			Expect(err).To(MatchError(ContainSubstring("too many")))
+		})
+	})
+
+	Context("When releasing reservations on edit", func() {
+		const wishName = "test-wish-release-on-edit"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		// createReserved creates a wish holding one active reservation.
+		createReserved := func(annotations map[string]string) {
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        wishName,
+					Namespace:   wishNamespace,
+					Annotations: annotations,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:       "Desk Lamp",
+					OfficialURL: "https://example.com/lamp",
+					Quantity:    2,
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{{
+				Quantity:  1,
+				CreatedAt: metav1.Now(),
+				ExpiresAt: metav1.NewTime(time.Now().Add(7 * 24 * time.Hour)),
+			}}
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+		}
+
+		// edit applies change to the stored spec.
+		edit := func(change func(*wishlistv1alpha1.WishSpec)) {
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			change(&wish.Spec)
+			Expect(k8sClient.Update(ctx, wish)).To(Succeed())
+		}
+
+		reconcileWish := func(reconciler *WishReconciler) *wishlistv1alpha1.Wish {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+
+			return wish
+		}
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should release reservations when the title changes but not on other edits", func() {
+			var (
+				mu       sync.Mutex
+				received []notify.Event
+			)
+
+			hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var event notify.Event
+				Expect(json.NewDecoder(r.Body).Decode(&event)).To(Succeed())
+
+				mu.Lock()
+				received = append(received, event)
+				mu.Unlock()
+
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			DeferCleanup(hook.Close)
+
+			events := func() []notify.Event {
+				mu.Lock()
+				defer mu.Unlock()
+
+				return slices.Clone(received)
+			}
+
+			createReserved(nil)
+
+			reconciler := &WishReconciler{
+				Client:        k8sClient,
+				Scheme:        k8sClient.Scheme(),
+				ReleaseOnEdit: true,
+				ReleaseHook:   &EventHook{Sender: notify.NewWebhookWithTimeout(hook.URL, time.Second)},
+			}
+
+			By("Fingerprinting the key fields without releasing anything")
+			wish := reconcileWish(reconciler)
+			Expect(wish.Status.KeyFieldsHash).NotTo(BeEmpty())
+			Expect(wish.Status.Reservations).To(HaveLen(1))
+
+			By("Keeping reservations on an untracked edit")
+			edit(func(spec *wishlistv1alpha1.WishSpec) { spec.Priority = 5 })
+			wish = reconcileWish(reconciler)
+			Expect(wish.Status.ObservedGeneration).To(Equal(wish.Generation))
+			Expect(wish.Status.Reservations).To(HaveLen(1))
+			Consistently(events, time.Second, interval).Should(BeEmpty())
+
+			By("Releasing reservations when the title changes")
+			edit(func(spec *wishlistv1alpha1.WishSpec) { spec.Title = "Floor Lamp" })
+			wish = reconcileWish(reconciler)
+			Expect(wish.Status.Reservations).To(BeEmpty())
+			Expect(wish.Status.History).To(HaveLen(1))
+			Expect(wish.Status.History[0].Reason).To(Equal(wishlistv1alpha1.HistoryReasonReleased))
+			Expect(wish.Status.History[0].Quantity).To(Equal(int32(1)))
+
+			Eventually(events, timeout, interval).Should(HaveLen(1))
+			Expect(events()[0].Type).To(Equal(notify.EventReservationsReleased))
+			Expect(events()[0].Wish).To(Equal(wishName))
+			Expect(events()[0].Title).To(Equal("Floor Lamp"))
+			Expect(events()[0].Released).To(Equal(int32(1)))
+		})
+
+		It("should honor the annotation and leave other wishes alone", func() {
+			reconciler := &WishReconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
+
+			By("Keeping reservations without the policy")
+			createReserved(nil)
+			reconcileWish(reconciler)
+			edit(func(spec *wishlistv1alpha1.WishSpec) { spec.OfficialURL = "https://example.com/other" })
+			wish := reconcileWish(reconciler)
+			Expect(wish.Status.KeyFieldsHash).To(BeEmpty())
+			Expect(wish.Status.Reservations).To(HaveLen(1))
+			Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+
+			By("Releasing reservations of an annotated wish")
+			createReserved(map[string]string{wishlistv1alpha1.AnnotationReleaseOnEdit: "true"})
+			reconcileWish(reconciler)
+			edit(func(spec *wishlistv1alpha1.WishSpec) { spec.OfficialURL = "https://example.com/other" })
+			wish = reconcileWish(reconciler)
+			Expect(wish.Status.Reservations).To(BeEmpty())
+			Expect(wish.Status.History).To(HaveLen(1))
+			Expect(wish.Status.History[0].Reason).To(Equal(wishlistv1alpha1.HistoryReasonReleased))
+		})
+	})
+
+	Context("When pruning the reservation history", func() {
+		const wishName = "test-wish-history"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		record := func(endedAgo time.Duration) wishlistv1alpha1.ReservationRecord {
+			ended := time.Now().Add(-endedAgo)
+
+			return wishlistv1alpha1.ReservationRecord{
+				Quantity:  1,
+				CreatedAt: metav1.NewTime(ended.Add(-7 * 24 * time.Hour)),
+				EndedAt:   metav1.NewTime(ended),
+				Reason:    wishlistv1alpha1.HistoryReasonExpired,
+			}
+		}
+
+		BeforeEach(func() {
+			By("Creating a Wish with an expired reservation and some history")
+			now := time.Now()
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title:    testMultiReservedGift,
+					Quantity: 5,
+				},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+
+			wish.Status.Reservations = []wishlistv1alpha1.Reservation{{
+				Quantity:  2,
+				CreatedAt: metav1.NewTime(now.Add(-7 * 24 * time.Hour)),
+				ExpiresAt: metav1.NewTime(now.Add(-time.Minute)),
+			}}
+			wish.Status.History = []wishlistv1alpha1.ReservationRecord{
+				record(90 * 24 * time.Hour),
+				record(20 * 24 * time.Hour),
+				record(10 * 24 * time.Hour),
+			}
+			Expect(k8sClient.Status().Update(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			By("Cleaning up the Wish resource")
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should record cleared reservations and prune history beyond the retention", func() {
+			reconciler := &WishReconciler{
+				Client:           k8sClient,
+				Scheme:           k8sClient.Scheme(),
+				HistoryRetention: HistoryRetention{MaxEntries: 3, MaxAge: 30 * 24 * time.Hour},
+			}
+			request := reconcile.Request{NamespacedName: typeNamespacedName}
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", 10*24*time.Hour, time.Minute))
+
+			wish := &wishlistv1alpha1.Wish{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.Status.Reservations).To(BeEmpty())
+			Expect(wish.Status.History).To(HaveLen(3))
+			Expect(wish.Status.History[2].Reason).To(Equal(wishlistv1alpha1.HistoryReasonExpired))
+			Expect(wish.Status.History[2].Quantity).To(Equal(int32(2)))
+			Expect(wish.Status.History[0].EndedAt.Time).To(BeTemporally("~", time.Now().Add(-20*24*time.Hour), time.Minute))
+
+			By("Reconciling again within the retention")
+			resourceVersion := wish.ResourceVersion
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, wish)).To(Succeed())
+			Expect(wish.ResourceVersion).To(Equal(resourceVersion))
+			Expect(wish.Status.History).To(HaveLen(3))
+		})
+
+		It("should prune by count and by age independently", func() {
+			now := time.Now()
+			history := []wishlistv1alpha1.ReservationRecord{
+				record(3 * time.Hour), record(2 * time.Hour), record(time.Hour),
+			}
+
+			wish := &wishlistv1alpha1.Wish{Status: wishlistv1alpha1.WishStatus{History: slices.Clone(history)}}
+			pruned, next := pruneHistory(wish, HistoryRetention{MaxEntries: 2}, now)
+			Expect(pruned).To(Equal(1))
+			Expect(next.IsZero()).To(BeTrue())
+			Expect(wish.Status.History).To(Equal(history[1:]))
+
+			wish = &wishlistv1alpha1.Wish{Status: wishlistv1alpha1.WishStatus{History: slices.Clone(history)}}
+			pruned, _ = pruneHistory(wish, HistoryRetention{MaxAge: 90 * time.Minute}, now)
+			Expect(pruned).To(Equal(2))
+			Expect(wish.Status.History).To(Equal(history[2:]))
+
+			wish = &wishlistv1alpha1.Wish{Status: wishlistv1alpha1.WishStatus{History: slices.Clone(history)}}
+			pruned, _ = pruneHistory(wish, HistoryRetention{}, now)
+			Expect(pruned).To(BeZero())
+			Expect(wish.Status.History).To(Equal(history))
+		})
+	})
+
+	Context("When tracking reconcile health", func() {
+		const wishName = "test-wish-health"
+		const wishNamespace = "default"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{
+			Name:      wishName,
+			Namespace: wishNamespace,
+		}
+
+		BeforeEach(func() {
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wishName,
+					Namespace: wishNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{Title: "Healthy Gift"},
+			}
+			Expect(k8sClient.Create(ctx, wish)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			wish := &wishlistv1alpha1.Wish{}
+			err := k8sClient.Get(ctx, typeNamespacedName, wish)
+			if err == nil {
+				Expect(k8sClient.Delete(ctx, wish)).To(Succeed())
+			}
+		})
+
+		It("should update the gauge after a successful reconcile", func() {
+			health := NewReconcileHealth()
+			Expect(health.Register(prometheus.NewRegistry())).To(Succeed())
+
+			reconciler := &WishReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+				Health: health,
+			}
+
+			before := time.Now()
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(testutil.ToFloat64(health.gauge)).To(BeNumerically("~", float64(before.Unix()), 5))
+		})
+
+		It("should fail readiness once reconciles are stale", func() {
+			health := NewReconcileHealth()
+			elected := make(chan struct{})
+			check := health.ReadyCheck(time.Hour, elected)
+
+			By("Passing on a replica that is not the leader")
+			health.succeeded(time.Now().Add(-2 * time.Hour))
+			Expect(check(nil)).To(Succeed())
+
+			By("Failing on the leader once the last success is too old")
+			close(elected)
+			Expect(check(nil)).To(MatchError(errReconcileStale))
+
+			By("Recovering after the next successful reconcile")
+			health.succeeded(time.Now())
+			Expect(check(nil)).To(Succeed())
+		})
+
+		It("should pass before the first reconcile", func() {
+			elected := make(chan struct{})
+			close(elected)
+
+			Expect(NewReconcileHealth().ReadyCheck(time.Hour, elected)(nil)).To(Succeed())
+		})
+	})
+
+	Context("When parsing the over-subscription mode", func() {
+		It("should accept the known modes and default to flag", func() {
+			for name, want := range map[string]OverSubscriptionMode{
+				"":     OverSubscriptionFlag,
+				"flag": OverSubscriptionFlag,
+				"trim": OverSubscriptionTrim,
+			} {
+				mode, err := ParseOverSubscriptionMode(name)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mode).To(Equal(want))
+			}
+
+			_, err := ParseOverSubscriptionMode("drop")
+			Expect(err).To(MatchError(ContainSubstring("drop")))
+		})
+	})
+
+	Context("When configuring the reconcile backoff", func() {
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "backoff", Namespace: "default"}}
+
+		It("should apply exponential backoff capped at the max delay", func() {
+			backoff := ReconcileBackoff{BaseDelay: time.Second, MaxDelay: 4 * time.Second}
+
+			limiter := backoff.rateLimiter()
+			Expect(limiter).NotTo(BeNil())
+			Expect(limiter.When(req)).To(Equal(time.Second))
+			Expect(limiter.When(req)).To(Equal(2 * time.Second))
+			Expect(limiter.When(req)).To(Equal(4 * time.Second))
+			Expect(limiter.When(req)).To(Equal(4 * time.Second))
+			Expect(limiter.NumRequeues(req)).To(Equal(4))
+
+			limiter.Forget(req)
+			Expect(limiter.When(req)).To(Equal(time.Second))
+		})
+
+		It("should keep the controller-runtime default when unset", func() {
+			Expect(ReconcileBackoff{}.rateLimiter()).To(BeNil())
+			Expect(ReconcileBackoff{BaseDelay: time.Second}.rateLimiter()).To(BeNil())
+		})
+	})
+
 	Context("When reconciling a Wish without TTL", func() {
 		const wishName = "test-wish-no-ttl"
 		const wishNamespace = "default"
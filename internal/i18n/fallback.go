@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package i18n
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// fallbacks holds the configured fallback chain per language. Unset means
+// every language falls back straight to DefaultLang.
+var fallbacks atomic.Pointer[map[string][]string] //nolint:gochecknoglobals // set once at startup
+
+// SetFallbacks configures the languages T tries, in order, when a key is
+// missing in the requested language, before DefaultLang. Languages without a
+// chain keep falling back to DefaultLang only. It is meant to be called once
+// at startup.
+func SetFallbacks(chains map[string][]string) error {
+	for lang, chain := range chains {
+		if !IsSupported(lang) {
+			return fmt.Errorf("unsupported language %q", lang)
+		}
+
+		for _, fallback := range chain {
+			if !IsSupported(fallback) {
+				return fmt.Errorf("unsupported fallback language %q for %s", fallback, lang)
+			}
+		}
+	}
+
+	fallbacks.Store(&chains)
+
+	return nil
+}
+
+// ParseFallbacks parses comma-separated lang=chain pairs, the chain being
+// languages separated by colons, e.g. "zh=ru:en,ru=en".
+func ParseFallbacks(spec string) (map[string][]string, error) {
+	chains := make(map[string][]string)
+
+	for pair := range strings.SplitSeq(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		lang, chain, ok := strings.Cut(pair, "=")
+		if !ok || lang == "" || chain == "" {
+			return nil, fmt.Errorf("invalid fallback %q (want lang=lang[:lang...])", pair)
+		}
+
+		chains[lang] = strings.Split(chain, ":")
+	}
+
+	return chains, nil
+}
+
+// fallbackChain returns the languages to try for lang, in order, ending with
+// DefaultLang.
+func fallbackChain(chains map[string][]string, lang string) []string {
+	chain := append([]string{lang}, chains[lang]...)
+
+	return append(chain, DefaultLang)
+}
+
+// translate looks key up along lang's fallback chain in catalog, returning
+// the key itself if no language has it.
+func translate(catalog map[string]map[string]string, chains map[string][]string, lang, key string) string {
+	for _, candidate := range fallbackChain(chains, lang) {
+		if msg, ok := catalog[candidate][key]; ok {
+			return msg
+		}
+	}
+
+	return key
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestTranslate_FallbackChain resolves keys missing in Chinese through a
+// custom chain, using a small catalog so the real translations, which are
+// complete in every language, don't mask the fallback.
+func TestTranslate_FallbackChain(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]map[string]string{
+		LangEN: {"greeting": "Hello", "farewell": "Goodbye"},
+		LangRU: {"greeting": "Привет"},
+		LangZH: {},
+	}
+	viaRussian := map[string][]string{LangZH: {LangRU}}
+
+	cases := []struct {
+		name   string
+		chains map[string][]string
+		key    string
+		want   string
+	}{
+		{"default chain goes to English", nil, "greeting", "Hello"},
+		{"custom chain resolves through Russian", viaRussian, "greeting", "Привет"},
+		{"chain ends in English", viaRussian, "farewell", "Goodbye"},
+		{"unknown key returns the key", viaRussian, "missing", "missing"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := translate(catalog, tc.chains, LangZH, tc.key)
+			if got != tc.want {
+				t.Errorf("translate(%q) = %q, want %q", tc.key, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseFallbacks(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseFallbacks("zh=ru:en, ru=en")
+	if err != nil {
+		t.Fatalf("ParseFallbacks: %v", err)
+	}
+
+	want := map[string][]string{LangZH: {LangRU, LangEN}, LangRU: {LangEN}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseFallbacks = %v, want %v", got, want)
+	}
+
+	for _, spec := range []string{"zh", "zh=", "=ru"} {
+		if _, err := ParseFallbacks(spec); err == nil {
+			t.Errorf("ParseFallbacks(%q) succeeded, want an error", spec)
+		}
+	}
+}
+
+func TestSetFallbacks_RejectsUnsupported(t *testing.T) {
+	t.Parallel()
+
+	for _, chains := range []map[string][]string{
+		{"de": {LangEN}},
+		{LangZH: {"de"}},
+	} {
+		if err := SetFallbacks(chains); err == nil {
+			t.Errorf("SetFallbacks(%v) succeeded, want an error", chains)
+		}
+	}
+
+	if fallbacks.Load() != nil {
+		t.Error("rejected chains were applied")
+	}
+}
@@ -4,8 +4,10 @@
 package i18n
 
 import (
+	"fmt"
 	"net/http"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -23,11 +25,16 @@ const (
 var supportedLangs = []string{LangEN, LangRU, LangZH} //nolint:gochecknoglobals // immutable language list
 
 // DetectLanguage determines the language from the request.
-// Priority: 1) ?lang= parameter, 2) Accept-Language header, 3) default (en).
+// Priority: 1) URL path prefix (see WithLanguage), 2) ?lang= parameter,
+// 3) Accept-Language header, 4) default (en).
 func DetectLanguage(r *http.Request) string {
+	if lang, ok := languageFromContext(r.Context()); ok {
+		return lang
+	}
+
 	// Check query parameter first
 	if lang := r.URL.Query().Get("lang"); lang != "" {
-		if isSupported(lang) {
+		if IsSupported(lang) {
 			return lang
 		}
 	}
@@ -55,7 +62,7 @@ func parseAcceptLanguage(header string) string {
 		// Get primary language tag (e.g., "en" from "en-US")
 		primaryLang, _, _ := strings.Cut(lang, "-")
 
-		if isSupported(primaryLang) {
+		if IsSupported(primaryLang) {
 			return primaryLang
 		}
 	}
@@ -63,28 +70,26 @@ func parseAcceptLanguage(header string) string {
 	return ""
 }
 
-// isSupported checks if the language is in the supported list.
-func isSupported(lang string) bool {
+// Languages returns the supported language codes, default first.
+func Languages() []string {
+	return slices.Clone(supportedLangs)
+}
+
+// IsSupported reports whether lang is one of the supported languages.
+func IsSupported(lang string) bool {
 	return slices.Contains(supportedLangs, strings.ToLower(lang))
 }
 
-// T returns the translation for the given key in the specified language.
+// T returns the translation for the given key in the specified language,
+// falling back along the language's configured chain (see SetFallbacks) and
+// then to English.
 func T(lang, key string) string {
-	if translations, ok := messages[lang]; ok {
-		if msg, ok := translations[key]; ok {
-			return msg
-		}
+	var chains map[string][]string
+	if configured := fallbacks.Load(); configured != nil {
+		chains = *configured
 	}
 
-	// Fallback to English
-	if translations, ok := messages[DefaultLang]; ok {
-		if msg, ok := translations[key]; ok {
-			return msg
-		}
-	}
-
-	// Return key if translation not found
-	return key
+	return translate(messages, chains, lang, key)
 }
 
 // Weeks returns the localized string for week duration.
@@ -135,6 +140,94 @@ func weeksChinese(_ int) string {
 	return T(LangZH, keyWeeksFormat)
 }
 
+// Days returns the localized string for day duration.
+func Days(lang string, n int) string {
+	switch lang {
+	case LangRU:
+		return daysRussian(n)
+	case LangZH:
+		return daysChinese(n)
+	default:
+		return daysEnglish(n)
+	}
+}
+
+func daysEnglish(n int) string {
+	if n == 1 {
+		return "1 day"
+	}
+
+	return T(LangEN, keyDaysFormat)
+}
+
+func daysRussian(n int) string {
+	// Russian pluralization rules
+	if n == 1 {
+		return "1 день"
+	}
+
+	lastTwo := n % 100
+	lastOne := n % 10
+
+	if lastTwo >= 11 && lastTwo <= 14 {
+		return T(LangRU, keyDaysMany) // дней
+	}
+
+	switch lastOne {
+	case 1:
+		return T(LangRU, keyDayOne) // день
+	case 2, 3, 4: //nolint:mnd // Russian pluralization rules
+		return T(LangRU, keyDaysFew) // дня
+	default:
+		return T(LangRU, keyDaysMany) // дней
+	}
+}
+
+func daysChinese(_ int) string {
+	// Chinese doesn't have plural forms
+	return T(LangZH, keyDaysFormat)
+}
+
+// ReservedCount returns the localized "n reserved until" phrase, with the
+// count formatted by FormatNumber and the noun agreeing with it.
+func ReservedCount(lang string, n int, until string) string {
+	var key string
+
+	switch lang {
+	case LangRU:
+		key = reservedCountKeyRussian(n)
+	case LangZH:
+		// Chinese doesn't have plural forms
+		key = keyReservedCount
+	default:
+		key = keyReservedCount
+		if n == 1 {
+			key = keyReservedCountOne
+		}
+	}
+
+	return fmt.Sprintf(T(lang, key), FormatNumber(lang, int64(n)), until)
+}
+
+func reservedCountKeyRussian(n int) string {
+	// Russian pluralization rules
+	lastTwo := n % 100
+	lastOne := n % 10
+
+	if lastTwo >= 11 && lastTwo <= 14 {
+		return keyReservedCount // штук
+	}
+
+	switch lastOne {
+	case 1:
+		return keyReservedCountOne // штука
+	case 2, 3, 4: //nolint:mnd // Russian pluralization rules
+		return keyReservedCountFew // штуки
+	default:
+		return keyReservedCount // штук
+	}
+}
+
 // FormatDate formats a date according to the language.
 func FormatDate(lang string, date time.Time) string {
 	switch lang {
@@ -146,3 +239,34 @@ func FormatDate(lang string, date time.Time) string {
 		return date.Format("Jan 2, 2006")
 	}
 }
+
+// FormatNumber formats an integer with the thousands separator of the
+// language: "1,234,567" in English and Chinese, "1 234 567" with no-break
+// spaces in Russian.
+func FormatNumber(lang string, n int64) string {
+	separator := ","
+	if lang == LangRU {
+		separator = "\u00a0"
+	}
+
+	digits := strconv.FormatInt(n, 10)
+
+	sign := ""
+	if n < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	var b strings.Builder
+
+	b.WriteString(sign)
+
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteString(separator)
+		}
+
+		b.WriteRune(digit)
+	}
+
+	return b.String()
+}
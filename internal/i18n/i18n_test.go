@@ -54,3 +54,176 @@ func TestWeeks(t *testing.T) {
 		})
 	}
 }
+
+// TestDays mirrors TestWeeks for the day-duration helper.
+func TestDays(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		lang string
+		n    int
+		want string
+	}{
+		{"en singular", i18n.LangEN, 1, "1 day"},
+		{"en plural", i18n.LangEN, 3, "days"},
+		{"ru singular", i18n.LangRU, 1, "1 день"},
+		{"ru few 3", i18n.LangRU, 3, "дня"},
+		{"ru many 12", i18n.LangRU, 12, "дней"},
+		{"ru one 31", i18n.LangRU, 31, "день"},
+		{"zh", i18n.LangZH, 5, "天"}, //nolint:gosmopolitan // Chinese day label is non-ASCII by design
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := i18n.Days(tc.lang, tc.n)
+			if got != tc.want {
+				t.Errorf("Days(%q, %d) = %q, want %q", tc.lang, tc.n, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestFormatNumber pins thousands grouping per language.
+func TestFormatNumber(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		lang string
+		n    int64
+		want string
+	}{
+		{"zero", i18n.LangEN, 0, "0"},
+		{"en below grouping", i18n.LangEN, 999, "999"},
+		{"en thousand", i18n.LangEN, 1000, "1,000"},
+		{"en million", i18n.LangEN, 1234567, "1,234,567"},
+		{"en negative", i18n.LangEN, -1234567, "-1,234,567"},
+		{"en negative small", i18n.LangEN, -5, "-5"},
+		{"unknown lang falls back to en", "fr", 12345, "12,345"},
+		{"ru below grouping", i18n.LangRU, 999, "999"},
+		{"ru thousand", i18n.LangRU, 1000, "1\u00a0000"},
+		{"ru million", i18n.LangRU, 1234567, "1\u00a0234\u00a0567"},
+		{"ru negative", i18n.LangRU, -100000, "-100\u00a0000"},
+		{"zh thousand", i18n.LangZH, 1000, "1,000"},
+		{"zh million", i18n.LangZH, 10000000, "10,000,000"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := i18n.FormatNumber(tc.lang, tc.n)
+			if got != tc.want {
+				t.Errorf("FormatNumber(%q, %d) = %q, want %q", tc.lang, tc.n, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestReservedCount pins the pluralized reservation phrase per language.
+func TestReservedCount(t *testing.T) {
+	t.Parallel()
+
+	const until = "Jan 2, 2026"
+
+	cases := []struct {
+		name string
+		lang string
+		n    int
+		want string
+	}{
+		{"en 1", i18n.LangEN, 1, "1 item reserved until " + until},
+		{"en 2", i18n.LangEN, 2, "2 items reserved until " + until},
+		{"en 5", i18n.LangEN, 5, "5 items reserved until " + until},
+		{"en 11", i18n.LangEN, 11, "11 items reserved until " + until},
+		{"en 21", i18n.LangEN, 21, "21 items reserved until " + until},
+		{"ru 1", i18n.LangRU, 1, "1 штука зарезервирована до " + until},
+		{"ru 2", i18n.LangRU, 2, "2 штуки зарезервированы до " + until},
+		{"ru 5", i18n.LangRU, 5, "5 штук зарезервировано до " + until},
+		{"ru 11", i18n.LangRU, 11, "11 штук зарезервировано до " + until},
+		{"ru 21", i18n.LangRU, 21, "21 штука зарезервирована до " + until},
+		{"zh 1", i18n.LangZH, 1, "1 件已预订至 " + until},    //nolint:gosmopolitan // Chinese phrase is non-ASCII by design
+		{"zh 2", i18n.LangZH, 2, "2 件已预订至 " + until},    //nolint:gosmopolitan // Chinese phrase is non-ASCII by design
+		{"zh 5", i18n.LangZH, 5, "5 件已预订至 " + until},    //nolint:gosmopolitan // Chinese phrase is non-ASCII by design
+		{"zh 11", i18n.LangZH, 11, "11 件已预订至 " + until}, //nolint:gosmopolitan // Chinese phrase is non-ASCII by design
+		{"zh 21", i18n.LangZH, 21, "21 件已预订至 " + until}, //nolint:gosmopolitan // Chinese phrase is non-ASCII by design
+		{"unknown lang falls back to en", "fr", 1, "1 item reserved until " + until},
+		{"ru thousands grouping", i18n.LangRU, 1001, "1\u00a0001 штука зарезервирована до " + until},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := i18n.ReservedCount(tc.lang, tc.n, until)
+			if got != tc.want {
+				t.Errorf("ReservedCount(%q, %d) = %q, want %q", tc.lang, tc.n, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestOccasion pins the localized labels of known occasions and the verbatim
+// rendering of unknown ones.
+func TestOccasion(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		lang string
+		tag  string
+		want string
+	}{
+		{"en", i18n.LangEN, "birthday", "Birthday"},
+		{"ru", i18n.LangRU, "birthday", "День рождения"},
+		{"zh", i18n.LangZH, "birthday", "生日"}, //nolint:gosmopolitan // Chinese label is non-ASCII by design
+		{"case-insensitive", i18n.LangRU, "Christmas", "Рождество"},
+		{"unknown lang falls back to en", "fr", "new-year", "New Year"},
+		{"unknown occasion is verbatim", i18n.LangRU, "hanami", "hanami"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := i18n.Occasion(tc.lang, tc.tag)
+			if got != tc.want {
+				t.Errorf("Occasion(%q, %q) = %q, want %q", tc.lang, tc.tag, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSplitPathPrefix(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		path     string
+		wantLang string
+		wantRest string
+		wantOK   bool
+	}{
+		{"/ru/", i18n.LangRU, "/", true},
+		{"/ru", i18n.LangRU, "/", true},
+		{"/zh/wishes/lamp", i18n.LangZH, "/wishes/lamp", true},
+		{"/xx/", "", "/xx/", false},
+		{"/RU/", "", "/RU/", false},
+		{"/wishes", "", "/wishes", false},
+		{"/", "", "/", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.path, func(t *testing.T) {
+			t.Parallel()
+
+			lang, rest, ok := i18n.SplitPathPrefix(tc.path)
+			if lang != tc.wantLang || rest != tc.wantRest || ok != tc.wantOK {
+				t.Errorf("SplitPathPrefix(%q) = %q, %q, %v, want %q, %q, %v",
+					tc.path, lang, rest, ok, tc.wantLang, tc.wantRest, tc.wantOK)
+			}
+		})
+	}
+}
@@ -19,17 +19,30 @@ const (
 	keyWeekOne            = "week_one"
 	keyWeeksFew           = "weeks_few"
 	keyWeeksMany          = "weeks_many"
+	keyDaysFormat         = "days_format"
+	keyDayOne             = "day_one"
+	keyDaysFew            = "days_few"
+	keyDaysMany           = "days_many"
 	keyQuantityLabel      = "quantity_label"
 	keyAvailableLabel     = "available_label"
 	keyUnlimitedLabel     = "unlimited_label"
 	keyUnlimitedAvailable = "unlimited_available"
 	keyReservedCount      = "reserved_count"
+	keyReservedCountOne   = "reserved_count_one"
+	keyReservedCountFew   = "reserved_count_few"
+	keyAskOwner           = "ask_owner"
+	keyMessageSent        = "message_sent"
+	keyArchiveTitle       = "archive_title"
+	keyArchiveEmpty       = "archive_empty"
+	keyExpiredOn          = "expired_on"
 
 	keyErrListWishes      = "err_list_wishes"
 	keyErrRender          = "err_render"
 	keyErrMissingName     = "err_missing_name"
 	keyErrInvalidForm     = "err_invalid_form"
 	keyErrWeeksRange      = "err_weeks_range"
+	keyErrDaysRange       = "err_days_range"
+	keyErrInvalidUnit     = "err_invalid_unit"
 	keyErrNotFound        = "err_not_found"
 	keyErrGetWish         = "err_get_wish"
 	keyErrAlreadyReserved = "err_already_reserved"
@@ -38,12 +51,116 @@ const (
 	keyErrInvalidQuantity = "err_invalid_quantity"
 	keyErrFullyReserved   = "err_fully_reserved"
 	keyErrQuantityExceeds = "err_quantity_exceeds"
+	keyErrIdempotencyKey  = "err_invalid_idempotency_key"
+	keyErrNoReservation   = "err_no_reservation"
+	keyErrReleaseExceeds  = "err_release_exceeds"
+	keyErrUnreserveFailed = "err_unreserve_failed"
+	keyErrMessageLength   = "err_message_length"
+	keyErrMessageFailed   = "err_message_failed"
+	keyErrImageForbidden  = "err_image_forbidden"
+	keyErrImageFetch      = "err_image_fetch"
+	keyErrUnauthorized    = "err_unauthorized"
+	keyErrNoteLength      = "err_note_length"
+	keyNotePlaceholder    = "note_placeholder"
+	keyStaleData          = "stale_data"
+	keyErrPagination      = "err_invalid_pagination"
+	keyActivityEmpty      = "activity_empty"
+	keyActivityReserve    = "activity_reserve"
+	keyActivityExpire     = "activity_expire"
+	keyActivityMore       = "activity_more"
+	keyFundProgress       = "fund_progress"
+	keyFundComplete       = "fund_complete"
+	keyContributeBtn      = "contribute_btn"
+	keyErrInvalidAmount   = "err_invalid_amount"
+	keyErrNotFund         = "err_not_fund"
+	keyErrFullyFunded     = "err_fully_funded"
+	keyErrContribute      = "err_contribute_failed"
+	keyErrBodyTooLarge    = "err_body_too_large"
+	keyErrTimeout         = "err_timeout"
+	keyGroupGift          = "group_gift"
+	keyGroupPledgers      = "group_pledgers"
+	keyGroupClosed        = "group_closed"
+	keyJoinGroupBtn       = "join_group_btn"
+	keyCloseGroupBtn      = "close_group_btn"
+	keyErrGroupClosed     = "err_group_closed"
+	keyErrNotGroupGift    = "err_not_group_gift"
+	keyErrNotCoordinator  = "err_not_coordinator"
+	keyErrJoinFailed      = "err_join_failed"
+	keyConfirmReserve     = "confirm_reserve"
+	keyConfirmBtn         = "confirm_btn"
+	keyErrPendingExpired  = "err_pending_expired"
+	keyErrReceived        = "err_received"
+	keyErrReceivedFailed  = "err_received_failed"
+	keyErrMinPriority     = "err_min_priority"
+	keyWishSet            = "wish_set"
+	keyErrSetUnavailable  = "err_set_unavailable"
+	keyReservationGrace   = "reservation_grace"
+	keyPermalink          = "permalink"
+	keyPriceAsOf          = "price_as_of"
+	keyErrPriceCheck      = "err_price_check"
+	keyReserveSuccess     = "reserve_success"
+	keyNeededBy           = "needed_by"
+	keyNeededByOverdue    = "needed_by_overdue"
+	keyErrReserverLimit   = "err_reserver_limit"
+	keySummaryActive      = "summary_active"
+	keySummaryUnlimited   = "summary_unlimited"
+	keySummaryReserved    = "summary_reserved"
+	keySummaryExpired     = "summary_expired"
+	keySummaryReceived    = "summary_received"
+	keyErrExtendDuration  = "err_extend_duration"
+	keyErrExtendNoTTL     = "err_extend_no_ttl"
+	keyErrExtendFailed    = "err_extend_failed"
+	keyContactPlaceholder = "contact_placeholder"
+	keyErrContactLength   = "err_contact_length"
+	keyReserveConfirmMsg  = "reserve_confirm_msg"
+	keyUnreservePrompt    = "unreserve_prompt"
+	keyUnreserveBtn       = "unreserve_btn"
+	keySantaTitle         = "santa_title"
+	keySantaEmpty         = "santa_empty"
+	keyErrSantaOwners     = "err_santa_owners"
+	keyErrSantaFailed     = "err_santa_failed"
+	keyErrSantaNotFound   = "err_santa_not_found"
+	keyErrBatchTTLParams  = "err_batch_ttl_params"
+	keyPopular            = "popular"
+	keyErrReadOnly        = "err_read_only"
+	keyErrTitleRequired   = "err_title_required"
+	keyErrTitleLength     = "err_title_length"
+	keyErrDescLength      = "err_description_length"
+	keyErrInvalidURL      = "err_invalid_url"
+	keyErrPriorityRange   = "err_priority_range"
+	keyErrTTLInvalid      = "err_ttl_invalid"
+	keyErrCreateFailed    = "err_create_failed"
+	keyCompareTitle       = "compare_title"
+	keyComparePrice       = "compare_price"
+	keyComparePriority    = "compare_priority"
+	keyCompareTags        = "compare_tags"
+	keyCompareDesc        = "compare_description"
+	keyErrCompareCount    = "err_compare_count"
+	keyErrCompareMissing  = "err_compare_missing"
+	keyErrOrderNames      = "err_order_names"
+	keyLinksLabel         = "links_label"
+	keyErrReservedFor     = "err_reserved_for"
+	keyErrPurchased       = "err_purchased"
+	keyErrPurchasedUnlim  = "err_purchased_unlimited"
+	keyErrPurchasedFailed = "err_purchased_failed"
+	keyErrWishExpired     = "err_wish_expired"
+	keyErrPreviewLang     = "err_preview_lang"
+	keyUnitWeeks          = "unit_weeks"
+	keyUnitDays           = "unit_days"
+	keyReserveShortened   = "reserve_success_shortened"
+	keyErrInvalidField    = "err_invalid_field"
+	keyErrURLDomain       = "err_url_domain"
+	keyErrLinkLabelLength = "err_link_label_length"
 )
 
 // ruWeeksMany is the Russian plural form for "weeks", shared by the
 // weeks_many and weeks_format keys.
 const ruWeeksMany = "недель"
 
+// ruDaysMany is the Russian plural form for "days", shared by the
+// days_many and days_format keys.
+const ruDaysMany = "дней"
+
 // messages contains all translations keyed by language code.
 //
 //nolint:gochecknoglobals,gosmopolitan // immutable translation map with CJK characters
@@ -53,7 +170,7 @@ var messages = map[string]map[string]string{
 		keyPageTitle:          "Wishlist",
 		keyFilterLabel:        "Filter:",
 		keyFilterAll:          "All",
-		keyEmptyFiltered:      "No wishes with tag",
+		keyEmptyFiltered:      "No wishes with tag %s.",
 		keyEmptyDefault:       "No wishes yet.",
 		keyBuyLabel:           "Buy:",
 		keyReservedBadge:      "Reserved",
@@ -61,11 +178,19 @@ var messages = map[string]map[string]string{
 		keyReserveBtn:         "Reserve",
 		keyWeeksFormat:        "weeks",
 		keyWeekOne:            "week",
+		keyDaysFormat:         "days",
+		keyDayOne:             "day",
 		keyQuantityLabel:      "Qty:",
 		keyAvailableLabel:     "Available:",
 		keyUnlimitedLabel:     "Unlimited",
 		keyUnlimitedAvailable: "Available: ∞",
-		keyReservedCount:      "%d reserved until %s",
+		keyReservedCount:      "%s items reserved until %s",
+		keyReservedCountOne:   "%s item reserved until %s",
+		keyAskOwner:           "Ask owner",
+		keyMessageSent:        "Message sent",
+		keyArchiveTitle:       "Archive",
+		keyArchiveEmpty:       "No expired wishes.",
+		keyExpiredOn:          "Expired %s",
 
 		// Error messages
 		keyErrListWishes:      "Failed to list wishes",
@@ -73,6 +198,8 @@ var messages = map[string]map[string]string{
 		keyErrMissingName:     "Missing wish name",
 		keyErrInvalidForm:     "Invalid form data",
 		keyErrWeeksRange:      "Weeks must be between %d and %d",
+		keyErrDaysRange:       "Days must be between %d and %d",
+		keyErrInvalidUnit:     "Invalid duration unit",
 		keyErrNotFound:        "Wish not found",
 		keyErrGetWish:         "Failed to get wish",
 		keyErrAlreadyReserved: "Wish is already reserved",
@@ -81,13 +208,113 @@ var messages = map[string]map[string]string{
 		keyErrInvalidQuantity: "Invalid quantity",
 		keyErrFullyReserved:   "All items are reserved",
 		keyErrQuantityExceeds: "Only %d available",
+		keyErrIdempotencyKey:  "Invalid idempotency key",
+		keyErrNoReservation:   "You have no reservation for this wish",
+		keyErrReleaseExceeds:  "You only hold %d",
+		keyErrUnreserveFailed: "Failed to release reservation",
+		keyErrMessageLength:   "Message must be 1 to %d characters",
+		keyErrMessageFailed:   "Failed to send message",
+		keyErrImageForbidden:  "Image is not on the wishlist",
+		keyErrImageFetch:      "Failed to load image",
+		keyErrUnauthorized:    "Authentication required",
+		keyErrNoteLength:      "Note must be at most %d characters",
+		keyNotePlaceholder:    "Note for the owner (optional)",
+		keyStaleData:          "The wishlist could not be refreshed; showing recently cached data",
+		keyErrPagination:      "Invalid limit or offset",
+		keyActivityEmpty:      "No recent activity",
+		keyActivityReserve:    "%s × %s reserved",
+		keyActivityExpire:     "Reservation of %s × %s expired",
+		keyActivityMore:       "Older activity",
+		keyFundProgress:       "%s of %s raised",
+		keyFundComplete:       "Fully funded",
+		keyContributeBtn:      "Contribute",
+		keyErrInvalidAmount:   "Amount must be a positive whole number",
+		keyErrNotFund:         "This wish does not accept contributions",
+		keyErrFullyFunded:     "This wish is already fully funded",
+		keyErrContribute:      "Failed to record contribution",
+		keyErrBodyTooLarge:    "Request body is too large",
+		keyErrTimeout:         "The request took too long, please try again",
+		keyGroupGift:          "Group gift",
+		keyGroupPledgers:      "%s joined",
+		keyGroupClosed:        "Group closed",
+		keyJoinGroupBtn:       "Join group gift",
+		keyCloseGroupBtn:      "Close group",
+		keyErrGroupClosed:     "This group gift is closed",
+		keyErrNotGroupGift:    "This wish is not a group gift",
+		keyErrNotCoordinator:  "Only the coordinator can close the group",
+		keyErrJoinFailed:      "Failed to update group gift",
+		keyConfirmReserve:     "Reserve %s? It is held for %s minutes until you confirm.",
+		keyConfirmBtn:         "Confirm",
+		keyErrPendingExpired:  "This reservation has lapsed, please reserve again",
+		keyErrReceived:        "This wish has already been received",
+		keyErrReceivedFailed:  "Failed to mark the wish as received",
+		keyErrMinPriority:     "Minimum priority must be between 0 and %d",
+		keyWishSet:            "Set “%s”: these wishes go together",
+		keyErrSetUnavailable:  "Another wish of this set is no longer available",
+		keyReservationGrace:   "Reservation of %s expired %s, still held briefly",
+		keyPermalink:          "Link to this wish",
+		keyPriceAsOf:          "price as of %s",
+		keyErrPriceCheck:      "Failed to record the price check",
+		keyReserveSuccess:     "Reserved until %s, thank you!",
+		keyNeededBy:           "Needed by %s",
+		keyNeededByOverdue:    "Was needed by %s",
+		keyErrReserverLimit:   "Reservation limit per giver reached (%d)",
+		keySummaryActive:      "Active, %s of %s available",
+		keySummaryUnlimited:   "Active, unlimited",
+		keySummaryReserved:    "Reserved until %s",
+		keySummaryExpired:     "Expired",
+		keySummaryReceived:    "Received",
+		keyErrExtendDuration:  "Invalid extension: use a positive duration of up to 8760h, such as 168h",
+		keyErrExtendNoTTL:     "This wish has no TTL to extend",
+		keyErrExtendFailed:    "Failed to extend wish",
+		keyContactPlaceholder: "Email for a confirmation (optional)",
+		keyErrContactLength:   "Contact must be at most %d characters",
+		keyReserveConfirmMsg:  "You reserved \"%s\" until %s. To release it, open %s",
+		keyUnreservePrompt:    "Release your reservation of this wish?",
+		keyUnreserveBtn:       "Release reservation",
+		keySantaTitle:         "You are the secret santa for %s",
+		keySantaEmpty:         "%s has no wishes listed yet",
+		keyErrSantaOwners:     "Give at least two distinct owners, each a valid label value",
+		keyErrSantaFailed:     "Failed to save secret santa assignments",
+		keyErrSantaNotFound:   "No secret santa assignment for this link",
+		keyErrBatchTTLParams:  "Give exactly one of expiresAt (RFC 3339, in the future), expiresIn or adjust (Go durations, up to 8760h)",
+		keyPopular:            "Popular",
+		keyErrReadOnly:        "This list is read-only",
+		keyErrTitleRequired:   "Title is required",
+		keyErrTitleLength:     "Title is too long (max %d characters)",
+		keyErrDescLength:      "Description is too long (max %d characters)",
+		keyErrInvalidURL:      "Links must be full addresses starting with http:// or https://",
+		keyErrPriorityRange:   "Priority must be between 0 and %d",
+		keyErrTTLInvalid:      "Invalid TTL: use a positive duration of up to 8760h, such as 720h",
+		keyErrCreateFailed:    "Failed to create wish",
+		keyCompareTitle:       "Comparison",
+		keyComparePrice:       "Price",
+		keyComparePriority:    "Priority",
+		keyCompareTags:        "Tags",
+		keyCompareDesc:        "Description",
+		keyErrCompareCount:    "Choose between %d and %d different wishes to compare",
+		keyErrCompareMissing:  "Wish %q not found",
+		keyErrOrderNames:      "List each wish name once, in the name field",
+		keyLinksLabel:         "See also",
+		keyErrReservedFor:     "Name who the reservation is for, in at most %d characters",
+		keyErrPurchased:       "Purchased quantity must be a whole number from 0 to %d",
+		keyErrPurchasedUnlim:  "Unlimited wishes don't track purchases",
+		keyErrPurchasedFailed: "Failed to record the purchase",
+		keyErrWishExpired:     "This wish has expired and can no longer be reserved",
+		keyErrPreviewLang:     "Choose the preview language with lang, one of: %s",
+		keyUnitWeeks:          "in weeks",
+		keyUnitDays:           "in days",
+		keyReserveShortened:   "Reserved until %s, when this wish ends — thank you!",
+		keyErrInvalidField:    "The value of %s is not valid",
+		keyErrURLDomain:       "%s is not an allowed shop domain",
+		keyErrLinkLabelLength: "Link label is too long (max %d characters)",
 	},
 	LangRU: {
 		// UI strings
 		keyPageTitle:          "Список желаний",
 		keyFilterLabel:        "Фильтр:",
 		keyFilterAll:          "Все",
-		keyEmptyFiltered:      "Нет желаний с тегом",
+		keyEmptyFiltered:      "Нет желаний с тегом %s.",
 		keyEmptyDefault:       "Пока нет желаний.",
 		keyBuyLabel:           "Купить:",
 		keyReservedBadge:      "Зарезервировано",
@@ -97,11 +324,22 @@ var messages = map[string]map[string]string{
 		keyWeeksFew:           "недели",
 		keyWeeksMany:          ruWeeksMany,
 		keyWeeksFormat:        ruWeeksMany,
+		keyDayOne:             "день",
+		keyDaysFew:            "дня",
+		keyDaysMany:           ruDaysMany,
+		keyDaysFormat:         ruDaysMany,
 		keyQuantityLabel:      "Кол-во:",
 		keyAvailableLabel:     "Доступно:",
 		keyUnlimitedLabel:     "Неограничено",
 		keyUnlimitedAvailable: "Доступно: ∞",
-		keyReservedCount:      "%d зарезервировано до %s",
+		keyReservedCount:      "%s штук зарезервировано до %s",
+		keyReservedCountOne:   "%s штука зарезервирована до %s",
+		keyReservedCountFew:   "%s штуки зарезервированы до %s",
+		keyAskOwner:           "Спросить владельца",
+		keyMessageSent:        "Сообщение отправлено",
+		keyArchiveTitle:       "Архив",
+		keyArchiveEmpty:       "Нет истёкших желаний.",
+		keyExpiredOn:          "Истекло %s",
 
 		// Error messages
 		keyErrListWishes:      "Не удалось загрузить список желаний",
@@ -109,6 +347,8 @@ var messages = map[string]map[string]string{
 		keyErrMissingName:     "Не указано название",
 		keyErrInvalidForm:     "Неверные данные формы",
 		keyErrWeeksRange:      "Срок должен быть от %d до %d недель",
+		keyErrDaysRange:       "Срок должен быть от %d до %d дней",
+		keyErrInvalidUnit:     "Неверная единица срока",
 		keyErrNotFound:        "Желание не найдено",
 		keyErrGetWish:         "Не удалось получить желание",
 		keyErrAlreadyReserved: "Уже зарезервировано",
@@ -117,13 +357,113 @@ var messages = map[string]map[string]string{
 		keyErrInvalidQuantity: "Неверное количество",
 		keyErrFullyReserved:   "Всё зарезервировано",
 		keyErrQuantityExceeds: "Доступно только %d",
+		keyErrIdempotencyKey:  "Неверный ключ идемпотентности",
+		keyErrNoReservation:   "У вас нет резерва на это желание",
+		keyErrReleaseExceeds:  "У вас зарезервировано только %d",
+		keyErrUnreserveFailed: "Не удалось снять резерв",
+		keyErrMessageLength:   "Сообщение должно быть от 1 до %d символов",
+		keyErrMessageFailed:   "Не удалось отправить сообщение",
+		keyErrImageForbidden:  "Изображения нет в списке желаний",
+		keyErrImageFetch:      "Не удалось загрузить изображение",
+		keyErrUnauthorized:    "Требуется аутентификация",
+		keyErrNoteLength:      "Заметка должна быть не длиннее %d символов",
+		keyNotePlaceholder:    "Заметка для владельца (необязательно)",
+		keyStaleData:          "Не удалось обновить список желаний; показаны недавно сохранённые данные",
+		keyErrPagination:      "Неверный limit или offset",
+		keyActivityEmpty:      "Пока никакой активности",
+		keyActivityReserve:    "Забронировано: %s × %s",
+		keyActivityExpire:     "Бронь истекла: %s × %s",
+		keyActivityMore:       "Более ранние события",
+		keyFundProgress:       "Собрано %s из %s",
+		keyFundComplete:       "Сбор завершён",
+		keyContributeBtn:      "Внести",
+		keyErrInvalidAmount:   "Сумма должна быть положительным целым числом",
+		keyErrNotFund:         "Это желание не принимает взносы",
+		keyErrFullyFunded:     "Сбор на это желание уже завершён",
+		keyErrContribute:      "Не удалось записать взнос",
+		keyErrBodyTooLarge:    "Слишком большое тело запроса",
+		keyErrTimeout:         "Запрос выполнялся слишком долго, попробуйте ещё раз",
+		keyGroupGift:          "Совместный подарок",
+		keyGroupPledgers:      "Присоединились: %s",
+		keyGroupClosed:        "Сбор участников закрыт",
+		keyJoinGroupBtn:       "Присоединиться",
+		keyCloseGroupBtn:      "Закрыть группу",
+		keyErrGroupClosed:     "Этот совместный подарок закрыт",
+		keyErrNotGroupGift:    "Это желание не совместный подарок",
+		keyErrNotCoordinator:  "Закрыть группу может только координатор",
+		keyErrJoinFailed:      "Не удалось обновить совместный подарок",
+		keyConfirmReserve:     "Зарезервировать %s? Бронь удерживается %s мин. до подтверждения.",
+		keyConfirmBtn:         "Подтвердить",
+		keyErrPendingExpired:  "Бронь истекла, зарезервируйте снова",
+		keyErrReceived:        "Этот подарок уже получен",
+		keyErrReceivedFailed:  "Не удалось отметить подарок как полученный",
+		keyErrMinPriority:     "Минимальный приоритет должен быть от 0 до %d",
+		keyWishSet:            "Набор «%s»: эти желания дарятся вместе",
+		keyErrSetUnavailable:  "Другое желание из этого набора уже недоступно",
+		keyReservationGrace:   "Бронь на %s истекла %s, ещё ненадолго удерживается",
+		keyPermalink:          "Ссылка на это желание",
+		keyPriceAsOf:          "цена на %s",
+		keyErrPriceCheck:      "Не удалось отметить проверку цены",
+		keyReserveSuccess:     "Забронировано до %s, спасибо!",
+		keyNeededBy:           "Нужно к %s",
+		keyNeededByOverdue:    "Было нужно к %s",
+		keyErrReserverLimit:   "Достигнут лимит броней на одного дарителя (%d)",
+		keySummaryActive:      "Активно, доступно %s из %s",
+		keySummaryUnlimited:   "Активно, без ограничений",
+		keySummaryReserved:    "Забронировано до %s",
+		keySummaryExpired:     "Истекло",
+		keySummaryReceived:    "Получено",
+		keyErrExtendDuration:  "Неверное продление: укажите положительную длительность до 8760h, например 168h",
+		keyErrExtendNoTTL:     "У этого желания нет срока действия для продления",
+		keyErrExtendFailed:    "Не удалось продлить желание",
+		keyContactPlaceholder: "Email для подтверждения (необязательно)",
+		keyErrContactLength:   "Контакт должен быть не длиннее %d символов",
+		keyReserveConfirmMsg:  "Вы забронировали «%s» до %s. Чтобы снять бронь, откройте %s",
+		keyUnreservePrompt:    "Снять вашу бронь с этого желания?",
+		keyUnreserveBtn:       "Снять бронь",
+		keySantaTitle:         "Вы тайный Санта для %s",
+		keySantaEmpty:         "У %s пока нет желаний в списке",
+		keyErrSantaOwners:     "Укажите хотя бы двух разных владельцев, каждый — допустимое значение метки",
+		keyErrSantaFailed:     "Не удалось сохранить распределение тайного Санты",
+		keyErrSantaNotFound:   "По этой ссылке нет назначения тайного Санты",
+		keyErrBatchTTLParams:  "Укажите ровно одно из expiresAt (RFC 3339, в будущем), expiresIn или adjust (длительности Go, до 8760h)",
+		keyPopular:            "Популярное",
+		keyErrReadOnly:        "Этот список доступен только для просмотра",
+		keyErrTitleRequired:   "Укажите название",
+		keyErrTitleLength:     "Название слишком длинное (максимум %d символов)",
+		keyErrDescLength:      "Описание слишком длинное (максимум %d символов)",
+		keyErrInvalidURL:      "Ссылки должны быть полными адресами, начинающимися с http:// или https://",
+		keyErrPriorityRange:   "Приоритет должен быть от 0 до %d",
+		keyErrTTLInvalid:      "Неверный срок жизни: укажите положительную длительность до 8760h, например 720h",
+		keyErrCreateFailed:    "Не удалось создать желание",
+		keyCompareTitle:       "Сравнение",
+		keyComparePrice:       "Цена",
+		keyComparePriority:    "Приоритет",
+		keyCompareTags:        "Теги",
+		keyCompareDesc:        "Описание",
+		keyErrCompareCount:    "Выберите от %d до %d разных желаний для сравнения",
+		keyErrCompareMissing:  "Желание %q не найдено",
+		keyErrOrderNames:      "Укажите каждое имя желания один раз в поле name",
+		keyLinksLabel:         "См. также",
+		keyErrReservedFor:     "Укажите, для кого бронь, не длиннее %d символов",
+		keyErrPurchased:       "Количество купленного должно быть целым числом от 0 до %d",
+		keyErrPurchasedUnlim:  "Для безлимитных желаний покупки не учитываются",
+		keyErrPurchasedFailed: "Не удалось отметить покупку",
+		keyErrWishExpired:     "Срок этого желания истёк, забронировать его нельзя",
+		keyErrPreviewLang:     "Укажите язык предпросмотра в lang, один из: %s",
+		keyUnitWeeks:          "в неделях",
+		keyUnitDays:           "в днях",
+		keyReserveShortened:   "Забронировано до %s, когда истекает срок желания, спасибо!",
+		keyErrInvalidField:    "Недопустимое значение поля %s",
+		keyErrURLDomain:       "Домена %s нет в списке разрешённых магазинов",
+		keyErrLinkLabelLength: "Подпись ссылки слишком длинная (максимум %d символов)",
 	},
 	LangZH: {
 		// UI strings
 		keyPageTitle:          "愿望清单",
 		keyFilterLabel:        "筛选：",
 		keyFilterAll:          "全部",
-		keyEmptyFiltered:      "没有带有此标签的愿望",
+		keyEmptyFiltered:      "没有带有标签 %s 的愿望。",
 		keyEmptyDefault:       "暂无愿望",
 		keyBuyLabel:           "购买：",
 		keyReservedBadge:      "已预订",
@@ -131,11 +471,18 @@ var messages = map[string]map[string]string{
 		keyReserveBtn:         "预订",
 		keyWeeksFormat:        "周",
 		keyWeekOne:            "周",
+		keyDaysFormat:         "天",
+		keyDayOne:             "天",
 		keyQuantityLabel:      "数量：",
 		keyAvailableLabel:     "可用：",
 		keyUnlimitedLabel:     "无限",
 		keyUnlimitedAvailable: "可用：∞",
-		keyReservedCount:      "%d 已预订至 %s",
+		keyReservedCount:      "%s 件已预订至 %s",
+		keyAskOwner:           "询问主人",
+		keyMessageSent:        "消息已发送",
+		keyArchiveTitle:       "归档",
+		keyArchiveEmpty:       "没有已过期的愿望",
+		keyExpiredOn:          "已于 %s 过期",
 
 		// Error messages
 		keyErrListWishes:      "无法加载愿望列表",
@@ -143,6 +490,8 @@ var messages = map[string]map[string]string{
 		keyErrMissingName:     "缺少名称",
 		keyErrInvalidForm:     "表单数据无效",
 		keyErrWeeksRange:      "周数必须在%d到%d之间",
+		keyErrDaysRange:       "天数必须在%d到%d之间",
+		keyErrInvalidUnit:     "时长单位无效",
 		keyErrNotFound:        "未找到愿望",
 		keyErrGetWish:         "获取愿望失败",
 		keyErrAlreadyReserved: "已被预订",
@@ -151,5 +500,105 @@ var messages = map[string]map[string]string{
 		keyErrInvalidQuantity: "数量无效",
 		keyErrFullyReserved:   "全部已预订",
 		keyErrQuantityExceeds: "仅有 %d 件可用",
+		keyErrIdempotencyKey:  "幂等键无效",
+		keyErrNoReservation:   "您没有预订此愿望",
+		keyErrReleaseExceeds:  "您仅预订了 %d 件",
+		keyErrUnreserveFailed: "取消预订失败",
+		keyErrMessageLength:   "消息长度必须为 1 到 %d 个字符",
+		keyErrMessageFailed:   "消息发送失败",
+		keyErrImageForbidden:  "该图片不在愿望清单中",
+		keyErrImageFetch:      "图片加载失败",
+		keyErrUnauthorized:    "需要身份验证",
+		keyErrNoteLength:      "备注最多 %d 个字符",
+		keyNotePlaceholder:    "给主人的备注（可选）",
+		keyStaleData:          "无法刷新愿望清单；正在显示最近缓存的数据",
+		keyErrPagination:      "limit 或 offset 无效",
+		keyActivityEmpty:      "暂无动态",
+		keyActivityReserve:    "已预订 %s × %s",
+		keyActivityExpire:     "%s × %s 的预订已过期",
+		keyActivityMore:       "更早的动态",
+		keyFundProgress:       "已筹集 %s / %s",
+		keyFundComplete:       "已筹满",
+		keyContributeBtn:      "捐款",
+		keyErrInvalidAmount:   "金额必须是正整数",
+		keyErrNotFund:         "此愿望不接受捐款",
+		keyErrFullyFunded:     "此愿望已筹满",
+		keyErrContribute:      "记录捐款失败",
+		keyErrBodyTooLarge:    "请求体过大",
+		keyErrTimeout:         "请求超时，请重试",
+		keyGroupGift:          "合送礼物",
+		keyGroupPledgers:      "已有 %s 人加入",
+		keyGroupClosed:        "已停止加入",
+		keyJoinGroupBtn:       "加入合送",
+		keyCloseGroupBtn:      "停止加入",
+		keyErrGroupClosed:     "此合送礼物已停止加入",
+		keyErrNotGroupGift:    "此愿望不是合送礼物",
+		keyErrNotCoordinator:  "只有发起人可以停止加入",
+		keyErrJoinFailed:      "更新合送礼物失败",
+		keyConfirmReserve:     "预订 %s 件？确认前将保留 %s 分钟。",
+		keyConfirmBtn:         "确认",
+		keyErrPendingExpired:  "该预订已失效，请重新预订",
+		keyErrReceived:        "此心愿已收到",
+		keyErrReceivedFailed:  "无法标记为已收到",
+		keyErrMinPriority:     "最低优先级必须在0到%d之间",
+		keyWishSet:            "套装“%s”：这些心愿需要一起送",
+		keyErrSetUnavailable:  "此套装中的另一个心愿已不可用",
+		keyReservationGrace:   "%s 件的预订已于 %s 过期，仍短暂保留",
+		keyPermalink:          "此心愿的链接",
+		keyPriceAsOf:          "价格截至 %s",
+		keyErrPriceCheck:      "无法记录价格核对",
+		keyReserveSuccess:     "已预订至 %s，谢谢！",
+		keyNeededBy:           "需要于 %s 前",
+		keyNeededByOverdue:    "原定 %s 前需要",
+		keyErrReserverLimit:   "已达到每位送礼人的预订上限（%d）",
+		keySummaryActive:      "有效，可用 %s / 共 %s",
+		keySummaryUnlimited:   "有效，不限数量",
+		keySummaryReserved:    "已预订至 %s",
+		keySummaryExpired:     "已过期",
+		keySummaryReceived:    "已收到",
+		keyErrExtendDuration:  "延期无效：请使用不超过 8760h 的正时长，例如 168h",
+		keyErrExtendNoTTL:     "此愿望没有可延长的有效期",
+		keyErrExtendFailed:    "延长愿望失败",
+		keyContactPlaceholder: "用于接收确认的邮箱（可选）",
+		keyErrContactLength:   "联系方式最多 %d 个字符",
+		keyReserveConfirmMsg:  "您已预订“%s”，有效期至 %s。如需取消，请打开 %s",
+		keyUnreservePrompt:    "要取消您对此愿望的预订吗？",
+		keyUnreserveBtn:       "取消预订",
+		keySantaTitle:         "你是 %s 的神秘圣诞老人",
+		keySantaEmpty:         "%s 还没有列出任何愿望",
+		keyErrSantaOwners:     "请至少提供两个不同的所有者，且每个都是有效的标签值",
+		keyErrSantaFailed:     "保存神秘圣诞老人分配失败",
+		keyErrSantaNotFound:   "此链接没有神秘圣诞老人分配",
+		keyErrBatchTTLParams:  "请只提供 expiresAt（RFC 3339，未来时间）、expiresIn 或 adjust（Go 时长，最多 8760h）中的一个",
+		keyPopular:            "热门",
+		keyErrReadOnly:        "此清单为只读",
+		keyErrTitleRequired:   "请填写名称",
+		keyErrTitleLength:     "名称过长（最多 %d 个字符）",
+		keyErrDescLength:      "描述过长（最多 %d 个字符）",
+		keyErrInvalidURL:      "链接必须是以 http:// 或 https:// 开头的完整地址",
+		keyErrPriorityRange:   "优先级必须在0到%d之间",
+		keyErrTTLInvalid:      "有效期无效：请使用不超过 8760h 的正时长，例如 720h",
+		keyErrCreateFailed:    "创建愿望失败",
+		keyCompareTitle:       "对比",
+		keyComparePrice:       "价格",
+		keyComparePriority:    "优先级",
+		keyCompareTags:        "标签",
+		keyCompareDesc:        "描述",
+		keyErrCompareCount:    "请选择 %d 到 %d 个不同的愿望进行对比",
+		keyErrCompareMissing:  "未找到愿望 %q",
+		keyErrOrderNames:      "请在 name 字段中列出每个愿望名称，且每个只列一次",
+		keyLinksLabel:         "另请参阅",
+		keyErrReservedFor:     "请填写为谁预订，最多 %d 个字符",
+		keyErrPurchased:       "已购买数量必须是 0 到 %d 之间的整数",
+		keyErrPurchasedUnlim:  "不限数量的愿望不记录购买",
+		keyErrPurchasedFailed: "无法记录购买",
+		keyErrWishExpired:     "该愿望已过期，无法再预订",
+		keyErrPreviewLang:     "请用 lang 指定预览语言，可选：%s",
+		keyUnitWeeks:          "按周",
+		keyUnitDays:           "按天",
+		keyReserveShortened:   "已预订至 %s（该愿望届时到期），谢谢！",
+		keyErrInvalidField:    "%s 的值无效",
+		keyErrURLDomain:       "%s 不在允许的商店列表中",
+		keyErrLinkLabelLength: "链接名称过长（最多 %d 个字符）",
 	},
 }
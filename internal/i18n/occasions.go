@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package i18n
+
+import "strings"
+
+// occasions maps canonical occasion keys, as used in a wish's contextTags, to
+// their display label per language.
+//
+//nolint:gochecknoglobals,gosmopolitan // immutable registry with CJK characters
+var occasions = map[string]map[string]string{
+	"birthday": {
+		LangEN: "Birthday",
+		LangRU: "День рождения",
+		LangZH: "生日",
+	},
+	"christmas": {
+		LangEN: "Christmas",
+		LangRU: "Рождество",
+		LangZH: "圣诞节",
+	},
+	"new-year": {
+		LangEN: "New Year",
+		LangRU: "Новый год",
+		LangZH: "新年",
+	},
+	"wedding": {
+		LangEN: "Wedding",
+		LangRU: "Свадьба",
+		LangZH: "婚礼",
+	},
+	"anniversary": {
+		LangEN: "Anniversary",
+		LangRU: "Годовщина",
+		LangZH: "周年纪念",
+	},
+	"housewarming": {
+		LangEN: "Housewarming",
+		LangRU: "Новоселье",
+		LangZH: "乔迁",
+	},
+	"graduation": {
+		LangEN: "Graduation",
+		LangRU: "Выпускной",
+		LangZH: "毕业",
+	},
+	"baby-shower": {
+		LangEN: "Baby shower",
+		LangRU: "Бэби-шауэр",
+		LangZH: "迎婴派对",
+	},
+	"valentines-day": {
+		LangEN: "Valentine's Day",
+		LangRU: "День святого Валентина",
+		LangZH: "情人节",
+	},
+}
+
+// Occasion returns the display label of a context tag naming a known
+// occasion (matched case-insensitively, e.g. "birthday" or "new-year") in the
+// given language, falling back to English for other languages. Unknown tags
+// are returned verbatim.
+func Occasion(lang, tag string) string {
+	labels, ok := occasions[strings.ToLower(tag)]
+	if !ok {
+		return tag
+	}
+
+	if label, ok := labels[lang]; ok {
+		return label
+	}
+
+	return labels[DefaultLang]
+}
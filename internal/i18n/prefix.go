@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package i18n
+
+import (
+	"context"
+	"strings"
+)
+
+// languageKey is the context key for a language chosen by URL path prefix.
+type languageKey struct{}
+
+// WithLanguage returns a copy of ctx that pins the request language, taking
+// precedence over the ?lang= parameter and Accept-Language in DetectLanguage.
+func WithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, languageKey{}, lang)
+}
+
+// languageFromContext returns the language pinned by WithLanguage, if any.
+func languageFromContext(ctx context.Context) (string, bool) {
+	lang, ok := ctx.Value(languageKey{}).(string)
+
+	return lang, ok
+}
+
+// SplitPathPrefix splits a supported language prefix off path, so
+// "/ru/wishes/lamp" gives "ru" and "/wishes/lamp", and "/ru" gives "ru" and
+// "/". ok is false when the first segment is not a supported language.
+func SplitPathPrefix(path string) (lang, rest string, ok bool) {
+	segment, rest, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	if segment == "" || segment != strings.ToLower(segment) || !IsSupported(segment) {
+		return "", path, false
+	}
+
+	return segment, "/" + rest, true
+}
+
+// LocalizedPath prefixes path with lang, the canonical form of a localized
+// URL: LocalizedPath("ru", "/wishes/lamp") is "/ru/wishes/lamp".
+func LocalizedPath(lang, path string) string {
+	return "/" + lang + path
+}
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// maxDeferredEvents bounds the events held until quiet hours end.
+const maxDeferredEvents = 1000
+
+// ErrDeferredBacklogFull is returned when an event arrives during quiet hours
+// while maxDeferredEvents are already waiting.
+var ErrDeferredBacklogFull = errors.New("too many notifications deferred for quiet hours")
+
+// Sender delivers events, such as a Webhook.
+type Sender interface {
+	Send(ctx context.Context, event Event) error
+}
+
+// QuietHours is a daily window, in minutes since midnight in Location, during
+// which notifications are held back. A window whose start is after its end
+// spans midnight; equal bounds disable it.
+type QuietHours struct {
+	Start    int
+	End      int
+	Location *time.Location
+}
+
+// ParseQuietHours parses a window such as "22:00-08:00" in the named IANA
+// time zone. An empty window disables quiet hours; an empty zone means UTC.
+func ParseQuietHours(window, zone string) (QuietHours, error) {
+	if window == "" {
+		return QuietHours{}, nil
+	}
+
+	location, err := time.LoadLocation(zone)
+	if err != nil {
+		return QuietHours{}, fmt.Errorf("time zone %q: %w", zone, err)
+	}
+
+	var startHour, startMinute, endHour, endMinute int
+
+	_, err = fmt.Sscanf(window, "%d:%d-%d:%d", &startHour, &startMinute, &endHour, &endMinute)
+	if err != nil || window != fmt.Sprintf("%02d:%02d-%02d:%02d", startHour, startMinute, endHour, endMinute) ||
+		startHour > 23 || endHour > 23 || startMinute > 59 || endMinute > 59 {
+		return QuietHours{}, fmt.Errorf("want HH:MM-HH:MM, got %q", window)
+	}
+
+	return QuietHours{
+		Start:    startHour*60 + startMinute,
+		End:      endHour*60 + endMinute,
+		Location: location,
+	}, nil
+}
+
+// Enabled reports whether the window is non-empty.
+func (q QuietHours) Enabled() bool {
+	return q.Start != q.End
+}
+
+// Remaining returns how long the quiet window containing now lasts, or zero
+// if now is outside quiet hours.
+func (q QuietHours) Remaining(now time.Time) time.Duration {
+	if !q.Enabled() {
+		return 0
+	}
+
+	location := q.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	local := now.In(location)
+	minute := local.Hour()*60 + local.Minute()
+
+	var endDay int
+
+	switch {
+	case q.Start < q.End && minute >= q.Start && minute < q.End, q.Start > q.End && minute < q.End:
+		endDay = local.Day()
+	case q.Start > q.End && minute >= q.Start:
+		endDay = local.Day() + 1
+	default:
+		return 0
+	}
+
+	end := time.Date(local.Year(), local.Month(), endDay, q.End/60, q.End%60, 0, 0, location)
+
+	return end.Sub(now)
+}
+
+// QuietSender holds events sent during quiet hours and delivers them, in
+// order, once the window ends; outside it events go straight through. Held
+// events are delivered with a background context and failures are only
+// logged, since the request that raised them is long gone.
+type QuietSender struct {
+	next  Sender
+	hours QuietHours
+	clock clock.WithDelayedExecution
+
+	mu      sync.Mutex
+	pending []Event
+}
+
+// NewQuietSender wraps next, deferring events during hours as told by clk.
+func NewQuietSender(next Sender, hours QuietHours, clk clock.WithDelayedExecution) *QuietSender {
+	return &QuietSender{next: next, hours: hours, clock: clk}
+}
+
+// Send delivers the event now, or queues it until quiet hours end.
+func (q *QuietSender) Send(ctx context.Context, event Event) error {
+	wait := q.hours.Remaining(q.clock.Now())
+	if wait <= 0 {
+		return q.next.Send(ctx, event)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.pending) >= maxDeferredEvents {
+		return ErrDeferredBacklogFull
+	}
+
+	if len(q.pending) == 0 {
+		q.clock.AfterFunc(wait, q.flush)
+	}
+
+	q.pending = append(q.pending, event)
+
+	return nil
+}
+
+// Deferred returns how many events are waiting for quiet hours to end.
+func (q *QuietSender) Deferred() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.pending)
+}
+
+// flush delivers the queued events.
+func (q *QuietSender) flush() {
+	q.mu.Lock()
+	events := q.pending
+	q.pending = nil
+	q.mu.Unlock()
+
+	for _, event := range events {
+		if err := q.next.Send(context.Background(), event); err != nil {
+			logf.Log.WithName("quiet-hours").Error(err, "Failed to send deferred notification",
+				"type", event.Type, "wish", event.Wish, "namespace", event.Namespace)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package notify
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// recordingSender remembers the events it was asked to send.
+type recordingSender struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (s *recordingSender) Send(_ context.Context, event Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, event)
+
+	return nil
+}
+
+func (s *recordingSender) sent() []Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.events)
+}
+
+func TestParseQuietHours(t *testing.T) {
+	t.Parallel()
+
+	hours, err := ParseQuietHours("22:30-08:00", "Europe/Moscow")
+	require.NoError(t, err)
+	assert.Equal(t, 22*60+30, hours.Start)
+	assert.Equal(t, 8*60, hours.End)
+	assert.Equal(t, "Europe/Moscow", hours.Location.String())
+
+	hours, err = ParseQuietHours("", "")
+	require.NoError(t, err)
+	assert.False(t, hours.Enabled())
+
+	for _, window := range []string{"22-08", "22:00", "24:00-08:00", "22:60-08:00", "22:00-8:00", "22:00 - 08:00"} {
+		_, err := ParseQuietHours(window, "UTC")
+		assert.Error(t, err, window)
+	}
+
+	_, err = ParseQuietHours("22:00-08:00", "Mars/Olympus")
+	assert.Error(t, err)
+}
+
+func TestQuietHours_Remaining(t *testing.T) {
+	t.Parallel()
+
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	overnight := QuietHours{Start: 22 * 60, End: 8 * 60, Location: moscow}
+	daytime := QuietHours{Start: 13 * 60, End: 15 * 60, Location: time.UTC}
+
+	tests := []struct {
+		name  string
+		hours QuietHours
+		now   time.Time
+		want  time.Duration
+	}{
+		{"before overnight window", overnight, time.Date(2025, 1, 1, 21, 59, 0, 0, moscow), 0},
+		{"overnight window before midnight", overnight, time.Date(2025, 1, 1, 23, 0, 0, 0, moscow), 9 * time.Hour},
+		{"overnight window after midnight", overnight, time.Date(2025, 1, 2, 3, 0, 0, 0, moscow), 5 * time.Hour},
+		{"overnight window end", overnight, time.Date(2025, 1, 2, 8, 0, 0, 0, moscow), 0},
+		{"in another zone", overnight, time.Date(2025, 1, 2, 2, 0, 0, 0, time.UTC), 3 * time.Hour},
+		{"daytime window", daytime, time.Date(2025, 1, 1, 14, 30, 0, 0, time.UTC), 30 * time.Minute},
+		{"after daytime window", daytime, time.Date(2025, 1, 1, 16, 0, 0, 0, time.UTC), 0},
+		{"disabled", QuietHours{}, time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.hours.Remaining(tt.now))
+		})
+	}
+}
+
+func TestQuietSender(t *testing.T) {
+	t.Parallel()
+
+	hours := QuietHours{Start: 22 * 60, End: 8 * 60, Location: time.UTC}
+	clock := clocktesting.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	next := &recordingSender{}
+	sender := NewQuietSender(next, hours, clock)
+
+	ctx := context.Background()
+
+	send := func(event Event) { require.NoError(t, sender.Send(ctx, event)) }
+
+	send(Event{Type: EventOwnerMessage, Wish: "noon"})
+	assert.Equal(t, []string{"noon"}, wishes(next.sent()), "sent at once outside quiet hours")
+
+	clock.SetTime(time.Date(2025, 1, 1, 23, 0, 0, 0, time.UTC))
+	send(Event{Type: EventOwnerMessage, Wish: "late"})
+	clock.Step(4 * time.Hour)
+	send(Event{Type: EventWishReceived, Wish: "night"})
+
+	assert.Equal(t, []string{"noon"}, wishes(next.sent()), "held during quiet hours")
+	assert.Equal(t, 2, sender.Deferred())
+
+	clock.Step(5*time.Hour - time.Second)
+	assert.Equal(t, 2, sender.Deferred(), "still quiet just before the end")
+
+	clock.Step(time.Second)
+	assert.Eventually(t, func() bool { return len(next.sent()) == 3 }, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"noon", "late", "night"}, wishes(next.sent()), "delivered in order")
+	assert.Zero(t, sender.Deferred())
+
+	send(Event{Type: EventOwnerMessage, Wish: "morning"})
+	assert.Equal(t, []string{"noon", "late", "night", "morning"}, wishes(next.sent()))
+}
+
+func wishes(events []Event) []string {
+	names := make([]string, 0, len(events))
+	for _, event := range events {
+		names = append(names, event.Wish)
+	}
+
+	return names
+}
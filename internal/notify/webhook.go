@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+// Package notify delivers outbound notifications about wishes.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Event types sent to the outbound webhook.
+const (
+	EventOwnerMessage         = "owner_message"
+	EventWishReceived         = "wish_received"
+	EventReservationConfirmed = "reservation_confirmed"
+	EventWishActiveChanged    = "wish_active_changed"
+	EventReservationsReleased = "reservations_released"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// ErrUnexpectedStatus is returned when the webhook answers with a non-2xx status.
+var ErrUnexpectedStatus = errors.New("unexpected webhook response status")
+
+// Event is the JSON payload posted to the outbound webhook.
+type Event struct {
+	Type      string    `json:"type"`
+	Namespace string    `json:"namespace"`
+	Wish      string    `json:"wish"`
+	Title     string    `json:"title,omitempty"`
+	Message   string    `json:"message,omitempty"`
+	Time      time.Time `json:"time"`
+
+	// Contact, ExpiresAt and Link are set on reservation_confirmed events:
+	// the giver's address to deliver the confirmation to, when the
+	// reservation expires, and where the giver can release it.
+	Contact   string     `json:"contact,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Link      string     `json:"link,omitempty"`
+
+	// Active is the new state on wish_active_changed events.
+	Active *bool `json:"active,omitempty"`
+
+	// Released is how many reservations were dropped on
+	// reservations_released events.
+	Released int32 `json:"released,omitempty"`
+}
+
+// Webhook posts events as JSON to a fixed URL.
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook creates a webhook notifier for the given URL.
+func NewWebhook(url string) *Webhook {
+	return NewWebhookWithTimeout(url, defaultTimeout)
+}
+
+// NewWebhookWithTimeout creates a webhook notifier for the given URL whose
+// posts give up after timeout.
+func NewWebhookWithTimeout(url string, timeout time.Duration) *Webhook {
+	return &Webhook{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Send posts the event to the webhook URL.
+func (h *Webhook) Send(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build webhook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %d", ErrUnexpectedStatus, resp.StatusCode)
+	}
+
+	return nil
+}
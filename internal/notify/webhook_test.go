@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhook_Send(t *testing.T) {
+	t.Parallel()
+
+	var received Event
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	event := Event{
+		Type:      EventOwnerMessage,
+		Namespace: "default",
+		Wish:      "keyboard",
+		Message:   "Which color?",
+		Time:      time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	require.NoError(t, NewWebhook(srv.URL).Send(context.Background(), event))
+	assert.Equal(t, event, received)
+}
+
+func TestWebhook_Send_ErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(srv.Close)
+
+	err := NewWebhook(srv.URL).Send(context.Background(), Event{Type: EventOwnerMessage})
+	require.ErrorIs(t, err, ErrUnexpectedStatus)
+}
+
+func TestWebhook_Send_Timeout(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-release
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+
+	err := NewWebhookWithTimeout(srv.URL, 50*time.Millisecond).Send(context.Background(), Event{Type: EventWishActiveChanged})
+	require.Error(t, err)
+}
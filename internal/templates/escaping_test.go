@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package templates
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+const (
+	xssScript    = `<script>alert("xss")</script>`
+	xssAttribute = `"><img src=x onerror=alert(1)>`
+	xssURL       = `javascript:alert(1)`
+)
+
+// hostileWish fills every user-controlled field with markup that must not
+// survive rendering unescaped.
+func hostileWish() *wishlistv1alpha1.Wish {
+	return &wishlistv1alpha1.Wish{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "hostile",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-48 * time.Hour)),
+		},
+		Spec: wishlistv1alpha1.WishSpec{
+			Title:        xssScript + " & Tom's <b>gift</b>",
+			Description:  xssScript + xssAttribute,
+			MSRP:         `<i>$10</i>`,
+			ImageURL:     `https://example.com/a.png` + xssAttribute,
+			OfficialURL:  xssURL,
+			PurchaseURLs: []string{xssURL, "https://shop.example.com/?a=1&b=<2>"},
+			Links:        []wishlistv1alpha1.Link{{Label: xssScript, URL: xssURL}},
+			OwnerContact: xssScript,
+			Tags:         []string{xssScript},
+			ContextTags:  []string{xssAttribute},
+			Quantity:     1,
+			TTL:          &metav1.Duration{Duration: 24 * time.Hour},
+		},
+		Status: wishlistv1alpha1.WishStatus{
+			Active: true,
+		},
+	}
+}
+
+func render(t *testing.T, component templ.Component) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, component.Render(context.Background(), &buf))
+
+	return buf.String()
+}
+
+// assertEscaped checks that none of the hostile payloads appear verbatim.
+func assertEscaped(t *testing.T, html string) {
+	t.Helper()
+
+	assert.NotContains(t, html, xssScript)
+	assert.NotContains(t, html, `<img src=x`)
+	assert.NotContains(t, html, `<b>gift</b>`)
+	assert.NotContains(t, html, `<i>$10</i>`)
+	assert.NotContains(t, html, `href="javascript:`)
+	assert.Contains(t, html, "&lt;script&gt;")
+}
+
+func TestEscaping_WishCard(t *testing.T) {
+	t.Parallel()
+
+	html := render(t, WishCard(hostileWish(), "en"))
+
+	assertEscaped(t, html)
+	assert.Contains(t, html, "Tom&#39;s")
+	assert.Contains(t, html, "&amp;b=&lt;2&gt;")
+}
+
+func TestEscaping_WishContent(t *testing.T) {
+	t.Parallel()
+
+	wish := hostileWish()
+	html := render(t, WishContent([]wishlistv1alpha1.Wish{*wish}, wish.Spec.Tags, xssScript, "en"))
+
+	assertEscaped(t, html)
+	// Tag values in filter links are query-escaped, not just HTML-escaped.
+	assert.Contains(t, html, "/?tag=%3Cscript%3E")
+}
+
+func TestEscaping_WishContentEmptyFilter(t *testing.T) {
+	t.Parallel()
+
+	html := render(t, WishContent(nil, nil, xssScript, "en"))
+
+	assert.NotContains(t, html, xssScript)
+	assert.Contains(t, html, "&lt;script&gt;")
+}
+
+func TestEscaping_Index(t *testing.T) {
+	t.Parallel()
+
+	wish := hostileWish()
+	html := render(t, Index([]wishlistv1alpha1.Wish{*wish}, wish.Spec.Tags, "", "en"))
+
+	assertEscaped(t, html)
+}
+
+func TestEscaping_Archive(t *testing.T) {
+	t.Parallel()
+
+	wish := hostileWish()
+	html := render(t, Archive([]wishlistv1alpha1.Wish{*wish}, "en"))
+
+	assertEscaped(t, html)
+}
+
+func TestEscaping_WishLinks(t *testing.T) {
+	t.Parallel()
+
+	assertEscaped(t, render(t, WishLinks(hostileWish(), "en")))
+}
@@ -13,6 +13,8 @@ import "github.com/a-h/templ"
 import templruntime "github.com/a-h/templ/runtime"
 
 import (
+	"net/url"
+
 	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
 	"github.com/lexfrei/wish-operator/internal/i18n"
 )
@@ -46,7 +48,7 @@ func FilterBar(allTags []string, activeTag string, lang string) templ.Component
 			var templ_7745c5c3_Var2 string
 			templ_7745c5c3_Var2, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(lang, "filter_label"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 14, Col: 60}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 16, Col: 60}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var2))
 			if templ_7745c5c3_Err != nil {
@@ -81,7 +83,7 @@ func FilterBar(allTags []string, activeTag string, lang string) templ.Component
 			var templ_7745c5c3_Var5 string
 			templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.ResolveAttributeValue("/wishes?lang=" + lang)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 18, Col: 35}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 20, Col: 35}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var5)
 			if templ_7745c5c3_Err != nil {
@@ -94,7 +96,7 @@ func FilterBar(allTags []string, activeTag string, lang string) templ.Component
 			var templ_7745c5c3_Var6 string
 			templ_7745c5c3_Var6, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(lang, "filter_all"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 22, Col: 32}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 24, Col: 32}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var6))
 			if templ_7745c5c3_Err != nil {
@@ -115,9 +117,9 @@ func FilterBar(allTags []string, activeTag string, lang string) templ.Component
 					return templ_7745c5c3_Err
 				}
 				var templ_7745c5c3_Var8 templ.SafeURL
-				templ_7745c5c3_Var8, templ_7745c5c3_Err = templ.JoinURLErrs(templ.SafeURL("/?tag=" + tag))
+				templ_7745c5c3_Var8, templ_7745c5c3_Err = templ.JoinURLErrs(templ.SafeURL("/?tag=" + url.QueryEscape(tag)))
 				if templ_7745c5c3_Err != nil {
-					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 25, Col: 41}
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 27, Col: 58}
 				}
 				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var8))
 				if templ_7745c5c3_Err != nil {
@@ -141,9 +143,9 @@ func FilterBar(allTags []string, activeTag string, lang string) templ.Component
 					return templ_7745c5c3_Err
 				}
 				var templ_7745c5c3_Var10 string
-				templ_7745c5c3_Var10, templ_7745c5c3_Err = templ.ResolveAttributeValue("/wishes?tag=" + tag + "&lang=" + lang)
+				templ_7745c5c3_Var10, templ_7745c5c3_Err = templ.ResolveAttributeValue("/wishes?tag=" + url.QueryEscape(tag) + "&lang=" + lang)
 				if templ_7745c5c3_Err != nil {
-					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 27, Col: 52}
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 29, Col: 69}
 				}
 				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var10)
 				if templ_7745c5c3_Err != nil {
@@ -154,9 +156,9 @@ func FilterBar(allTags []string, activeTag string, lang string) templ.Component
 					return templ_7745c5c3_Err
 				}
 				var templ_7745c5c3_Var11 string
-				templ_7745c5c3_Var11, templ_7745c5c3_Err = templ.ResolveAttributeValue("/?tag=" + tag)
+				templ_7745c5c3_Var11, templ_7745c5c3_Err = templ.ResolveAttributeValue("/?tag=" + url.QueryEscape(tag))
 				if templ_7745c5c3_Err != nil {
-					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 30, Col: 33}
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 32, Col: 50}
 				}
 				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var11)
 				if templ_7745c5c3_Err != nil {
@@ -167,9 +169,9 @@ func FilterBar(allTags []string, activeTag string, lang string) templ.Component
 					return templ_7745c5c3_Err
 				}
 				var templ_7745c5c3_Var12 string
-				templ_7745c5c3_Var12, templ_7745c5c3_Err = templ.JoinStringErrs(tag)
+				templ_7745c5c3_Var12, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.Occasion(lang, tag))
 				if templ_7745c5c3_Err != nil {
-					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 31, Col: 10}
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 33, Col: 31}
 				}
 				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var12))
 				if templ_7745c5c3_Err != nil {
@@ -210,120 +212,190 @@ func Index(wishes []wishlistv1alpha1.Wish, allTags []string, activeTag string, l
 			templ_7745c5c3_Var13 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "<!doctype html><html lang=\"")
+		templ_7745c5c3_Var14 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+			templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+			if !templ_7745c5c3_IsBuffer {
+				defer func() {
+					templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+					if templ_7745c5c3_Err == nil {
+						templ_7745c5c3_Err = templ_7745c5c3_BufErr
+					}
+				}()
+			}
+			ctx = templ.InitializeContext(ctx)
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "<h1>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var15 string
+			templ_7745c5c3_Var15, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(lang, "page_title"))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 41, Col: 34}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var15))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, "</h1><div id=\"wish-content\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = WishContent(wishes, allTags, activeTag, lang).Render(ctx, templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 16, "</div>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			return nil
+		})
+		templ_7745c5c3_Err = Page(lang, i18n.LocalizedPath(lang, "/")).Render(templ.WithChildren(ctx, templ_7745c5c3_Var14), templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var14 string
-		templ_7745c5c3_Var14, templ_7745c5c3_Err = templ.ResolveAttributeValue(lang)
+		return nil
+	})
+}
+
+// Page is the shared HTML document shell: head, styles, and footer.
+// canonical is the page's language-prefixed path, linked as rel=canonical.
+func Page(lang, canonical string) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var16 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var16 == nil {
+			templ_7745c5c3_Var16 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 17, "<!doctype html><html lang=\"")
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 39, Col: 18}
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var17 string
+		templ_7745c5c3_Var17, templ_7745c5c3_Err = templ.ResolveAttributeValue(lang)
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 52, Col: 18}
 		}
-		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var14)
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var17)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, "\"><head><meta charset=\"UTF-8\"><meta name=\"viewport\" content=\"width=device-width, initial-scale=1.0\"><title>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 18, "\"><head><meta charset=\"UTF-8\"><meta name=\"viewport\" content=\"width=device-width, initial-scale=1.0\"><title>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var15 string
-		templ_7745c5c3_Var15, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(lang, "page_title"))
+		var templ_7745c5c3_Var18 string
+		templ_7745c5c3_Var18, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(lang, "page_title"))
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 43, Col: 38}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 56, Col: 38}
 		}
-		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var15))
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var18))
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 16, "</title><script src=\"https://unpkg.com/htmx.org@2.0.4\"></script><script>\n\t\t\t\t(function() {\n\t\t\t\t\tconst theme = localStorage.getItem('theme');\n\t\t\t\t\tif (theme === 'dark' || (theme === 'auto' || !theme) && window.matchMedia('(prefers-color-scheme: dark)').matches) {\n\t\t\t\t\t\tdocument.documentElement.setAttribute('data-theme', 'dark');\n\t\t\t\t\t}\n\t\t\t\t})();\n\t\t\t</script><style>\n\t\t\t\t:root {\n\t\t\t\t\t--bg-primary: #f5f5f5;\n\t\t\t\t\t--bg-card: #ffffff;\n\t\t\t\t\t--text-primary: #333333;\n\t\t\t\t\t--text-secondary: #6b7280;\n\t\t\t\t\t--text-muted: #374151;\n\t\t\t\t\t--border-color: #d1d5db;\n\t\t\t\t\t--border-hover: #9ca3af;\n\t\t\t\t\t--accent-color: #2563eb;\n\t\t\t\t\t--accent-hover: #1d4ed8;\n\t\t\t\t\t--tag-bg: #e5e7eb;\n\t\t\t\t\t--tag-context-bg: #dbeafe;\n\t\t\t\t\t--tag-context-text: #1d4ed8;\n\t\t\t\t\t--reserved-bg: #fef3c7;\n\t\t\t\t\t--reserved-text: #92400e;\n\t\t\t\t\t--stars-color: #fbbf24;\n\t\t\t\t\t--chip-bg: #ffffff;\n\t\t\t\t\t--chip-hover: #f3f4f6;\n\t\t\t\t\t--shadow: rgba(0,0,0,0.1);\n\t\t\t\t\t--footer-border: #e5e7eb;\n\t\t\t\t}\n\t\t\t\t[data-theme=\"dark\"] {\n\t\t\t\t\t--bg-primary: #1a1a2e;\n\t\t\t\t\t--bg-card: #16213e;\n\t\t\t\t\t--text-primary: #e4e4e7;\n\t\t\t\t\t--text-secondary: #a1a1aa;\n\t\t\t\t\t--text-muted: #d4d4d8;\n\t\t\t\t\t--border-color: #3f3f46;\n\t\t\t\t\t--border-hover: #52525b;\n\t\t\t\t\t--accent-color: #3b82f6;\n\t\t\t\t\t--accent-hover: #2563eb;\n\t\t\t\t\t--tag-bg: #27272a;\n\t\t\t\t\t--tag-context-bg: #1e3a5f;\n\t\t\t\t\t--tag-context-text: #60a5fa;\n\t\t\t\t\t--reserved-bg: #422006;\n\t\t\t\t\t--reserved-text: #fbbf24;\n\t\t\t\t\t--stars-color: #fbbf24;\n\t\t\t\t\t--chip-bg: #27272a;\n\t\t\t\t\t--chip-hover: #3f3f46;\n\t\t\t\t\t--shadow: rgba(0,0,0,0.3);\n\t\t\t\t\t--footer-border: #3f3f46;\n\t\t\t\t}\n\t\t\t\t* { box-sizing: border-box; margin: 0; padding: 0; }\n\t\t\t\tbody { font-family: system-ui, -apple-system, sans-serif; background: var(--bg-primary); padding: 2rem; color: var(--text-primary); transition: background 0.3s, color 0.3s; }\n\t\t\t\t.container { max-width: 1200px; margin: 0 auto; }\n\t\t\t\th1 { text-align: center; margin-bottom: 2rem; color: var(--text-primary); }\n\t\t\t\t.wishes { display: grid; grid-template-columns: repeat(auto-fill, minmax(300px, 1fr)); gap: 1.5rem; }\n\t\t\t\t.wish-card { background: var(--bg-card); border-radius: 12px; padding: 1.5rem; box-shadow: 0 2px 8px var(--shadow); transition: background 0.3s; }\n\t\t\t\t.wish-card.reserved { opacity: 0.7; }\n\t\t\t\t.wish-card img { width: 100%; height: 200px; object-fit: contain; border-radius: 8px; margin-bottom: 1rem; }\n\t\t\t\t.wish-card h2 { font-size: 1.25rem; margin-bottom: 0.5rem; color: var(--text-primary); }\n\t\t\t\t.wish-card h2 a { color: var(--accent-color); text-decoration: none; }\n\t\t\t\t.wish-card h2 a:hover { text-decoration: underline; }\n\t\t\t\t.wish-card .price { font-size: 1.5rem; font-weight: bold; color: var(--accent-color); margin-bottom: 0.5rem; }\n\t\t\t\t.wish-card .stars { color: var(--stars-color); margin-bottom: 0.5rem; }\n\t\t\t\t.wish-card .tags { display: flex; flex-wrap: wrap; gap: 0.5rem; margin-bottom: 1rem; }\n\t\t\t\t.wish-card .tag { background: var(--tag-bg); color: var(--text-secondary); padding: 0.25rem 0.75rem; border-radius: 9999px; font-size: 0.875rem; }\n\t\t\t\t.wish-card .context-tag { background: var(--tag-context-bg); color: var(--tag-context-text); }\n\t\t\t\t.wish-card .description { color: var(--text-secondary); font-size: 0.875rem; margin-bottom: 1rem; }\n\t\t\t\t.wish-card .purchase-links { margin-bottom: 1rem; font-size: 0.875rem; color: var(--text-secondary); }\n\t\t\t\t.wish-card .purchase-links a { color: var(--accent-color); margin-left: 0.25rem; }\n\t\t\t\t.wish-card .reserve-form { display: flex; gap: 0.5rem; }\n\t\t\t\t.wish-card select, .wish-card button { padding: 0.5rem 1rem; border-radius: 6px; border: 1px solid var(--border-color); background: var(--bg-card); color: var(--text-primary); }\n\t\t\t\t.wish-card button { background: var(--accent-color); color: white; border: none; cursor: pointer; }\n\t\t\t\t.wish-card button:hover { background: var(--accent-hover); }\n\t\t\t\t.wish-card .reserved-badge { background: var(--reserved-bg); color: var(--reserved-text); padding: 0.5rem 1rem; border-radius: 6px; text-align: center; }\n\t\t\t\t.wish-card .reserved-until { font-size: 0.75rem; margin-top: 0.25rem; opacity: 0.8; }\n\t\t\t\t.wish-card.fully-reserved { opacity: 0.7; }\n\t\t\t\t.wish-card .quantity-info { font-size: 0.875rem; color: var(--text-secondary); margin-bottom: 0.5rem; }\n\t\t\t\t.wish-card .quantity-info.unlimited { color: #10b981; font-weight: 600; }\n\t\t\t\t.wish-card .reservations-list { margin-bottom: 1rem; }\n\t\t\t\t.wish-card .reservation-item { font-size: 0.75rem; color: var(--reserved-text); background: var(--reserved-bg); padding: 0.25rem 0.5rem; border-radius: 4px; margin-bottom: 0.25rem; }\n\t\t\t\t.wish-card .fully-reserved-badge { background: var(--reserved-bg); color: var(--reserved-text); padding: 0.5rem 1rem; border-radius: 6px; text-align: center; }\n\t\t\t\t.empty { text-align: center; color: var(--text-secondary); padding: 4rem; }\n\t\t\t\t.filter-bar { display: flex; flex-wrap: wrap; gap: 0.5rem; margin-bottom: 1.5rem; align-items: center; }\n\t\t\t\t.filter-label { font-weight: 500; color: var(--text-muted); margin-right: 0.5rem; }\n\t\t\t\t.filter-chip { padding: 0.375rem 0.875rem; border-radius: 9999px; font-size: 0.875rem; cursor: pointer; border: 1px solid var(--border-color); background: var(--chip-bg); color: var(--text-muted); transition: all 0.15s; text-decoration: none; }\n\t\t\t\t.filter-chip:hover { background: var(--chip-hover); border-color: var(--border-hover); }\n\t\t\t\t.filter-chip.active { background: var(--accent-color); color: white; border-color: var(--accent-color); }\n\t\t\t\t.footer { margin-top: 3rem; padding-top: 1.5rem; border-top: 1px solid var(--footer-border); text-align: center; }\n\t\t\t\t.footer-row { display: flex; justify-content: center; gap: 1rem; margin-bottom: 0.75rem; }\n\t\t\t\t.footer-row:last-child { margin-bottom: 0; }\n\t\t\t\t.lang-selector a, .theme-selector button { font-size: 1.5rem; text-decoration: none; opacity: 0.6; transition: opacity 0.15s; background: none; border: none; cursor: pointer; padding: 0.25rem; }\n\t\t\t\t.lang-selector a:hover, .theme-selector button:hover { opacity: 1; }\n\t\t\t\t.lang-selector a.active, .theme-selector button.active { opacity: 1; }\n\t\t\t</style></head><body><div class=\"container\"><h1>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 19, "</title><link rel=\"canonical\" href=\"")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var16 string
-		templ_7745c5c3_Var16, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(lang, "page_title"))
+		var templ_7745c5c3_Var19 templ.SafeURL
+		templ_7745c5c3_Var19, templ_7745c5c3_Err = templ.JoinURLErrs(templ.SafeURL(canonical))
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 143, Col: 36}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 57, Col: 56}
 		}
-		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var16))
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var19))
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 17, "</h1><div id=\"wish-content\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 20, "\"><link rel=\"icon\" href=\"/favicon.ico\"><script src=\"https://unpkg.com/htmx.org@2.0.4\"></script><script>\n\t\t\t\t(function() {\n\t\t\t\t\tconst theme = localStorage.getItem('theme');\n\t\t\t\t\tif (theme === 'dark' || (theme === 'auto' || !theme) && window.matchMedia('(prefers-color-scheme: dark)').matches) {\n\t\t\t\t\t\tdocument.documentElement.setAttribute('data-theme', 'dark');\n\t\t\t\t\t}\n\t\t\t\t})();\n\t\t\t</script><style>\n\t\t\t\t:root {\n\t\t\t\t\t--bg-primary: #f5f5f5;\n\t\t\t\t\t--bg-card: #ffffff;\n\t\t\t\t\t--text-primary: #333333;\n\t\t\t\t\t--text-secondary: #6b7280;\n\t\t\t\t\t--text-muted: #374151;\n\t\t\t\t\t--border-color: #d1d5db;\n\t\t\t\t\t--border-hover: #9ca3af;\n\t\t\t\t\t--accent-color: #2563eb;\n\t\t\t\t\t--accent-hover: #1d4ed8;\n\t\t\t\t\t--tag-bg: #e5e7eb;\n\t\t\t\t\t--tag-context-bg: #dbeafe;\n\t\t\t\t\t--tag-context-text: #1d4ed8;\n\t\t\t\t\t--reserved-bg: #fef3c7;\n\t\t\t\t\t--reserved-text: #92400e;\n\t\t\t\t\t--stars-color: #fbbf24;\n\t\t\t\t\t--chip-bg: #ffffff;\n\t\t\t\t\t--chip-hover: #f3f4f6;\n\t\t\t\t\t--shadow: rgba(0,0,0,0.1);\n\t\t\t\t\t--footer-border: #e5e7eb;\n\t\t\t\t}\n\t\t\t\t[data-theme=\"dark\"] {\n\t\t\t\t\t--bg-primary: #1a1a2e;\n\t\t\t\t\t--bg-card: #16213e;\n\t\t\t\t\t--text-primary: #e4e4e7;\n\t\t\t\t\t--text-secondary: #a1a1aa;\n\t\t\t\t\t--text-muted: #d4d4d8;\n\t\t\t\t\t--border-color: #3f3f46;\n\t\t\t\t\t--border-hover: #52525b;\n\t\t\t\t\t--accent-color: #3b82f6;\n\t\t\t\t\t--accent-hover: #2563eb;\n\t\t\t\t\t--tag-bg: #27272a;\n\t\t\t\t\t--tag-context-bg: #1e3a5f;\n\t\t\t\t\t--tag-context-text: #60a5fa;\n\t\t\t\t\t--reserved-bg: #422006;\n\t\t\t\t\t--reserved-text: #fbbf24;\n\t\t\t\t\t--stars-color: #fbbf24;\n\t\t\t\t\t--chip-bg: #27272a;\n\t\t\t\t\t--chip-hover: #3f3f46;\n\t\t\t\t\t--shadow: rgba(0,0,0,0.3);\n\t\t\t\t\t--footer-border: #3f3f46;\n\t\t\t\t}\n\t\t\t\t* { box-sizing: border-box; margin: 0; padding: 0; }\n\t\t\t\tbody { font-family: system-ui, -apple-system, sans-serif; background: var(--bg-primary); padding: 2rem; color: var(--text-primary); transition: background 0.3s, color 0.3s; }\n\t\t\t\t.container { max-width: 1200px; margin: 0 auto; }\n\t\t\t\th1 { text-align: center; margin-bottom: 2rem; color: var(--text-primary); }\n\t\t\t\t.wishes { display: grid; grid-template-columns: repeat(auto-fill, minmax(300px, 1fr)); gap: 1.5rem; }\n\t\t\t\t.wish-card { background: var(--bg-card); border-radius: 12px; padding: 1.5rem; box-shadow: 0 2px 8px var(--shadow); transition: background 0.3s; }\n\t\t\t\t.wish-card.reserved { opacity: 0.7; }\n\t\t\t\t.wish-card img { width: 100%; height: 200px; object-fit: contain; border-radius: 8px; margin-bottom: 1rem; }\n\t\t\t\t.wish-card h2 { font-size: 1.25rem; margin-bottom: 0.5rem; color: var(--text-primary); }\n\t\t\t\t.wish-card h2 a { color: var(--accent-color); text-decoration: none; }\n\t\t\t\t.wish-card h2 a:hover { text-decoration: underline; }\n\t\t\t\t.wish-card .price { font-size: 1.5rem; font-weight: bold; color: var(--accent-color); margin-bottom: 0.5rem; }\n\t\t\t\t.wish-card .needed-by { font-size: 0.875rem; color: var(--text-muted); margin-bottom: 0.5rem; }\n\t\t\t\t.wish-card .needed-by.needed-soon { color: #d97706; font-weight: bold; }\n\t\t\t\t.wish-card .needed-by.overdue { color: #dc2626; }\n\t\t\t\t.wish-card .price-checked { font-size: 0.75rem; color: var(--text-muted); margin: -0.25rem 0 0.5rem; }\n\t\t\t\t.wish-card .stars { color: var(--stars-color); margin-bottom: 0.5rem; }\n\t\t\t\t.wish-card .popular-badge { display: inline-block; background: #fef3c7; color: #92400e; padding: 0.125rem 0.5rem; border-radius: 9999px; font-size: 0.75rem; font-weight: bold; margin-bottom: 0.5rem; }\n\t\t\t\t.compare-wrap { overflow-x: auto; margin-top: 1rem; }\n\t\t\t\t.compare { width: 100%; border-collapse: collapse; background: var(--bg-card); border-radius: 8px; }\n\t\t\t\t.compare th, .compare td { padding: 0.75rem; border-bottom: 1px solid var(--border-color); text-align: left; vertical-align: top; color: var(--text-primary); }\n\t\t\t\t.compare thead th a { color: var(--accent-color); text-decoration: none; }\n\t\t\t\t.compare tbody th { color: var(--text-secondary); font-weight: normal; white-space: nowrap; }\n\t\t\t\t.compare .stars { color: var(--stars-color); }\n\t\t\t\t.compare .description { color: var(--text-secondary); font-size: 0.875rem; }\n\t\t\t\t.wish-card .tags { display: flex; flex-wrap: wrap; gap: 0.5rem; margin-bottom: 1rem; }\n\t\t\t\t.wish-card .tag { background: var(--tag-bg); color: var(--text-secondary); padding: 0.25rem 0.75rem; border-radius: 9999px; font-size: 0.875rem; }\n\t\t\t\t.wish-card .context-tag { background: var(--tag-context-bg); color: var(--tag-context-text); }\n\t\t\t\t.wish-card .description { color: var(--text-secondary); font-size: 0.875rem; margin-bottom: 1rem; }\n\t\t\t\t.wish-card .purchase-links { margin-bottom: 1rem; font-size: 0.875rem; color: var(--text-secondary); }\n\t\t\t\t.wish-card .purchase-links a { color: var(--accent-color); margin-left: 0.25rem; }\n\t\t\t\t.wish-links { max-width: 600px; margin: 1.5rem auto 0; background: var(--bg-card); border-radius: 12px; padding: 1rem 1.5rem; font-size: 0.875rem; color: var(--text-secondary); box-shadow: 0 2px 8px var(--shadow); }\n\t\t\t\t.wish-links ul { list-style: none; margin-top: 0.5rem; }\n\t\t\t\t.wish-links li { margin-bottom: 0.25rem; }\n\t\t\t\t.wish-links a { color: var(--accent-color); }\n\t\t\t\t.wish-card h2 .permalink { margin-left: 0.375rem; font-weight: normal; color: var(--text-secondary); text-decoration: none; }\n\t\t\t\t.wish-card .owner-contact { margin-bottom: 1rem; font-size: 0.875rem; color: var(--text-secondary); }\n\t\t\t\t.wish-card .owner-contact a { color: var(--accent-color); }\n\t\t\t\t.wish-card .reserve-form { display: flex; gap: 0.5rem; }\n\t\t\t\t.wish-card .reserve-form input[name=\"note\"] { flex: 1; min-width: 0; }\n\t\t\t\t.wish-card select, .wish-card button, .wish-card .reserve-form input { padding: 0.5rem 1rem; border-radius: 6px; border: 1px solid var(--border-color); background: var(--bg-card); color: var(--text-primary); }\n\t\t\t\t.wish-card button { background: var(--accent-color); color: white; border: none; cursor: pointer; }\n\t\t\t\t.wish-card button:hover { background: var(--accent-hover); }\n\t\t\t\t.wish-card .reserved-badge { background: var(--reserved-bg); color: var(--reserved-text); padding: 0.5rem 1rem; border-radius: 6px; text-align: center; }\n\t\t\t\t.wish-card .reserved-until { font-size: 0.75rem; margin-top: 0.25rem; opacity: 0.8; }\n\t\t\t\t.wish-card.fully-reserved { opacity: 0.7; }\n\t\t\t\t.wish-card .quantity-info { font-size: 0.875rem; color: var(--text-secondary); margin-bottom: 0.5rem; }\n\t\t\t\t.wish-card .quantity-info.unlimited { color: #10b981; font-weight: 600; }\n\t\t\t\t.wish-card .reservations-list { margin-bottom: 1rem; }\n\t\t\t\t.wish-card .reservation-item { font-size: 0.75rem; color: var(--reserved-text); background: var(--reserved-bg); padding: 0.25rem 0.5rem; border-radius: 4px; margin-bottom: 0.25rem; }\n\t\t\t\t.wish-card .reservation-expiring { font-style: italic; opacity: 0.75; }\n\t\t\t\t.wish-card .fully-reserved-badge { background: var(--reserved-bg); color: var(--reserved-text); padding: 0.5rem 1rem; border-radius: 6px; text-align: center; }\n\t\t\t\t.wish-card .expired-at { font-size: 0.875rem; color: var(--text-secondary); }\n\t\t\t\t.wish-card .fund progress { width: 100%; height: 0.75rem; accent-color: var(--accent-color); }\n\t\t\t\t.wish-card .fund-progress { font-size: 0.875rem; color: var(--text-secondary); margin: 0.25rem 0 0.75rem; }\n\t\t\t\t.wish-card .reserve-error { color: #dc2626; font-size: 0.875rem; margin-top: 0.5rem; }\n\t\t\t\t.wish-card .reserve-success { color: #16a34a; font-size: 0.875rem; margin-top: 0.5rem; }\n\t\t\t\t.wish-card .group-gift { display: flex; flex-wrap: wrap; gap: 0.5rem; align-items: center; margin-bottom: 0.75rem; font-size: 0.875rem; color: var(--text-secondary); }\n\t\t\t\t.wish-set { grid-column: 1 / -1; display: grid; grid-template-columns: repeat(auto-fill, minmax(300px, 1fr)); gap: 1.5rem; padding: 1rem; border: 2px dashed var(--border-color); border-radius: 12px; }\n\t\t\t\t.wish-set-note { grid-column: 1 / -1; color: var(--text-secondary); font-weight: 500; }\n\t\t\t\t.wish-card .reserve-confirm { margin-bottom: 0.75rem; color: var(--text-secondary); }\n\t\t\t\t.wish-card .group-gift-label { font-weight: 500; color: var(--accent-color); }\n\t\t\t\t.stale-banner { background: var(--reserved-bg); color: var(--reserved-text); padding: 0.75rem 1rem; border-radius: 6px; margin-bottom: 1.5rem; text-align: center; }\n\t\t\t\t.empty { text-align: center; color: var(--text-secondary); padding: 4rem; }\n\t\t\t\t.filter-bar { display: flex; flex-wrap: wrap; gap: 0.5rem; margin-bottom: 1.5rem; align-items: center; }\n\t\t\t\t.filter-label { font-weight: 500; color: var(--text-muted); margin-right: 0.5rem; }\n\t\t\t\t.filter-chip { padding: 0.375rem 0.875rem; border-radius: 9999px; font-size: 0.875rem; cursor: pointer; border: 1px solid var(--border-color); background: var(--chip-bg); color: var(--text-muted); transition: all 0.15s; text-decoration: none; }\n\t\t\t\t.filter-chip:hover { background: var(--chip-hover); border-color: var(--border-hover); }\n\t\t\t\t.filter-chip.active { background: var(--accent-color); color: white; border-color: var(--accent-color); }\n\t\t\t\t.footer { margin-top: 3rem; padding-top: 1.5rem; border-top: 1px solid var(--footer-border); text-align: center; }\n\t\t\t\t.footer-row { display: flex; justify-content: center; gap: 1rem; margin-bottom: 0.75rem; }\n\t\t\t\t.footer-row:last-child { margin-bottom: 0; }\n\t\t\t\t.lang-selector a, .theme-selector button { font-size: 1.5rem; text-decoration: none; opacity: 0.6; transition: opacity 0.15s; background: none; border: none; cursor: pointer; padding: 0.25rem; }\n\t\t\t\t.lang-selector a:hover, .theme-selector button:hover { opacity: 1; }\n\t\t\t\t.lang-selector a.active, .theme-selector button.active { opacity: 1; }\n\t\t\t</style></head><body><div class=\"container\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = WishContent(wishes, allTags, activeTag, lang).Render(ctx, templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = templ_7745c5c3_Var16.Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 18, "</div><footer class=\"footer\"><div class=\"footer-row lang-selector\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 21, "<footer class=\"footer\"><div class=\"footer-row lang-selector\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var17 = []any{templ.KV("active", lang == "en")}
-		templ_7745c5c3_Err = templ.RenderCSSItems(ctx, templ_7745c5c3_Buffer, templ_7745c5c3_Var17...)
+		var templ_7745c5c3_Var20 = []any{templ.KV("active", lang == "en")}
+		templ_7745c5c3_Err = templ.RenderCSSItems(ctx, templ_7745c5c3_Buffer, templ_7745c5c3_Var20...)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 19, "<a href=\"/?lang=en\" class=\"")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 22, "<a href=\"/en/\" class=\"")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var18 string
-		templ_7745c5c3_Var18, templ_7745c5c3_Err = templ.ResolveAttributeValue(templ.CSSClasses(templ_7745c5c3_Var17).String())
+		var templ_7745c5c3_Var21 string
+		templ_7745c5c3_Var21, templ_7745c5c3_Err = templ.ResolveAttributeValue(templ.CSSClasses(templ_7745c5c3_Var20).String())
 		if templ_7745c5c3_Err != nil {
 			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 1, Col: 0}
 		}
-		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var18)
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var21)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 20, "\" title=\"English\">🇬🇧</a> ")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 23, "\" title=\"English\">🇬🇧</a> ")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var19 = []any{templ.KV("active", lang == "ru")}
-		templ_7745c5c3_Err = templ.RenderCSSItems(ctx, templ_7745c5c3_Buffer, templ_7745c5c3_Var19...)
+		var templ_7745c5c3_Var22 = []any{templ.KV("active", lang == "ru")}
+		templ_7745c5c3_Err = templ.RenderCSSItems(ctx, templ_7745c5c3_Buffer, templ_7745c5c3_Var22...)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 21, "<a href=\"/?lang=ru\" class=\"")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 24, "<a href=\"/ru/\" class=\"")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var20 string
-		templ_7745c5c3_Var20, templ_7745c5c3_Err = templ.ResolveAttributeValue(templ.CSSClasses(templ_7745c5c3_Var19).String())
+		var templ_7745c5c3_Var23 string
+		templ_7745c5c3_Var23, templ_7745c5c3_Err = templ.ResolveAttributeValue(templ.CSSClasses(templ_7745c5c3_Var22).String())
 		if templ_7745c5c3_Err != nil {
 			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 1, Col: 0}
 		}
-		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var20)
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var23)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 22, "\" title=\"Русский\">🇷🇺</a> ")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 25, "\" title=\"Русский\">🇷🇺</a> ")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var21 = []any{templ.KV("active", lang == "zh")}
-		templ_7745c5c3_Err = templ.RenderCSSItems(ctx, templ_7745c5c3_Buffer, templ_7745c5c3_Var21...)
+		var templ_7745c5c3_Var24 = []any{templ.KV("active", lang == "zh")}
+		templ_7745c5c3_Err = templ.RenderCSSItems(ctx, templ_7745c5c3_Buffer, templ_7745c5c3_Var24...)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 23, "<a href=\"/?lang=zh\" class=\"")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 26, "<a href=\"/zh/\" class=\"")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var22 string
-		templ_7745c5c3_Var22, templ_7745c5c3_Err = templ.ResolveAttributeValue(templ.CSSClasses(templ_7745c5c3_Var21).String())
+		var templ_7745c5c3_Var25 string
+		templ_7745c5c3_Var25, templ_7745c5c3_Err = templ.ResolveAttributeValue(templ.CSSClasses(templ_7745c5c3_Var24).String())
 		if templ_7745c5c3_Err != nil {
 			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/templates/index.templ`, Line: 1, Col: 0}
 		}
-		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var22)
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var25)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 24, "\" title=\"中文\">🇨🇳</a></div><div class=\"footer-row theme-selector\"><button onclick=\"setTheme('light')\" id=\"theme-light\" title=\"Light\">☀️</button> <button onclick=\"setTheme('auto')\" id=\"theme-auto\" title=\"Auto\">🌓</button> <button onclick=\"setTheme('dark')\" id=\"theme-dark\" title=\"Dark\">🌙</button></div></footer></div><script>\n\t\t\t\tfunction setTheme(theme) {\n\t\t\t\t\tlocalStorage.setItem('theme', theme);\n\t\t\t\t\tupdateTheme();\n\t\t\t\t}\n\t\t\t\tfunction updateTheme() {\n\t\t\t\t\tconst theme = localStorage.getItem('theme') || 'auto';\n\t\t\t\t\tconst isDark = theme === 'dark' || (theme === 'auto' && window.matchMedia('(prefers-color-scheme: dark)').matches);\n\t\t\t\t\tdocument.documentElement.setAttribute('data-theme', isDark ? 'dark' : 'light');\n\t\t\t\t\tdocument.querySelectorAll('.theme-selector button').forEach(btn => btn.classList.remove('active'));\n\t\t\t\t\tdocument.getElementById('theme-' + theme)?.classList.add('active');\n\t\t\t\t}\n\t\t\t\tupdateTheme();\n\t\t\t\twindow.matchMedia('(prefers-color-scheme: dark)').addEventListener('change', updateTheme);\n\t\t\t</script></body></html>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 27, "\" title=\"中文\">🇨🇳</a></div><div class=\"footer-row theme-selector\"><button onclick=\"setTheme('light')\" id=\"theme-light\" title=\"Light\">☀️</button> <button onclick=\"setTheme('auto')\" id=\"theme-auto\" title=\"Auto\">🌓</button> <button onclick=\"setTheme('dark')\" id=\"theme-dark\" title=\"Dark\">🌙</button></div></footer></div><script>\n\t\t\t\t// Swap error fragments the server explicitly retargeted, such as\n\t\t\t\t// reserve form validation errors; other error responses are left alone.\n\t\t\t\tdocument.addEventListener('htmx:beforeSwap', function(evt) {\n\t\t\t\t\tif (evt.detail.isError && evt.detail.xhr.getResponseHeader('HX-Retarget')) {\n\t\t\t\t\t\tevt.detail.shouldSwap = true;\n\t\t\t\t\t\tevt.detail.isError = false;\n\t\t\t\t\t}\n\t\t\t\t});\n\t\t\t\tfunction setTheme(theme) {\n\t\t\t\t\tlocalStorage.setItem('theme', theme);\n\t\t\t\t\tupdateTheme();\n\t\t\t\t}\n\t\t\t\tfunction updateTheme() {\n\t\t\t\t\tconst theme = localStorage.getItem('theme') || 'auto';\n\t\t\t\t\tconst isDark = theme === 'dark' || (theme === 'auto' && window.matchMedia('(prefers-color-scheme: dark)').matches);\n\t\t\t\t\tdocument.documentElement.setAttribute('data-theme', isDark ? 'dark' : 'light');\n\t\t\t\t\tdocument.querySelectorAll('.theme-selector button').forEach(btn => btn.classList.remove('active'));\n\t\t\t\t\tdocument.getElementById('theme-' + theme)?.classList.add('active');\n\t\t\t\t}\n\t\t\t\tupdateTheme();\n\t\t\t\twindow.matchMedia('(prefers-color-scheme: dark)').addEventListener('change', updateTheme);\n\t\t\t</script></body></html>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
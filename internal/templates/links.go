@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package templates
+
+import (
+	"net/url"
+	"strings"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// linkLabel returns the text of a wish link: its label, or the URL's host
+// without a leading "www." when the label is empty.
+func linkLabel(link wishlistv1alpha1.Link) string {
+	if label := strings.TrimSpace(link.Label); label != "" {
+		return label
+	}
+
+	parsed, err := url.Parse(link.URL)
+	if err != nil || parsed.Hostname() == "" {
+		return link.URL
+	}
+
+	return strings.TrimPrefix(parsed.Hostname(), "www.")
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package templates
+
+import (
+	"fmt"
+	"time"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// NeededSoonWindow is how long before its NeededBy date a wish is highlighted
+// and listed ahead of others.
+const NeededSoonWindow = 7 * 24 * time.Hour
+
+// NeededSoon reports whether the wish is needed within NeededSoonWindow of
+// now, including wishes already past their NeededBy date.
+func NeededSoon(wish *wishlistv1alpha1.Wish, now time.Time) bool {
+	return wish.Spec.NeededBy != nil && wish.Spec.NeededBy.Sub(now) <= NeededSoonWindow
+}
+
+// isOverdue reports whether the wish's NeededBy date has passed.
+func isOverdue(wish *wishlistv1alpha1.Wish, now time.Time) bool {
+	return wish.Spec.NeededBy != nil && !now.Before(wish.Spec.NeededBy.Time)
+}
+
+// neededByText returns the localized needed-by line for the wish.
+func neededByText(wish *wishlistv1alpha1.Wish, now time.Time, lang string) string {
+	key := "needed_by"
+	if isOverdue(wish, now) {
+		key = "needed_by_overdue"
+	}
+
+	return fmt.Sprintf(i18n.T(lang, key), i18n.FormatDate(lang, wish.Spec.NeededBy.Time))
+}
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package templates
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// RenderOptions carries server configuration that affects how templates render.
+type RenderOptions struct {
+	// ImageProxy routes wish images through the server's /img endpoint.
+	ImageProxy bool
+
+	// Stale marks content served from a cached wish list because the
+	// Kubernetes API could not be reached.
+	Stale bool
+
+	// Reservable reports whether a wish accepts another reservation. Nil
+	// falls back to the wish's own quantity check.
+	Reservable func(wish *wishlistv1alpha1.Wish) bool
+
+	// SuggestWeeks returns the weeks preselected in a wish's reserve form.
+	// Nil preselects fallbackWeeks.
+	SuggestWeeks func(wish *wishlistv1alpha1.Wish) int
+
+	// Coordinator marks the viewer as coordinator of the group gift being
+	// rendered. Only set when rendering a single wish.
+	Coordinator bool
+
+	// ConfirmReserve makes the reserve form hold a pending reservation that
+	// the giver confirms in a second step.
+	ConfirmReserve bool
+
+	// AskContact adds an optional contact field to the reserve form, for
+	// sending the giver a reservation confirmation.
+	AskContact bool
+
+	// PopularThreshold is the Status.Demand at which a wish gets the popular
+	// badge. Zero shows no badges.
+	PopularThreshold int32
+
+	// ReadOnly leaves out every form that would change a wish, for servers
+	// that refuse writes.
+	ReadOnly bool
+
+	// PollInterval is how often the wish list refreshes itself in the
+	// browser. Zero disables polling.
+	PollInterval time.Duration
+
+	// Now is the time the page is rendered at, from the server's clock. The
+	// zero value falls back to the wall clock.
+	Now time.Time
+}
+
+type renderOptionsKey struct{}
+
+// WithOptions returns a context carrying the given render options.
+func WithOptions(ctx context.Context, opts RenderOptions) context.Context {
+	return context.WithValue(ctx, renderOptionsKey{}, opts)
+}
+
+// optionsFrom returns the render options stored in ctx, or the zero value.
+func optionsFrom(ctx context.Context) RenderOptions {
+	opts, _ := ctx.Value(renderOptionsKey{}).(RenderOptions)
+
+	return opts
+}
+
+// renderNow returns the time the page is rendered at.
+func renderNow(ctx context.Context) time.Time {
+	if now := optionsFrom(ctx).Now; !now.IsZero() {
+		return now
+	}
+
+	return time.Now()
+}
+
+// isStale reports whether the page is rendered from cached data.
+func isStale(ctx context.Context) bool {
+	return optionsFrom(ctx).Stale
+}
+
+// reserveAction is where the reserve form posts, asking for a pending
+// reservation first when confirmation is enabled.
+func reserveAction(ctx context.Context, wish *wishlistv1alpha1.Wish, lang string) string {
+	action := fmt.Sprintf("/wishes/%s/reserve?lang=%s", wish.Name, lang)
+	if optionsFrom(ctx).ConfirmReserve {
+		action += "&confirm=false"
+	}
+
+	return action
+}
+
+// askContact reports whether the reserve form asks for the giver's contact.
+func askContact(ctx context.Context) bool {
+	return optionsFrom(ctx).AskContact
+}
+
+// isCoordinator reports whether the viewer coordinates the rendered group gift.
+func isCoordinator(ctx context.Context) bool {
+	return optionsFrom(ctx).Coordinator
+}
+
+// isPopular reports whether the wish gets the popular badge.
+func isPopular(ctx context.Context, wish *wishlistv1alpha1.Wish) bool {
+	threshold := optionsFrom(ctx).PopularThreshold
+
+	return threshold > 0 && wish.Status.Demand >= threshold
+}
+
+// isReadOnly reports whether forms that change wishes are left out.
+func isReadOnly(ctx context.Context) bool {
+	return optionsFrom(ctx).ReadOnly
+}
+
+// pollSeconds returns the list's poll interval in whole seconds, at least
+// one, or zero when polling is off.
+func pollSeconds(ctx context.Context) int {
+	interval := optionsFrom(ctx).PollInterval
+	if interval <= 0 {
+		return 0
+	}
+
+	return max(int(interval/time.Second), 1)
+}
+
+// listURL is where the list partial for the active tag is fetched from.
+func listURL(activeTag, lang string) string {
+	if activeTag == "" {
+		return "/wishes?lang=" + lang
+	}
+
+	return "/wishes?tag=" + url.QueryEscape(activeTag) + "&lang=" + lang
+}
+
+// pollTrigger is the hx-trigger that refreshes the list every seconds.
+func pollTrigger(seconds int) string {
+	return "every " + strconv.Itoa(seconds) + "s"
+}
+
+// fallbackWeeks is preselected in the reserve form when no suggestion is
+// configured.
+const fallbackWeeks = 4
+
+// suggestedWeeks returns the weeks preselected when reserving the wish.
+func suggestedWeeks(ctx context.Context, wish *wishlistv1alpha1.Wish) int {
+	if suggest := optionsFrom(ctx).SuggestWeeks; suggest != nil {
+		return suggest(wish)
+	}
+
+	return fallbackWeeks
+}
+
+// canReserve reports whether the reserve form should be shown for the wish.
+func canReserve(ctx context.Context, wish *wishlistv1alpha1.Wish) bool {
+	if reservable := optionsFrom(ctx).Reservable; reservable != nil {
+		return reservable(wish)
+	}
+
+	return wish.IsUnlimited() || wish.AvailableQuantity() > 0
+}
+
+// imageSrc returns the src attribute for a wish image, going through the
+// image proxy when it is enabled.
+func imageSrc(ctx context.Context, imageURL string) string {
+	if !optionsFrom(ctx).ImageProxy {
+		return imageURL
+	}
+
+	return "/img?url=" + url.QueryEscape(imageURL)
+}
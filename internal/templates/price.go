@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package templates
+
+import (
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// PriceText returns the price to display for a wish. A structured price wins
+// over MSRP: both bounds give a range ("₽1500–₽2500"), a single bound or
+// equal bounds give one amount, and Approximate adds a leading tilde.
+func PriceText(spec *wishlistv1alpha1.WishSpec) string {
+	var text string
+
+	switch {
+	case spec.PriceMin != nil && spec.PriceMax != nil && *spec.PriceMin != *spec.PriceMax:
+		text = formatAmount(spec.Currency, *spec.PriceMin) + "–" + formatAmount(spec.Currency, *spec.PriceMax)
+	case spec.PriceMin != nil:
+		text = formatAmount(spec.Currency, *spec.PriceMin)
+	case spec.PriceMax != nil:
+		text = formatAmount(spec.Currency, *spec.PriceMax)
+	default:
+		return spec.MSRP
+	}
+
+	if spec.Approximate {
+		text = "~" + text
+	}
+
+	return text
+}
+
+// formatAmount prefixes amount with the currency. Symbols are attached
+// directly ("$10"); alphabetic codes are separated by a space ("RUB 10").
+func formatAmount(currency string, amount int64) string {
+	value := strconv.FormatInt(amount, 10)
+	if currency == "" {
+		return value
+	}
+
+	if last, _ := utf8.DecodeLastRuneInString(currency); unicode.IsLetter(last) {
+		return currency + " " + value
+	}
+
+	return currency + value
+}
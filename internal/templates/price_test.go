@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package templates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func amount(v int64) *int64 {
+	return &v
+}
+
+func TestPriceText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		spec wishlistv1alpha1.WishSpec
+		want string
+	}{
+		{"msrp only", wishlistv1alpha1.WishSpec{MSRP: "$150"}, "$150"},
+		{"nothing", wishlistv1alpha1.WishSpec{}, ""},
+		{
+			"range",
+			wishlistv1alpha1.WishSpec{PriceMin: amount(1500), PriceMax: amount(2500), Currency: "₽"},
+			"₽1500–₽2500",
+		},
+		{
+			"approximate single",
+			wishlistv1alpha1.WishSpec{PriceMin: amount(2000), Currency: "₽", Approximate: true},
+			"~₽2000",
+		},
+		{
+			"approximate range",
+			wishlistv1alpha1.WishSpec{PriceMin: amount(10), PriceMax: amount(20), Currency: "$", Approximate: true},
+			"~$10–$20",
+		},
+		{
+			"equal bounds collapse",
+			wishlistv1alpha1.WishSpec{PriceMin: amount(99), PriceMax: amount(99), Currency: "€"},
+			"€99",
+		},
+		{"max only", wishlistv1alpha1.WishSpec{PriceMax: amount(500), Currency: "$"}, "$500"},
+		{"currency code", wishlistv1alpha1.WishSpec{PriceMin: amount(1500), Currency: "RUB"}, "RUB 1500"},
+		{"no currency", wishlistv1alpha1.WishSpec{PriceMin: amount(42)}, "42"},
+		{
+			"structured wins over msrp",
+			wishlistv1alpha1.WishSpec{MSRP: "$150", PriceMin: amount(140), PriceMax: amount(160), Currency: "$"},
+			"$140–$160",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, PriceText(&tt.spec))
+		})
+	}
+}
+
+func TestWishCard_PriceRange(t *testing.T) {
+	t.Parallel()
+
+	wish := hostileWish()
+	wish.Spec.PriceMin = amount(1500)
+	wish.Spec.PriceMax = amount(2500)
+	wish.Spec.Currency = "₽"
+	wish.Spec.Approximate = true
+
+	html := render(t, WishCard(wish, "en"))
+
+	assert.Contains(t, html, `<div class="price">~₽1500–₽2500</div>`)
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package templates
+
+import (
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// wishGroup is a run of wishes rendered together: the wishes of one set, or a
+// single wish outside any set.
+type wishGroup struct {
+	Set    string
+	Wishes []wishlistv1alpha1.Wish
+}
+
+// groupSets keeps the list order but gathers each set's wishes at the
+// position of its first wish.
+func groupSets(wishes []wishlistv1alpha1.Wish) []wishGroup {
+	groups := make([]wishGroup, 0, len(wishes))
+	setIndex := make(map[string]int)
+
+	for i := range wishes {
+		set := wishes[i].Spec.PartOfSet
+		if set == "" {
+			groups = append(groups, wishGroup{Wishes: wishes[i : i+1]})
+
+			continue
+		}
+
+		if idx, ok := setIndex[set]; ok {
+			groups[idx].Wishes = append(groups[idx].Wishes, wishes[i])
+
+			continue
+		}
+
+		setIndex[set] = len(groups)
+		groups = append(groups, wishGroup{Set: set, Wishes: []wishlistv1alpha1.Wish{wishes[i]}})
+	}
+
+	return groups
+}
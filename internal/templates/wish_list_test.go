@@ -0,0 +1,393 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package templates
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+func TestWishContent_FilteredEmptyState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		lang string
+		want string
+	}{
+		{lang: "en", want: "No wishes with tag &#39;electronics&#39;."},
+		{lang: "ru", want: "Нет желаний с тегом &#39;electronics&#39;."},
+		{lang: "zh", want: "没有带有标签 &#39;electronics&#39; 的愿望。"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			t.Parallel()
+
+			html := render(t, WishContent(nil, []string{"books", "electronics"}, "electronics", tt.lang))
+			assert.Contains(t, html, tt.want)
+		})
+	}
+}
+
+func TestWishContent_UnfilteredEmptyState(t *testing.T) {
+	t.Parallel()
+
+	html := render(t, WishContent(nil, nil, "", "en"))
+
+	assert.Contains(t, html, "No wishes yet")
+	assert.NotContains(t, html, "No wishes with tag")
+}
+
+func TestEmptyFilteredText_MultipleTags(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "No wishes with tag 'electronics', 'books'.", emptyFilteredText("en", "electronics", "books"))
+}
+
+func setWish(name, set string) wishlistv1alpha1.Wish {
+	return wishlistv1alpha1.Wish{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       wishlistv1alpha1.WishSpec{Title: name, PartOfSet: set},
+		Status:     wishlistv1alpha1.WishStatus{Active: true},
+	}
+}
+
+func TestGroupSets(t *testing.T) {
+	t.Parallel()
+
+	groups := groupSets([]wishlistv1alpha1.Wish{
+		setWish("tent", "camping"),
+		setWish("book", ""),
+		setWish("stove", "camping"),
+		setWish("lamp", "desk"),
+	})
+
+	require.Len(t, groups, 3)
+	assert.Equal(t, "camping", groups[0].Set)
+	assert.Equal(t, "tent", groups[0].Wishes[0].Name)
+	assert.Equal(t, "stove", groups[0].Wishes[1].Name)
+	assert.Empty(t, groups[1].Set)
+	assert.Equal(t, "book", groups[1].Wishes[0].Name)
+	assert.Equal(t, "desk", groups[2].Set)
+	assert.Len(t, groups[2].Wishes, 1)
+}
+
+func TestWishContent_RendersSetsTogether(t *testing.T) {
+	t.Parallel()
+
+	html := render(t, WishContent([]wishlistv1alpha1.Wish{
+		setWish("tent", "camping"),
+		setWish("book", ""),
+		setWish("stove", "camping"),
+	}, nil, "", "en"))
+
+	assert.Equal(t, 1, strings.Count(html, `class="wish-set"`))
+	assert.Contains(t, html, "Set “camping”: these wishes go together")
+
+	set := html[strings.Index(html, `class="wish-set"`):strings.Index(html, "</section>")]
+	assert.Contains(t, set, "tent")
+	assert.Contains(t, set, "stove")
+	assert.NotContains(t, set, "book")
+}
+
+func TestWishContent_ShowsReservationsInGrace(t *testing.T) {
+	t.Parallel()
+
+	wish := setWish("lamp", "")
+	wish.Spec.Quantity = 3
+	wish.Status.Reservations = []wishlistv1alpha1.Reservation{
+		{Quantity: 1, ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour))},
+		{Quantity: 2, ExpiresAt: metav1.NewTime(time.Now().Add(-time.Minute))},
+	}
+
+	html := render(t, WishContent([]wishlistv1alpha1.Wish{wish}, nil, "", "en"))
+
+	assert.Contains(t, html, "1 item reserved until")
+	assert.Contains(t, html, "Reservation of 2 expired")
+	assert.Contains(t, html, "reservation-expiring")
+}
+
+func TestWishContent_ShowsPriceCheckedAt(t *testing.T) {
+	t.Parallel()
+
+	wish := setWish("lamp", "")
+	wish.Spec.MSRP = "$40"
+
+	assert.NotContains(t, render(t, WishContent([]wishlistv1alpha1.Wish{wish}, nil, "", "en")), "price-checked")
+
+	checked := metav1.NewTime(time.Date(2025, time.March, 14, 12, 0, 0, 0, time.UTC))
+	wish.Status.PriceCheckedAt = &checked
+
+	html := render(t, WishContent([]wishlistv1alpha1.Wish{wish}, nil, "", "en"))
+
+	assert.Contains(t, html, "price-checked")
+	assert.Contains(t, html, "price as of "+i18n.FormatDate("en", checked.Time))
+}
+
+func TestReserveSuccess_Localized(t *testing.T) {
+	t.Parallel()
+
+	expires := time.Date(2025, time.June, 2, 12, 0, 0, 0, time.UTC)
+
+	wish := setWish("lamp", "")
+	wish.Status.Reservations = []wishlistv1alpha1.Reservation{
+		{Quantity: 1, ExpiresAt: metav1.NewTime(expires.Add(-7 * 24 * time.Hour))},
+		{Quantity: 1, ExpiresAt: metav1.NewTime(expires)},
+	}
+
+	tests := []struct {
+		lang string
+		want string
+	}{
+		{"en", "Reserved until %s, thank you!"},
+		{"ru", "Забронировано до %s, спасибо!"},
+		{"zh", "已预订至 %s，谢谢！"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			t.Parallel()
+
+			html := render(t, ReserveSuccess(&wish, false, tt.lang))
+
+			assert.Contains(t, html, `id="wish-lamp"`)
+			assert.Contains(t, html, fmt.Sprintf(tt.want, i18n.FormatDate(tt.lang, expires)))
+		})
+	}
+}
+
+func TestReserveSuccess_Shortened(t *testing.T) {
+	t.Parallel()
+
+	expires := time.Date(2025, time.June, 2, 12, 0, 0, 0, time.UTC)
+
+	wish := setWish("lamp", "")
+	wish.Status.Reservations = []wishlistv1alpha1.Reservation{
+		{Quantity: 1, ExpiresAt: metav1.NewTime(expires)},
+	}
+
+	html := render(t, ReserveSuccess(&wish, true, "en"))
+
+	assert.Contains(t, html, "Reserved until "+i18n.FormatDate("en", expires)+", when this wish ends")
+}
+
+func TestWishContent_ShowsNeededBy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		neededIn  time.Duration
+		wantText  string
+		wantClass string
+	}{
+		{"far ahead", 30 * 24 * time.Hour, "Needed by", `class="needed-by"`},
+		{"due soon", 2 * 24 * time.Hour, "Needed by", `class="needed-by needed-soon"`},
+		{"overdue", -24 * time.Hour, "Was needed by", `class="needed-by needed-soon overdue"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := setWish("lamp", "")
+			wish.Spec.NeededBy = &metav1.Time{Time: time.Now().Add(tt.neededIn)}
+
+			html := render(t, WishContent([]wishlistv1alpha1.Wish{wish}, nil, "", "en"))
+
+			assert.Contains(t, html, tt.wantClass)
+			assert.Contains(t, html, tt.wantText+" "+i18n.FormatDate("en", wish.Spec.NeededBy.Time))
+		})
+	}
+
+	assert.NotContains(t, render(t, WishContent([]wishlistv1alpha1.Wish{setWish("plain", "")}, nil, "", "en")), "needed-by")
+}
+
+func TestWishContent_LocalizesOccasions(t *testing.T) {
+	t.Parallel()
+
+	wish := setWish("lamp", "")
+	wish.Spec.ContextTags = []string{"birthday", "hanami"}
+
+	for lang, want := range map[string]string{
+		"en": "Birthday",
+		"ru": "День рождения",
+		"zh": "生日", //nolint:gosmopolitan // Chinese label is non-ASCII by design
+	} {
+		html := render(t, WishContent([]wishlistv1alpha1.Wish{wish}, nil, "", lang))
+
+		assert.Contains(t, html, `<span class="tag context-tag">`+want+`</span>`, lang)
+		assert.Contains(t, html, `<span class="tag context-tag">hanami</span>`, lang)
+	}
+}
+
+func TestWishCard_ReserveDurationUnits(t *testing.T) {
+	t.Parallel()
+
+	wish := setWish("lamp", "")
+
+	tests := []struct {
+		lang  string
+		units []string
+		days  []string
+	}{
+		{"en", []string{"in weeks", "in days"}, []string{"1 day", "2 days", "56 days"}},
+		{"ru", []string{"в неделях", "в днях"}, []string{"1 день", "3 дня", "5 дней", "21 день", "22 дня"}},
+		{"zh", []string{"按周", "按天"}, []string{"1 天", "56 天"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			t.Parallel()
+
+			html := render(t, WishCard(&wish, tt.lang))
+
+			assert.Contains(t, html, `<select name="unit" required><option value="weeks" selected>`+tt.units[0]+`</option>`)
+			assert.Contains(t, html, `<option value="days">`+tt.units[1]+`</option>`)
+			assert.Contains(t, html, `<select name="days" required>`)
+			assert.Contains(t, html, `<option value="28" selected>`, "the suggested four weeks in days")
+
+			for _, days := range tt.days {
+				assert.Contains(t, html, ">"+days+"</option>")
+			}
+		})
+	}
+}
+
+func TestWishCard_PopularBadge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		threshold int32
+		demand    int32
+		want      bool
+	}{
+		{name: "disabled", threshold: 0, demand: 10, want: false},
+		{name: "below threshold", threshold: 3, demand: 2, want: false},
+		{name: "at threshold", threshold: 3, demand: 3, want: true},
+		{name: "above threshold", threshold: 3, demand: 7, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := setWish("lamp", "")
+			wish.Status.Demand = tt.demand
+
+			ctx := WithOptions(context.Background(), RenderOptions{PopularThreshold: tt.threshold})
+
+			var buf bytes.Buffer
+			require.NoError(t, WishCard(&wish, "ru").Render(ctx, &buf))
+
+			if tt.want {
+				assert.Contains(t, buf.String(), `<div class="popular-badge">Популярное</div>`)
+			} else {
+				assert.NotContains(t, buf.String(), "popular-badge")
+			}
+		})
+	}
+}
+
+func TestListPoller(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		interval  time.Duration
+		activeTag string
+		want      []string
+	}{
+		{name: "disabled", interval: 0},
+		{
+			name:     "all wishes",
+			interval: 45 * time.Second,
+			want: []string{
+				`data-poll-interval="45"`,
+				`hx-get="/wishes?lang=en"`,
+				`hx-trigger="every 45s"`,
+				`hx-target="#wish-content"`,
+			},
+		},
+		{
+			name:      "keeps the tag filter",
+			interval:  2 * time.Minute,
+			activeTag: "board games",
+			want: []string{
+				`data-poll-interval="120"`,
+				`hx-get="/wishes?tag=board+games&amp;lang=en"`,
+				`hx-trigger="every 120s"`,
+			},
+		},
+		{
+			name:     "rounds up to a second",
+			interval: 300 * time.Millisecond,
+			want:     []string{`data-poll-interval="1"`, `hx-trigger="every 1s"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := WithOptions(context.Background(), RenderOptions{PollInterval: tt.interval})
+
+			var buf bytes.Buffer
+			require.NoError(t, ListPoller(tt.activeTag, "en").Render(ctx, &buf))
+
+			if len(tt.want) == 0 {
+				assert.Empty(t, buf.String())
+			}
+
+			for _, want := range tt.want {
+				assert.Contains(t, buf.String(), want)
+			}
+		})
+	}
+}
+
+func TestWishLinks(t *testing.T) {
+	t.Parallel()
+
+	wish := setWish("lamp", "")
+	assert.Empty(t, render(t, WishLinks(&wish, "en")), "no links, no section")
+
+	wish.Spec.PurchaseURLs = []string{"https://shop.example.com/lamp"}
+	wish.Spec.Links = []wishlistv1alpha1.Link{
+		{Label: "Review", URL: "https://reviews.example.com/lamp"},
+		{URL: "https://www.specs.example.org/lamp.pdf"},
+		{Label: "  ", URL: "http://manuals.example.net/lamp"},
+	}
+
+	html := render(t, WishLinks(&wish, "ru"))
+
+	assert.Contains(t, html, "См. также")
+	assert.Contains(t, html, `<a href="https://reviews.example.com/lamp" target="_blank" rel="noopener">Review</a>`)
+	assert.Contains(t, html, `>specs.example.org</a>`)
+	assert.Contains(t, html, `>manuals.example.net</a>`)
+	assert.NotContains(t, html, "shop.example.com", "purchase URLs are listed apart")
+
+	page := render(t, WishPage(&wish, "en"))
+	assert.Contains(t, page, `class="wish-links"`)
+	assert.Contains(t, page, ">Review</a>")
+}
+
+func TestWishContent_StarsWithoutPriority(t *testing.T) {
+	t.Parallel()
+
+	html := render(t, WishContent([]wishlistv1alpha1.Wish{setWish("lamp", "")}, nil, "", "en"))
+
+	assert.Contains(t, html, "☆☆☆☆☆")
+}
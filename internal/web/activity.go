@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/templates"
+)
+
+const (
+	defaultActivityLimit = 20
+	maxActivityLimit     = 100
+)
+
+// activityPage is the JSON body of GET /admin/activity. NextOffset is the
+// offset of the following page and is omitted on the last one.
+type activityPage struct {
+	Events     []templates.ActivityEvent `json:"events"`
+	NextOffset int                       `json:"nextOffset,omitempty"`
+}
+
+// handleAdminActivity returns recent reservation events across all wishes,
+// newest first, paginated with limit and offset query parameters.
+func (s *Server) handleAdminActivity(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	limit, offset, ok := parsePagination(r)
+	if !ok {
+		http.Error(w, i18n.T(lang, "err_invalid_pagination"), http.StatusBadRequest)
+
+		return
+	}
+
+	wishList := &wishlistv1alpha1.WishList{}
+	if err := s.reader.List(r.Context(), wishList, client.InNamespace(s.namespaceFor(r.Context()))); err != nil {
+		http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
+
+		return
+	}
+
+	events := collectActivity(wishList.Items, s.clock.Now())
+
+	page := activityPage{Events: events[min(offset, len(events)):min(offset+limit, len(events))]}
+	if offset+limit < len(events) {
+		page.NextOffset = offset + limit
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+
+	if wantsJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if err := json.NewEncoder(w).Encode(page); err != nil {
+			http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	var nextURL string
+	if page.NextOffset > 0 {
+		nextURL = fmt.Sprintf("/admin/activity?limit=%d&offset=%d", limit, page.NextOffset)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	if err := templates.Activity(page.Events, nextURL, lang).Render(r.Context(), w); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+
+		return
+	}
+}
+
+// parsePagination reads the limit and offset query parameters, applying the
+// default limit and capping it at maxActivityLimit.
+func parsePagination(r *http.Request) (int, int, bool) {
+	limit, offset := defaultActivityLimit, 0
+
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return 0, 0, false
+		}
+
+		limit = min(n, maxActivityLimit)
+	}
+
+	if v := r.URL.Query().Get("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return 0, 0, false
+		}
+
+		offset = n
+	}
+
+	return limit, offset, true
+}
+
+// collectActivity derives reservation events from the wishes' current
+// reservations: one reserve event per reservation and an expire event for
+// those already past their expiry. Reservations the controller has already
+// cleaned up or that were released leave no trace and do not appear, and
+// pending reservations are left out until confirmed. Events are sorted
+// newest first.
+func collectActivity(wishes []wishlistv1alpha1.Wish, now time.Time) []templates.ActivityEvent {
+	events := make([]templates.ActivityEvent, 0)
+
+	for i := range wishes {
+		wish := &wishes[i]
+
+		for _, res := range wish.Status.Reservations {
+			if res.IsPending() {
+				continue
+			}
+
+			event := templates.ActivityEvent{
+				Wish:     wish.Name,
+				Title:    wish.Spec.Title,
+				Quantity: res.Quantity,
+			}
+
+			if !res.CreatedAt.IsZero() {
+				event.Type, event.At = templates.ActivityReserve, res.CreatedAt.Time
+				events = append(events, event)
+			}
+
+			if !res.ExpiresAt.IsZero() && !res.ExpiresAt.Time.After(now) {
+				event.Type, event.At = templates.ActivityExpire, res.ExpiresAt.Time
+				events = append(events, event)
+			}
+		}
+	}
+
+	slices.SortStableFunc(events, func(a, b templates.ActivityEvent) int {
+		return cmp.Or(b.At.Compare(a.At), cmp.Compare(a.Wish, b.Wish))
+	})
+
+	return events
+}
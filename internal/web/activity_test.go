@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/templates"
+)
+
+// newActivityWish returns a wish with one reservation per creation offset,
+// each created that long ago and expiring after ttl.
+func newActivityWish(name string, ttl time.Duration, ago ...time.Duration) *wishlistv1alpha1.Wish {
+	wish := newSummaryWish(name, 0, 0)
+	wish.Spec.Title = name + " title"
+
+	for _, offset := range ago {
+		created := time.Now().Add(-offset)
+		wish.Status.Reservations = append(wish.Status.Reservations, wishlistv1alpha1.Reservation{
+			Quantity:  1,
+			CreatedAt: metav1.NewTime(created),
+			ExpiresAt: metav1.NewTime(created.Add(ttl)),
+		})
+	}
+
+	return wish
+}
+
+func decodeActivity(t *testing.T, srv *Server, path string) activityPage {
+	t.Helper()
+
+	rec := adminRequest(t, srv, path, testAdminToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var page activityPage
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+
+	return page
+}
+
+func TestServer_HandleAdminActivity_MergesWishesNewestFirst(t *testing.T) {
+	t.Parallel()
+
+	week := 7 * 24 * time.Hour
+	srv := newTestServer(t,
+		newActivityWish("alpha", week, time.Hour, 3*time.Hour),
+		newActivityWish("beta", week, 2*time.Hour),
+		// Created 10 days ago with a 7-day hold: reserved, then expired 3 days ago.
+		newActivityWish("gamma", week, 10*24*time.Hour),
+	)
+	WithAdminToken(testAdminToken)(srv)
+
+	page := decodeActivity(t, srv, "/admin/activity?format=json")
+
+	type entry struct{ wish, kind string }
+
+	got := make([]entry, 0, len(page.Events))
+	for _, event := range page.Events {
+		got = append(got, entry{event.Wish, event.Type})
+	}
+
+	assert.Equal(t, []entry{
+		{"alpha", templates.ActivityReserve},
+		{"beta", templates.ActivityReserve},
+		{"alpha", templates.ActivityReserve},
+		{"gamma", templates.ActivityExpire},
+		{"gamma", templates.ActivityReserve},
+	}, got)
+	assert.Zero(t, page.NextOffset)
+}
+
+func TestServer_HandleAdminActivity_Paginates(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t,
+		newActivityWish("alpha", 24*time.Hour, time.Hour, 3*time.Hour),
+		newActivityWish("beta", 24*time.Hour, 2*time.Hour),
+	)
+	WithAdminToken(testAdminToken)(srv)
+
+	first := decodeActivity(t, srv, "/admin/activity?format=json&limit=2")
+	require.Len(t, first.Events, 2)
+	assert.Equal(t, 2, first.NextOffset)
+	assert.Equal(t, []string{"alpha", "beta"}, []string{first.Events[0].Wish, first.Events[1].Wish})
+
+	second := decodeActivity(t, srv, "/admin/activity?format=json&limit=2&offset=2")
+	require.Len(t, second.Events, 1)
+	assert.Equal(t, "alpha", second.Events[0].Wish)
+	assert.Zero(t, second.NextOffset)
+
+	beyond := decodeActivity(t, srv, "/admin/activity?format=json&offset=10")
+	assert.Empty(t, beyond.Events)
+	assert.NotNil(t, beyond.Events)
+}
+
+func TestServer_HandleAdminActivity_HTMLPartial(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newActivityWish("alpha", 24*time.Hour, time.Hour, 2*time.Hour))
+	WithAdminToken(testAdminToken)(srv)
+
+	rec := adminRequest(t, srv, "/admin/activity?limit=1", testAdminToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+
+	body := rec.Body.String()
+	assert.Contains(t, body, "1 × alpha title reserved")
+	assert.Contains(t, body, `href="/admin/activity?limit=1&amp;offset=1"`)
+	assert.NotContains(t, body, "<html")
+}
+
+func TestServer_HandleAdminActivity_Errors(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithAdminToken(testAdminToken)(srv)
+
+	assert.Equal(t, http.StatusUnauthorized, adminRequest(t, srv, "/admin/activity", "").Code)
+
+	for _, query := range []string{"limit=0", "limit=abc", "offset=-1"} {
+		rec := adminRequest(t, srv, "/admin/activity?"+query, testAdminToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// adminSummary is the JSON body of GET /admin/summary. Active wishes are
+// counted as reserved when anyone holds part of them and as available while
+// any quantity is left, so a partially reserved wish counts towards both.
+// Received wishes count only as received.
+type adminSummary struct {
+	Total     int `json:"total"`
+	Active    int `json:"active"`
+	Reserved  int `json:"reserved"`
+	Available int `json:"available"`
+	Expired   int `json:"expired"`
+	Received  int `json:"received"`
+}
+
+// adminWishDetail is the JSON body of GET /admin/wishes/{name}. Unlike public
+// views it includes full reservation detail, such as giver notes.
+type adminWishDetail struct {
+	Name   string                      `json:"name"`
+	Spec   wishlistv1alpha1.WishSpec   `json:"spec"`
+	Status wishlistv1alpha1.WishStatus `json:"status"`
+}
+
+// requireAdmin lets a request through only if it carries the configured admin
+// token as a bearer token.
+func (s *Server) requireAdmin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !s.isAdmin(r) {
+			lang := i18n.DetectLanguage(r)
+			w.Header().Set("WWW-Authenticate", `Bearer realm="wish-operator"`)
+			http.Error(w, i18n.T(lang, "err_unauthorized"), http.StatusUnauthorized)
+
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// isAdmin reports whether the request carries the configured admin token as
+// a bearer token. It is false whenever admin endpoints are disabled.
+func (s *Server) isAdmin(r *http.Request) bool {
+	if s.adminToken == "" {
+		return false
+	}
+
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	return found && subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) == 1
+}
+
+// handleAdminSummary reports how many wishes are active, reserved, available
+// and expired, including reservation state the public view may hide.
+func (s *Server) handleAdminSummary(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	wishList := &wishlistv1alpha1.WishList{}
+	if err := s.reader.List(r.Context(), wishList, client.InNamespace(s.namespaceFor(r.Context()))); err != nil {
+		http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if err := json.NewEncoder(w).Encode(summarizeWishes(wishList.Items, s.clock.Now())); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+
+		return
+	}
+}
+
+// handleAdminWish returns a single wish with its full reservation detail.
+func (s *Server) handleAdminWish(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+	name := r.PathValue("name")
+
+	wish := &wishlistv1alpha1.Wish{}
+	if err := s.client.Get(r.Context(), s.objectKey(r, name), wish); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, i18n.T(lang, "err_get_wish"), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if err := json.NewEncoder(w).Encode(adminWishDetail{
+		Name:   wish.Name,
+		Spec:   wish.Spec,
+		Status: wish.Status,
+	}); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+
+		return
+	}
+}
+
+// summarizeWishes counts wishes by state as of now.
+func summarizeWishes(wishes []wishlistv1alpha1.Wish, now time.Time) adminSummary {
+	summary := adminSummary{Total: len(wishes)}
+
+	for i := range wishes {
+		wish := &wishes[i]
+
+		switch {
+		case wish.Status.Received:
+			summary.Received++
+		case wish.IsExpiredAt(now):
+			summary.Expired++
+		case wish.Status.Active:
+			summary.Active++
+
+			if wish.TotalReserved() > 0 {
+				summary.Reserved++
+			}
+
+			if !wish.IsFullyReserved() {
+				summary.Available++
+			}
+		}
+	}
+
+	return summary
+}
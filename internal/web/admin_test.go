@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+const testAdminToken = "s3cret-admin-token"
+
+func newSummaryWish(name string, quantity, reserved int32) *wishlistv1alpha1.Wish {
+	wish := &wishlistv1alpha1.Wish{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         testNamespace,
+			CreationTimestamp: metav1.NewTime(time.Now()),
+		},
+		Spec: wishlistv1alpha1.WishSpec{
+			Title:    testTitleGift,
+			Quantity: quantity,
+		},
+		Status: wishlistv1alpha1.WishStatus{
+			Active: true,
+		},
+	}
+
+	if reserved > 0 {
+		wish.Status.Reservations = []wishlistv1alpha1.Reservation{{
+			Quantity:  reserved,
+			ExpiresAt: metav1.NewTime(time.Now().Add(7 * 24 * time.Hour)),
+		}}
+	}
+
+	return wish
+}
+
+func adminRequest(t *testing.T, srv *Server, path, token string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandleAdminSummary_Counts(t *testing.T) {
+	t.Parallel()
+
+	expired := newArchiveWish("expired", testNamespace, "Expired Gift", 10*24*time.Hour, 24*time.Hour)
+	foreign := newSummaryWish("foreign", 1, 0)
+	foreign.Namespace = "other"
+
+	srv := newTestServer(t,
+		newSummaryWish("free", 1, 0),
+		newSummaryWish("partial", 3, 1),
+		newSummaryWish("full", 2, 2),
+		newSummaryWish("unlimited", 0, 4),
+		expired,
+		foreign,
+	)
+	WithAdminToken(testAdminToken)(srv)
+
+	rec := adminRequest(t, srv, "/admin/summary", testAdminToken)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var summary adminSummary
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &summary))
+
+	assert.Equal(t, adminSummary{
+		Total:     5,
+		Active:    4,
+		Reserved:  3, // partial, full, unlimited
+		Available: 3, // free, partial, unlimited
+		Expired:   1,
+	}, summary)
+}
+
+func TestServer_HandleAdminSummary_RequiresToken(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithAdminToken(testAdminToken)(srv)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "missing", token: ""},
+		{name: "wrong", token: "not-the-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := adminRequest(t, srv, "/admin/summary", tt.token)
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+			assert.NotEmpty(t, rec.Header().Get("WWW-Authenticate"))
+		})
+	}
+}
+
+func TestServer_HandleAdminSummary_DisabledWithoutToken(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	// Without an admin token the route is not registered and falls through
+	// to the index page.
+	rec := adminRequest(t, srv, "/admin/summary", "")
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+}
+
+func TestServer_HandleAdminWish_IncludesNotes(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("noted"))
+	WithAdminToken(testAdminToken)(srv)
+
+	require.Equal(t, http.StatusOK, reserveWithNote(srv.Handler(), "noted", "blue one please").Code)
+
+	rec := adminRequest(t, srv, "/admin/wishes/noted", testAdminToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var detail adminWishDetail
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &detail))
+	require.Len(t, detail.Status.Reservations, 1)
+	assert.Equal(t, "blue one please", detail.Status.Reservations[0].Note)
+
+	assert.Equal(t, http.StatusUnauthorized, adminRequest(t, srv, "/admin/wishes/noted", "").Code)
+	assert.Equal(t, http.StatusNotFound, adminRequest(t, srv, "/admin/wishes/missing", testAdminToken).Code)
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"unicode/utf8"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// maxReservedForLength bounds the name an owner reserves on behalf of, as
+// the CRD does.
+const maxReservedForLength = 100
+
+// handleAdminReserve reserves a wish on behalf of the giver named in the
+// reservedFor form field, for owners who hear by phone that someone will buy
+// it. It reads quantity, weeks or days, and note like the reserve form, but
+// skips the checks meant for anonymous givers: tag policies, the per-giver
+// limit, confirmation and group gift coordination. The wish must still have
+// enough left unless it is unlimited or allows multiple reservations, and the
+// reservation ends with the wish's TTL at the latest. The reservation is
+// marked AdminCreated and carries no token, so only the controller or the
+// owner can release it.
+func (s *Server) handleAdminReserve(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	if !s.parseForm(w, r, lang) {
+		return
+	}
+
+	reservedFor, ok := sanitizeNote(r.FormValue("reservedFor"))
+	if !ok || reservedFor == "" || utf8.RuneCountInString(reservedFor) > maxReservedForLength {
+		http.Error(w, fmt.Sprintf(i18n.T(lang, "err_reserved_for"), maxReservedForLength), http.StatusBadRequest)
+
+		return
+	}
+
+	duration, errMsg := parseReservationDuration(r, lang)
+	if errMsg != "" {
+		http.Error(w, errMsg, http.StatusBadRequest)
+
+		return
+	}
+
+	quantity, ok := parseReserveQuantity(r)
+	if !ok {
+		http.Error(w, i18n.T(lang, "err_invalid_quantity"), http.StatusBadRequest)
+
+		return
+	}
+
+	note, ok := sanitizeNote(r.FormValue("note"))
+	if !ok {
+		http.Error(w, fmt.Sprintf(i18n.T(lang, "err_note_length"), maxNoteLength), http.StatusBadRequest)
+
+		return
+	}
+
+	wish := &wishlistv1alpha1.Wish{}
+	if err := s.client.Get(r.Context(), s.objectKey(r, r.PathValue("name")), wish); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, i18n.T(lang, "err_get_wish"), http.StatusInternalServerError)
+
+		return
+	}
+
+	if wish.Status.Received {
+		http.Error(w, i18n.T(lang, "err_received"), http.StatusConflict)
+
+		return
+	}
+
+	now := s.clock.Now()
+	if wish.IsExpiredAt(now) {
+		http.Error(w, i18n.T(lang, "err_wish_expired"), http.StatusConflict)
+
+		return
+	}
+
+	if available := wish.AvailableQuantity(); !wish.IsUnlimited() && !wish.Spec.AllowMultipleReservations &&
+		quantity > available {
+		if available == 0 {
+			http.Error(w, i18n.T(lang, "err_fully_reserved"), http.StatusConflict)
+
+			return
+		}
+
+		http.Error(w, fmt.Sprintf(i18n.T(lang, "err_quantity_exceeds"), available), http.StatusBadRequest)
+
+		return
+	}
+
+	expires, _ := wish.CapReservationExpiry(now.Add(duration))
+
+	wish.Status.Reservations = append(wish.Status.Reservations, wishlistv1alpha1.Reservation{
+		Quantity:     quantity,
+		CreatedAt:    metav1.NewTime(now),
+		ExpiresAt:    metav1.NewTime(expires),
+		Note:         note,
+		ReservedFor:  reservedFor,
+		AdminCreated: true,
+	})
+
+	if err := s.client.Status().Update(r.Context(), wish); err != nil {
+		http.Error(w, i18n.T(lang, "err_reserve_failed"), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(adminWishDetail{
+		Name:   wish.Name,
+		Spec:   wish.Spec,
+		Status: wish.Status,
+	}); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func adminReserve(srv *Server, name, token string, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/admin/wishes/"+name+"/reserve", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandleAdminReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+
+	srv := newTestServer(t, newSummaryWish("lamp", 3, 1))
+	WithAdminToken(testAdminToken)(srv)
+	WithClock(clocktesting.NewFakePassiveClock(now))(srv)
+
+	rec := adminReserve(srv, "lamp", testAdminToken, url.Values{
+		"reservedFor": {"Aunt Maria"},
+		"weeks":       {"3"},
+		"quantity":    {"2"},
+		"note":        {"called on Sunday"},
+	})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), `"reservedFor":"Aunt Maria"`)
+
+	wish := getFundWish(t, srv, "lamp")
+	require.Len(t, wish.Status.Reservations, 2)
+
+	res := wish.Status.Reservations[1]
+	assert.Equal(t, int32(2), res.Quantity)
+	assert.Equal(t, "Aunt Maria", res.ReservedFor)
+	assert.True(t, res.AdminCreated)
+	assert.Equal(t, "called on Sunday", res.Note)
+	assert.Empty(t, res.TokenHash)
+	assert.True(t, now.Equal(res.CreatedAt.Time))
+	assert.True(t, now.Add(3*7*24*time.Hour).Equal(res.ExpiresAt.Time))
+	assert.True(t, wish.IsFullyReserved())
+
+	page := getPath(srv.Handler(), "/wishes/lamp").Body.String()
+	assert.NotContains(t, page, "Aunt Maria", "kept off public pages")
+	assert.NotContains(t, getPath(srv.Handler(), "/wishes/lamp?format=json").Body.String(), "Aunt Maria")
+}
+
+func TestServer_HandleAdminReserve_ClampedToTTL(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+
+	wish := newSummaryWish("lamp", 1, 0)
+	wish.CreationTimestamp = metav1.NewTime(now.Add(-5 * 24 * time.Hour))
+	wish.Spec.TTL = &metav1.Duration{Duration: 10 * 24 * time.Hour}
+
+	srv := newTestServer(t, wish)
+	WithAdminToken(testAdminToken)(srv)
+	WithClock(clocktesting.NewFakePassiveClock(now))(srv)
+
+	form := url.Values{"reservedFor": {"Aunt Maria"}, "weeks": {"4"}}
+	require.Equal(t, http.StatusCreated, adminReserve(srv, "lamp", testAdminToken, form).Code)
+
+	reservations := getFundWish(t, srv, "lamp").Status.Reservations
+	require.Len(t, reservations, 1)
+	assert.True(t, now.Add(5*24*time.Hour).Equal(reservations[0].ExpiresAt.Time), "expires at %s", reservations[0].ExpiresAt)
+}
+
+func TestServer_HandleAdminReserve_SkipsGiverChecks(t *testing.T) {
+	t.Parallel()
+
+	single := newSummaryWish("experience", 5, 1)
+	single.Spec.Tags = []string{"experience"}
+
+	srv := newTestServer(t, single)
+	WithAdminToken(testAdminToken)(srv)
+	WithTagPolicies(map[string]ReservationPolicy{"experience": PolicySingle})(srv)
+	WithReserverLimit(1)(srv)
+
+	form := url.Values{"reservedFor": {"Grandpa"}, "weeks": {"1"}}
+	require.Equal(t, http.StatusCreated, adminReserve(srv, "experience", testAdminToken, form).Code)
+	require.Equal(t, http.StatusCreated, adminReserve(srv, "experience", testAdminToken, form).Code)
+
+	assert.Len(t, getFundWish(t, srv, "experience").Status.Reservations, 3)
+}
+
+func TestServer_HandleAdminReserve_Rejects(t *testing.T) {
+	t.Parallel()
+
+	received := newSummaryWish("received", 1, 0)
+	received.Status.Received = true
+
+	expired := newSummaryWish("expired", 1, 0)
+	expired.CreationTimestamp = metav1.NewTime(time.Now().Add(-48 * time.Hour))
+	expired.Spec.TTL = &metav1.Duration{Duration: 24 * time.Hour}
+
+	srv := newTestServer(t, newSummaryWish("lamp", 2, 1), newSummaryWish("taken", 1, 1), received, expired)
+	WithAdminToken(testAdminToken)(srv)
+
+	valid := url.Values{"reservedFor": {"Aunt Maria"}, "weeks": {"2"}}
+
+	tests := []struct {
+		name  string
+		wish  string
+		token string
+		form  url.Values
+		want  int
+	}{
+		{name: "missing token", wish: "lamp", form: valid, want: http.StatusUnauthorized},
+		{name: "missing name", wish: "lamp", token: testAdminToken, form: url.Values{"weeks": {"2"}}, want: http.StatusBadRequest},
+		{
+			name:  "long name",
+			wish:  "lamp",
+			token: testAdminToken,
+			form:  url.Values{"reservedFor": {strings.Repeat("a", maxReservedForLength+1)}, "weeks": {"2"}},
+			want:  http.StatusBadRequest,
+		},
+		{name: "bad weeks", wish: "lamp", token: testAdminToken, form: url.Values{"reservedFor": {"Maria"}, "weeks": {"9"}}, want: http.StatusBadRequest},
+		{
+			name:  "too many",
+			wish:  "lamp",
+			token: testAdminToken,
+			form:  url.Values{"reservedFor": {"Maria"}, "weeks": {"2"}, "quantity": {"2"}},
+			want:  http.StatusBadRequest,
+		},
+		{name: "fully reserved", wish: "taken", token: testAdminToken, form: valid, want: http.StatusConflict},
+		{name: "received", wish: "received", token: testAdminToken, form: valid, want: http.StatusConflict},
+		{name: "expired", wish: "expired", token: testAdminToken, form: valid, want: http.StatusConflict},
+		{name: "unknown wish", wish: "missing", token: testAdminToken, form: valid, want: http.StatusNotFound},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, adminReserve(srv, tc.wish, tc.token, tc.form).Code)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/templates"
+)
+
+// archiveItem is the JSON representation of an expired wish.
+type archiveItem struct {
+	Name        string    `json:"name"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	MSRP        string    `json:"msrp,omitempty"`
+	ImageURL    string    `json:"imageURL,omitempty"`
+	Tags        []string  `json:"tags,omitempty"`
+	ContextTags []string  `json:"contextTags,omitempty"`
+	Priority    int32     `json:"priority,omitempty"`
+	ExpiredAt   time.Time `json:"expiredAt"`
+}
+
+// handleArchive lists wishes whose TTL has passed, most recently expired
+// first. It answers with JSON when asked via ?format=json or the Accept
+// header, and with an HTML page otherwise.
+func (s *Server) handleArchive(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	wishes, stale, err := s.listExpiredWishes(r.Context())
+	if err != nil {
+		http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
+
+		return
+	}
+
+	s.redactWishes(wishes)
+	ctx := s.listContext(r.Context(), w, stale)
+
+	if wantsJSON(r) {
+		items := make([]archiveItem, 0, len(wishes))
+
+		for i := range wishes {
+			wish := &wishes[i]
+			expiredAt, _ := wish.ExpirationTime()
+			items = append(items, archiveItem{
+				Name:        wish.Name,
+				Title:       wish.Spec.Title,
+				Description: wish.Spec.Description,
+				MSRP:        wish.Spec.MSRP,
+				ImageURL:    wish.Spec.ImageURL,
+				Tags:        wish.Spec.Tags,
+				ContextTags: wish.Spec.ContextTags,
+				Priority:    wish.Spec.Priority,
+				ExpiredAt:   expiredAt,
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(items)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	if err := templates.Archive(wishes, lang).Render(ctx, w); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) listExpiredWishes(ctx context.Context) ([]wishlistv1alpha1.Wish, bool, error) {
+	items, stale, err := s.listAllWishes(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	now := s.clock.Now()
+
+	expired := make([]wishlistv1alpha1.Wish, 0, len(items))
+
+	for i := range items {
+		if items[i].IsExpiredAt(now) && !items[i].Spec.Unlisted {
+			expired = append(expired, items[i])
+		}
+	}
+
+	// Most recently expired first
+	sort.SliceStable(expired, func(i, j int) bool {
+		ei, _ := expired[i].ExpirationTime()
+		ej, _ := expired[j].ExpirationTime()
+
+		return ei.After(ej)
+	})
+
+	return expired, stale, nil
+}
+
+// wantsJSON reports whether the client asked for a JSON response.
+func wantsJSON(r *http.Request) bool {
+	if r.URL.Query().Get("format") == "json" {
+		return true
+	}
+
+	return strings.Contains(r.Header.Get("Accept"), "application/json")
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func newArchiveWish(name, namespace, title string, age, ttl time.Duration) *wishlistv1alpha1.Wish {
+	return &wishlistv1alpha1.Wish{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+		},
+		Spec: wishlistv1alpha1.WishSpec{
+			Title: title,
+			TTL:   &metav1.Duration{Duration: ttl},
+		},
+		Status: wishlistv1alpha1.WishStatus{
+			Active: age < ttl,
+		},
+	}
+}
+
+func archiveFixtures() []*wishlistv1alpha1.Wish {
+	return []*wishlistv1alpha1.Wish{
+		newArchiveWish("active", testNamespace, "Active Gift", time.Hour, 24*time.Hour),
+		newArchiveWish("old", testNamespace, "Old Gift", 400*24*time.Hour, 30*24*time.Hour),
+		newArchiveWish("recent", testNamespace, "Recent Gift", 10*24*time.Hour, 24*time.Hour),
+		newArchiveWish("elsewhere", "other", "Foreign Gift", 10*24*time.Hour, 24*time.Hour),
+	}
+}
+
+func TestServer_HandleArchive_JSON(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, archiveFixtures()...)
+
+	req := httptest.NewRequest(http.MethodGet, "/wishes/archive", nil)
+	req.Header.Set("Accept", "application/json")
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var items []archiveItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &items))
+
+	// Active and other-namespace wishes are excluded; most recently expired first.
+	require.Len(t, items, 2)
+	assert.Equal(t, "recent", items[0].Name)
+	assert.Equal(t, "old", items[1].Name)
+	assert.True(t, items[0].ExpiredAt.After(items[1].ExpiredAt))
+}
+
+func TestServer_HandleArchive_HTML(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, archiveFixtures()...)
+
+	req := httptest.NewRequest(http.MethodGet, "/wishes/archive?lang=ru", nil)
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+
+	body := rec.Body.String()
+	assert.Contains(t, body, "Архив")
+	assert.Contains(t, body, "Recent Gift")
+	assert.Contains(t, body, "Old Gift")
+	assert.NotContains(t, body, "Active Gift")
+	assert.NotContains(t, body, "Foreign Gift")
+	assert.NotContains(t, body, `class="reserve-form"`)
+}
+
+func TestServer_HandleArchive_Empty(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/wishes/archive?format=json", nil)
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, "[]", rec.Body.String())
+}
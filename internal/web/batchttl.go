@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// ttlBatchResult is the JSON body of POST /admin/wishes/ttl. Skipped wishes
+// already had the requested expiry or, when adjusting, have no TTL to
+// adjust. Failed lists the wishes whose update was rejected.
+type ttlBatchResult struct {
+	Updated int      `json:"updated"`
+	Skipped int      `json:"skipped"`
+	Failed  []string `json:"failed,omitempty"`
+}
+
+// expiryFunc returns the new expiry of a wish, or false to leave it alone.
+type expiryFunc func(wish *wishlistv1alpha1.Wish) (time.Time, bool)
+
+// parseBatchExpiry reads the single expiry field of a batch TTL request:
+// expiresAt (RFC 3339) or expiresIn (Go duration) give every wish the same
+// expiry, adjust (Go duration, possibly negative) shifts each wish's own. A
+// shift into the past expires the wish now. It reports false unless exactly
+// one field is set to a value within maxExtension of now.
+func parseBatchExpiry(r *http.Request, now time.Time) (expiryFunc, bool) {
+	expiresAt, expiresIn, adjust := r.FormValue("expiresAt"), r.FormValue("expiresIn"), r.FormValue("adjust")
+
+	set := 0
+
+	for _, value := range []string{expiresAt, expiresIn, adjust} {
+		if value != "" {
+			set++
+		}
+	}
+
+	if set != 1 {
+		return nil, false
+	}
+
+	switch {
+	case expiresAt != "":
+		at, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil || !at.After(now) || at.Sub(now) > maxExtension {
+			return nil, false
+		}
+
+		return func(*wishlistv1alpha1.Wish) (time.Time, bool) { return at, true }, true
+	case expiresIn != "":
+		d, err := time.ParseDuration(expiresIn)
+		if err != nil || d <= 0 || d > maxExtension {
+			return nil, false
+		}
+
+		at := now.Add(d)
+
+		return func(*wishlistv1alpha1.Wish) (time.Time, bool) { return at, true }, true
+	default:
+		d, err := time.ParseDuration(adjust)
+		if err != nil || d == 0 || d < -maxExtension || d > maxExtension {
+			return nil, false
+		}
+
+		return func(wish *wishlistv1alpha1.Wish) (time.Time, bool) {
+			current, ok := wish.ExpirationTime()
+			if !ok {
+				return time.Time{}, false
+			}
+
+			return maxTime(current.Add(d), now), true
+		}, true
+	}
+}
+
+// maxTime returns the later of a and b.
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
+
+// handleAdminBatchTTL sets the TTL of every wish in the namespace so that it
+// expires as the form asks (see parseBatchExpiry), e.g. to wind the whole
+// list down a week after an event. Expired wishes given a future expiry
+// become active again. A wish that fails to update does not stop the rest;
+// the response lists it and the status is 500. Retrying an adjust request
+// shifts the wishes that did update once more.
+func (s *Server) handleAdminBatchTTL(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	if !s.parseForm(w, r, lang) {
+		return
+	}
+
+	expiry, ok := parseBatchExpiry(r, s.clock.Now())
+	if !ok {
+		http.Error(w, i18n.T(lang, "err_batch_ttl_params"), http.StatusBadRequest)
+
+		return
+	}
+
+	wishList := &wishlistv1alpha1.WishList{}
+	if err := s.client.List(r.Context(), wishList, client.InNamespace(s.namespaceFor(r.Context()))); err != nil {
+		http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
+
+		return
+	}
+
+	result := ttlBatchResult{}
+
+	for i := range wishList.Items {
+		wish := &wishList.Items[i]
+
+		at, ok := expiry(wish)
+		if !ok {
+			result.Skipped++
+
+			continue
+		}
+
+		ttl := at.Sub(wish.CreationTimestamp.Time)
+		if wish.Spec.TTL != nil && wish.Spec.TTL.Duration == ttl {
+			result.Skipped++
+
+			continue
+		}
+
+		wish.Spec.TTL = &metav1.Duration{Duration: ttl}
+
+		if err := s.client.Update(r.Context(), wish); err != nil {
+			logf.FromContext(r.Context()).Error(err, "Failed to update wish TTL", "wish", wish.Name)
+			result.Failed = append(result.Failed, wish.Name)
+
+			continue
+		}
+
+		result.Updated++
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if len(result.Failed) > 0 {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+
+	_ = json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func batchTTL(srv *Server, token string, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/admin/wishes/ttl", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func decodeTTLBatch(t *testing.T, rec *httptest.ResponseRecorder) ttlBatchResult {
+	t.Helper()
+
+	var result ttlBatchResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+
+	return result
+}
+
+func requireExpiry(t *testing.T, srv *Server, name string, want time.Time) {
+	t.Helper()
+
+	expiresAt, ok := getFundWish(t, srv, name).ExpirationTime()
+	require.True(t, ok, name)
+	assert.WithinDuration(t, want, expiresAt, time.Second, name)
+}
+
+func TestServer_HandleAdminBatchTTL_SetsExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+	at := now.Add(10 * 24 * time.Hour)
+
+	tests := []struct {
+		name string
+		form url.Values
+	}{
+		{name: "absolute", form: url.Values{"expiresAt": {at.Format(time.RFC3339)}}},
+		{name: "relative to now", form: url.Values{"expiresIn": {"240h"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newTestServer(t,
+				newArchiveWish("lamp", testNamespace, "Lamp", time.Hour, 24*time.Hour),
+				newArchiveWish("book", testNamespace, "Book", 10*24*time.Hour, 24*time.Hour),
+				newSummaryWish("forever", 1, 0),
+				newArchiveWish("elsewhere", "other", "Foreign", time.Hour, 24*time.Hour),
+			)
+			WithAdminToken(testAdminToken)(srv)
+			WithClock(clocktesting.NewFakePassiveClock(now))(srv)
+
+			rec := batchTTL(srv, testAdminToken, tt.form)
+			require.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+			assert.Equal(t, ttlBatchResult{Updated: 3}, decodeTTLBatch(t, rec))
+
+			for _, name := range []string{"lamp", "book", "forever"} {
+				requireExpiry(t, srv, name, at)
+			}
+		})
+	}
+}
+
+func TestServer_HandleAdminBatchTTL_Adjust(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+
+	lamp := newArchiveWish("lamp", testNamespace, "Lamp", time.Hour, 24*time.Hour)
+	book := newArchiveWish("book", testNamespace, "Book", time.Hour, 72*time.Hour)
+
+	srv := newTestServer(t, lamp, book, newSummaryWish("forever", 1, 0))
+	WithAdminToken(testAdminToken)(srv)
+	WithClock(clocktesting.NewFakePassiveClock(now))(srv)
+
+	lampExpiry, _ := lamp.ExpirationTime()
+	bookExpiry, _ := book.ExpirationTime()
+
+	rec := batchTTL(srv, testAdminToken, url.Values{"adjust": {"-48h"}})
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, ttlBatchResult{Updated: 2, Skipped: 1}, decodeTTLBatch(t, rec))
+
+	requireExpiry(t, srv, "lamp", maxTime(lampExpiry.Add(-48*time.Hour), now))
+	requireExpiry(t, srv, "book", bookExpiry.Add(-48*time.Hour))
+	assert.Nil(t, getFundWish(t, srv, "forever").Spec.TTL)
+}
+
+func TestServer_HandleAdminBatchTTL_Rejects(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newArchiveWish("lamp", testNamespace, "Lamp", time.Hour, 24*time.Hour))
+	WithAdminToken(testAdminToken)(srv)
+
+	past := time.Now().Add(-time.Hour).Format(time.RFC3339)
+
+	tests := []struct {
+		name  string
+		token string
+		form  url.Values
+		want  int
+	}{
+		{name: "no token", form: url.Values{"expiresIn": {"24h"}}, want: http.StatusUnauthorized},
+		{name: "no mode", token: testAdminToken, form: url.Values{}, want: http.StatusBadRequest},
+		{
+			name:  "two modes",
+			token: testAdminToken,
+			form:  url.Values{"expiresIn": {"24h"}, "adjust": {"24h"}},
+			want:  http.StatusBadRequest,
+		},
+		{name: "past expiry", token: testAdminToken, form: url.Values{"expiresAt": {past}}, want: http.StatusBadRequest},
+		{name: "bad timestamp", token: testAdminToken, form: url.Values{"expiresAt": {"soon"}}, want: http.StatusBadRequest},
+		{name: "negative expiresIn", token: testAdminToken, form: url.Values{"expiresIn": {"-1h"}}, want: http.StatusBadRequest},
+		{name: "zero adjust", token: testAdminToken, form: url.Values{"adjust": {"0s"}}, want: http.StatusBadRequest},
+		{name: "too long", token: testAdminToken, form: url.Values{"adjust": {"8761h"}}, want: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, batchTTL(srv, tt.token, tt.form).Code)
+		})
+	}
+
+	assert.Equal(t, 24*time.Hour, getFundWish(t, srv, "lamp").Spec.TTL.Duration)
+}
+
+func TestServer_HandleAdminBatchTTL_PartialFailure(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, wishlistv1alpha1.AddToScheme(scheme))
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			newArchiveWish("lamp", testNamespace, "Lamp", time.Hour, 24*time.Hour),
+			newArchiveWish("book", testNamespace, "Book", time.Hour, 24*time.Hour),
+		).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				if obj.GetName() == "book" {
+					return errAPIUnavailable
+				}
+
+				return c.Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	srv := NewServer(fakeClient, testNamespace, 30, 10, WithAdminToken(testAdminToken))
+
+	rec := batchTTL(srv, testAdminToken, url.Values{"adjust": {"24h"}})
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, ttlBatchResult{Updated: 1, Failed: []string{"book"}}, decodeTTLBatch(t, rec))
+
+	assert.Equal(t, 48*time.Hour, getFundWish(t, srv, "lamp").Spec.TTL.Duration)
+	assert.Equal(t, 24*time.Hour, getFundWish(t, srv, "book").Spec.TTL.Duration)
+}
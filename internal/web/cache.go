@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// etagHashBytes is how many bytes of the SHA-256 digest go into an ETag.
+const etagHashBytes = 16
+
+// strongETag returns a quoted strong ETag derived from the given parts, e.g.
+// a source URL and its size, or an encoded payload.
+func strongETag(parts ...string) string {
+	h := sha256.New()
+
+	for _, part := range parts {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+
+	return `"` + hex.EncodeToString(h.Sum(nil)[:etagHashBytes]) + `"`
+}
+
+// setCacheHeaders marks a response as publicly cacheable for maxAge under etag.
+func setCacheHeaders(w http.ResponseWriter, etag string, maxAge time.Duration) {
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(maxAge.Seconds())))
+}
+
+// writeNotModified answers 304 Not Modified if the request's If-None-Match
+// matches etag, and reports whether it did. Cache headers should be set
+// before calling so they are included in the 304 response.
+func writeNotModified(w http.ResponseWriter, r *http.Request, etag string) bool {
+	if !etagMatches(r.Header.Get("If-None-Match"), etag) {
+		return false
+	}
+
+	w.WriteHeader(http.StatusNotModified)
+
+	return true
+}
+
+// etagMatches implements the weak comparison If-None-Match requires.
+func etagMatches(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+
+	for candidate := range strings.SplitSeq(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func cachedHandler(payload string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		etag := strongETag(payload)
+		setCacheHeaders(w, etag, time.Hour)
+
+		if writeNotModified(w, r, etag) {
+			return
+		}
+
+		_, _ = w.Write([]byte(payload))
+	})
+}
+
+func TestCache_NotModified(t *testing.T) {
+	t.Parallel()
+
+	handler := cachedHandler("payload-v1")
+
+	first := httptest.NewRecorder()
+	handler.ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, "public, max-age=3600", first.Header().Get("Cache-Control"))
+
+	etag := first.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("If-None-Match", etag)
+
+	hit := httptest.NewRecorder()
+	handler.ServeHTTP(hit, req)
+
+	assert.Equal(t, http.StatusNotModified, hit.Code)
+	assert.Empty(t, hit.Body.String())
+	assert.Equal(t, etag, hit.Header().Get("ETag"))
+}
+
+func TestCache_ChangedPayload(t *testing.T) {
+	t.Parallel()
+
+	oldETag := strongETag("payload-v1")
+	newETag := strongETag("payload-v2")
+
+	assert.NotEqual(t, oldETag, newETag)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("If-None-Match", oldETag)
+
+	rec := httptest.NewRecorder()
+	cachedHandler("payload-v2").ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, newETag, rec.Header().Get("ETag"))
+	assert.Equal(t, "payload-v2", rec.Body.String())
+}
+
+func TestETagMatches(t *testing.T) {
+	t.Parallel()
+
+	etag := strongETag("https://example.com/a.png", "1024")
+
+	tests := []struct {
+		name   string
+		header string
+		want   bool
+	}{
+		{"empty", "", false},
+		{"exact", etag, true},
+		{"weak form", "W/" + etag, true},
+		{"in list", `"other", ` + etag, true},
+		{"wildcard", "*", true},
+		{"different", `"other"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, etagMatches(tt.header, etag))
+		})
+	}
+
+	// Parts are delimited, so shifting bytes between them changes the tag.
+	assert.NotEqual(t, strongETag("ab", "c"), strongETag("a", "bc"))
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// cleanupResult is the JSON body of POST /admin/reservations/cleanup:
+// how many reservations were cleared from how many wishes, and the wishes
+// whose update was rejected.
+type cleanupResult struct {
+	Cleared int      `json:"cleared"`
+	Wishes  int      `json:"wishes"`
+	Failed  []string `json:"failed,omitempty"`
+}
+
+// clearExpiredReservations drops the wish's expired reservations and
+// pending ones never confirmed in time, recording them in its history as
+// the controller does, and returns how many it dropped.
+func clearExpiredReservations(wish *wishlistv1alpha1.Wish, now time.Time) int {
+	kept := make([]wishlistv1alpha1.Reservation, 0, len(wish.Status.Reservations))
+
+	for _, res := range wish.Status.Reservations {
+		reason := ""
+
+		switch {
+		case res.IsPending() && !res.PendingUntil.After(now):
+			reason = wishlistv1alpha1.HistoryReasonUnconfirmed
+		case !res.ExpiresAt.After(now):
+			reason = wishlistv1alpha1.HistoryReasonExpired
+		default:
+			kept = append(kept, res)
+
+			continue
+		}
+
+		wish.Status.History = append(wish.Status.History, wishlistv1alpha1.ReservationRecord{
+			Quantity:  res.Quantity,
+			CreatedAt: res.CreatedAt,
+			EndedAt:   metav1.NewTime(now),
+			Reason:    reason,
+		})
+	}
+
+	cleared := len(wish.Status.Reservations) - len(kept)
+	if cleared > 0 {
+		wish.Status.Reservations = kept
+	}
+
+	return cleared
+}
+
+// handleAdminCleanup clears expired reservations from every wish in the
+// namespace right away, rather than when the controller next reconciles
+// each wish. Reservations the controller is still holding through its grace
+// period are cleared too. A wish that fails to update does not stop the
+// rest; the response lists it and the status is 500.
+func (s *Server) handleAdminCleanup(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	wishList := &wishlistv1alpha1.WishList{}
+	if err := s.client.List(r.Context(), wishList, client.InNamespace(s.namespaceFor(r.Context()))); err != nil {
+		http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
+
+		return
+	}
+
+	now := s.clock.Now()
+	result := cleanupResult{}
+
+	for i := range wishList.Items {
+		wish := &wishList.Items[i]
+
+		cleared := clearExpiredReservations(wish, now)
+		if cleared == 0 {
+			continue
+		}
+
+		if err := s.client.Status().Update(r.Context(), wish); err != nil {
+			logf.FromContext(r.Context()).Error(err, "Failed to clear expired reservations", "wish", wish.Name)
+			result.Failed = append(result.Failed, wish.Name)
+
+			continue
+		}
+
+		result.Cleared += cleared
+		result.Wishes++
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if len(result.Failed) > 0 {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+
+	_ = json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func cleanupReservations(srv *Server, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/admin/reservations/cleanup", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandleAdminCleanup(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+	expired := wishlistv1alpha1.Reservation{Quantity: 1, ExpiresAt: metav1.NewTime(now.Add(-time.Minute))}
+	active := wishlistv1alpha1.Reservation{Quantity: 1, ExpiresAt: metav1.NewTime(now.Add(time.Hour))}
+	lapsed := metav1.NewTime(now.Add(-time.Second))
+	unconfirmed := wishlistv1alpha1.Reservation{
+		Quantity:     1,
+		ExpiresAt:    metav1.NewTime(now.Add(time.Hour)),
+		PendingUntil: &lapsed,
+	}
+
+	mixed := newSummaryWish("mixed", 3, 0)
+	mixed.Status.Reservations = []wishlistv1alpha1.Reservation{expired, active, unconfirmed}
+
+	stale := newSummaryWish("stale", 2, 0)
+	stale.Status.Reservations = []wishlistv1alpha1.Reservation{expired, expired}
+
+	fresh := newSummaryWish("fresh", 1, 0)
+	fresh.Status.Reservations = []wishlistv1alpha1.Reservation{active}
+
+	srv := newTestServer(t, mixed, stale, fresh, newSummaryWish("free", 1, 0))
+	WithAdminToken(testAdminToken)(srv)
+	WithClock(clocktesting.NewFakePassiveClock(now))(srv)
+
+	assert.Equal(t, http.StatusUnauthorized, cleanupReservations(srv, "").Code)
+
+	rec := cleanupReservations(srv, testAdminToken)
+	assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, cleanupResult{Cleared: 4, Wishes: 2}, decodeCleanup(t, rec))
+
+	got := getFundWish(t, srv, "mixed")
+	require.Len(t, got.Status.Reservations, 1)
+	assert.True(t, got.Status.Reservations[0].ExpiresAt.Equal(&active.ExpiresAt))
+	require.Len(t, got.Status.History, 2)
+	assert.Equal(t, wishlistv1alpha1.HistoryReasonExpired, got.Status.History[0].Reason)
+	assert.Equal(t, wishlistv1alpha1.HistoryReasonUnconfirmed, got.Status.History[1].Reason)
+
+	assert.Empty(t, getFundWish(t, srv, "stale").Status.Reservations)
+	assert.Len(t, getFundWish(t, srv, "fresh").Status.Reservations, 1)
+
+	assert.Equal(t, cleanupResult{}, decodeCleanup(t, cleanupReservations(srv, testAdminToken)),
+		"nothing left to clear")
+}
+
+func TestServer_HandleAdminCleanup_PartialFailure(t *testing.T) {
+	t.Parallel()
+
+	expired := []wishlistv1alpha1.Reservation{{Quantity: 1, ExpiresAt: metav1.NewTime(time.Now().Add(-time.Minute))}}
+
+	lamp := newSummaryWish("lamp", 1, 0)
+	lamp.Status.Reservations = expired
+	book := newSummaryWish("book", 1, 0)
+	book.Status.Reservations = expired
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, wishlistv1alpha1.AddToScheme(scheme))
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(lamp, book).
+		WithStatusSubresource(&wishlistv1alpha1.Wish{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(
+				ctx context.Context, c client.Client, sub string, obj client.Object, opts ...client.SubResourceUpdateOption,
+			) error {
+				if obj.GetName() == "book" {
+					return errAPIUnavailable
+				}
+
+				return c.SubResource(sub).Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	srv := NewServer(fakeClient, testNamespace, 30, 10, WithAdminToken(testAdminToken))
+
+	rec := cleanupReservations(srv, testAdminToken)
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var result cleanupResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, cleanupResult{Cleared: 1, Wishes: 1, Failed: []string{"book"}}, result)
+
+	assert.Empty(t, getFundWish(t, srv, "lamp").Status.Reservations)
+	assert.Len(t, getFundWish(t, srv, "book").Status.Reservations, 1)
+}
+
+func decodeCleanup(t *testing.T, rec *httptest.ResponseRecorder) cleanupResult {
+	t.Helper()
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var result cleanupResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+
+	return result
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/templates"
+)
+
+// Bounds on how many wishes the comparison view shows side by side.
+const (
+	minCompared = 2
+	maxCompared = 4
+)
+
+// parseCompareNames splits the comma-separated names parameter, reporting
+// false unless it holds minCompared to maxCompared distinct names.
+func parseCompareNames(value string) ([]string, bool) {
+	var names []string
+
+	for name := range strings.SplitSeq(value, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+
+		if slices.Contains(names, name) || len(names) == maxCompared {
+			return nil, false
+		}
+
+		names = append(names, name)
+	}
+
+	return names, len(names) >= minCompared
+}
+
+// handleCompare shows the wishes in the names query parameter side by side,
+// in the order given. Like permalinks, it reaches any wish by name.
+func (s *Server) handleCompare(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	names, ok := parseCompareNames(r.URL.Query().Get("names"))
+	if !ok {
+		http.Error(w, fmt.Sprintf(i18n.T(lang, "err_compare_count"), minCompared, maxCompared), http.StatusBadRequest)
+
+		return
+	}
+
+	wishes := make([]wishlistv1alpha1.Wish, len(names))
+
+	for i, name := range names {
+		if err := s.client.Get(r.Context(), s.objectKey(r, name), &wishes[i]); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				http.Error(w, fmt.Sprintf(i18n.T(lang, "err_compare_missing"), name), http.StatusNotFound)
+
+				return
+			}
+
+			http.Error(w, i18n.T(lang, "err_get_wish"), http.StatusInternalServerError)
+
+			return
+		}
+	}
+
+	s.delayListReservations(r, wishes)
+	s.redactWishes(wishes)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	canonical := i18n.LocalizedPath(lang, "/wishes/compare?names="+url.QueryEscape(strings.Join(names, ",")))
+	if err := templates.ComparePage(wishes, canonical, lang).Render(s.renderContext(r.Context()), w); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func compareFixtures() []*wishlistv1alpha1.Wish {
+	sony := newSummaryWish("sony", 1, 0)
+	sony.Spec.Title = "Sony Headphones"
+	sony.Spec.MSRP = "$350"
+	sony.Spec.Priority = 4
+	sony.Spec.Tags = []string{"audio", "travel"}
+	sony.Spec.Description = "Noise cancelling"
+
+	bose := newSummaryWish("bose", 1, 0)
+	bose.Spec.Title = "Bose Headphones"
+	bose.Spec.Description = "Lighter"
+
+	return []*wishlistv1alpha1.Wish{sony, bose, newSummaryWish("lamp", 1, 0)}
+}
+
+func TestServer_HandleCompare(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, compareFixtures()...)
+
+	rec := getPath(srv.Handler(), "/wishes/compare?names=bose,+sony")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	assert.Less(t, strings.Index(body, "Bose Headphones"), strings.Index(body, "Sony Headphones"))
+	assert.Contains(t, body, "$350")
+	assert.Contains(t, body, "★★★★☆")
+	assert.Contains(t, body, "audio, travel")
+	assert.Contains(t, body, "Noise cancelling")
+	assert.Contains(t, body, "Lighter")
+	assert.NotContains(t, body, testTitleGift)
+}
+
+func TestServer_HandleCompare_HidesPrices(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, compareFixtures()...)
+	WithHiddenPrices()(srv)
+
+	rec := getPath(srv.Handler(), "/wishes/compare?names=sony,bose")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "$350")
+}
+
+func TestServer_HandleCompare_Rejects(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, compareFixtures()...)
+	handler := srv.Handler()
+
+	tests := []struct {
+		name string
+		path string
+		code int
+		want string
+	}{
+		{name: "missing wish", path: "/wishes/compare?names=sony,nope", code: http.StatusNotFound, want: `Wish "nope" not found`},
+		{name: "missing wish localized", path: "/ru/wishes/compare?names=sony,nope", code: http.StatusNotFound, want: `Желание "nope" не найдено`},
+		{name: "no names", path: "/wishes/compare", code: http.StatusBadRequest, want: "between 2 and 4"},
+		{name: "single wish", path: "/wishes/compare?names=sony", code: http.StatusBadRequest, want: "between 2 and 4"},
+		{name: "duplicate", path: "/wishes/compare?names=sony,sony", code: http.StatusBadRequest, want: "between 2 and 4"},
+		{name: "too many", path: "/wishes/compare?names=a,b,c,d,e", code: http.StatusBadRequest, want: "between 2 and 4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := getPath(handler, tt.path)
+
+			assert.Equal(t, tt.code, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.want)
+		})
+	}
+}
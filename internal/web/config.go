@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/netip"
+
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// adminConfig is the JSON body of GET /admin/config: the server's effective
+// configuration for troubleshooting a deployment. Secrets such as the admin
+// token are left out; optional integrations only report whether they are on,
+// since their settings (e.g. a webhook URL) may embed credentials. Durations
+// are in Go duration syntax, with "0s" meaning disabled.
+type adminConfig struct {
+	Namespace string  `json:"namespace"`
+	RateLimit float64 `json:"rateLimit"`
+	RateBurst int     `json:"rateBurst"`
+
+	RateLimitExempt []netip.Prefix `json:"rateLimitExempt,omitempty"`
+	TrustedProxies  []netip.Prefix `json:"trustedProxies,omitempty"`
+
+	HostNamespaces     map[string]string `json:"hostNamespaces,omitempty"`
+	RejectUnknownHosts bool              `json:"rejectUnknownHosts"`
+
+	MinWeeks int `json:"minWeeks"`
+	MaxWeeks int `json:"maxWeeks"`
+	MinDays  int `json:"minDays"`
+	MaxDays  int `json:"maxDays"`
+
+	MaxTitleLength       int      `json:"maxTitleLength"`
+	MaxDescriptionLength int      `json:"maxDescriptionLength"`
+	AllowedURLDomains    []string `json:"allowedURLDomains,omitempty"`
+
+	Notifications  bool   `json:"notifications"`
+	ImageProxy     bool   `json:"imageProxy"`
+	CustomFavicon  bool   `json:"customFavicon"`
+	ViewPassword   bool   `json:"viewPassword"`
+	StaleCacheAge  string `json:"staleCacheMaxAge"`
+	MaxRequestBody int64  `json:"maxRequestBody"`
+	RequestTimeout string `json:"requestTimeout"`
+
+	TagPolicies     map[string]ReservationPolicy `json:"tagPolicies,omitempty"`
+	PriorityWeeks   map[int32]int                `json:"priorityWeeks,omitempty"`
+	ListMinPriority int32                        `json:"minPriority"`
+	MaxListed       int                          `json:"maxListedWishes"`
+	WholeSets       bool                         `json:"wholeSets"`
+	HidePrices      bool                         `json:"hidePrices"`
+	ReserverLimit   int                          `json:"maxReservationsPerGiver"`
+	PopularAt       int32                        `json:"popularThreshold"`
+	ReadOnly        bool                         `json:"readOnly"`
+	CSVAdminOnly    bool                         `json:"csvAdminOnly"`
+	PollInterval    string                       `json:"pollInterval"`
+	ReserveDelay    string                       `json:"publicReservationDelay"`
+	ConfirmReserve  bool                         `json:"reserveConfirm"`
+	PendingTTL      string                       `json:"reserveConfirmTTL"`
+}
+
+// effectiveConfig reports the server's configuration without secrets.
+func (s *Server) effectiveConfig() adminConfig {
+	return adminConfig{
+		Namespace:            s.namespace,
+		RateLimit:            s.rateLimit,
+		RateBurst:            s.rateBurst,
+		RateLimitExempt:      s.rateLimitExempt,
+		TrustedProxies:       s.trustedProxies,
+		HostNamespaces:       s.hostNamespaces,
+		RejectUnknownHosts:   s.rejectUnknownHosts,
+		MinWeeks:             minWeeks,
+		MaxWeeks:             maxWeeks,
+		MinDays:              minDays,
+		MaxDays:              maxDays,
+		MaxTitleLength:       s.validation.Lengths.MaxTitle,
+		MaxDescriptionLength: s.validation.Lengths.MaxDescription,
+		AllowedURLDomains:    s.validation.AllowedURLDomains,
+		Notifications:        s.notifier != nil,
+		ImageProxy:           s.imageClient != nil,
+		CustomFavicon:        s.faviconPath != "",
+		ViewPassword:         s.viewPassword != "",
+		StaleCacheAge:        s.staleMaxAge.String(),
+		MaxRequestBody:       s.maxRequestBody,
+		RequestTimeout:       s.requestTimeout.String(),
+		TagPolicies:          s.tagPolicies,
+		PriorityWeeks:        s.priorityWeeks,
+		ListMinPriority:      s.listMinPriority,
+		MaxListed:            s.maxListed,
+		WholeSets:            s.wholeSets,
+		HidePrices:           s.hidePrices,
+		ReserverLimit:        s.reserverLimit,
+		PopularAt:            s.popularThreshold,
+		ReadOnly:             s.readOnly,
+		CSVAdminOnly:         s.csvAdminOnly,
+		PollInterval:         s.pollInterval.String(),
+		ReserveDelay:         s.reservationDelay.String(),
+		ConfirmReserve:       s.confirmReserve,
+		PendingTTL:           s.pendingTTL.String(),
+	}
+}
+
+// handleAdminConfig returns the effective server configuration.
+func (s *Server) handleAdminConfig(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if err := json.NewEncoder(w).Encode(s.effectiveConfig()); err != nil {
+		http.Error(w, i18n.T(i18n.DetectLanguage(r), "err_render"), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func TestServer_HandleAdminConfig(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithAdminToken(testAdminToken)(srv)
+	WithNotifier(&fakeNotifier{})(srv)
+	WithTagPolicies(map[string]ReservationPolicy{"experience": PolicySingle})(srv)
+	WithWholeSets()(srv)
+	WithReserveConfirmation(10 * time.Minute)(srv)
+	WithPopularThreshold(3)(srv)
+	WithPollInterval(30 * time.Second)(srv)
+	WithReservationDelay(time.Hour)(srv)
+	WithHostNamespaces(map[string]string{"alice.example.com": "alice"})(srv)
+	WithAdminOnlyCSV()(srv)
+	WithTrustedProxies([]netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")})(srv)
+	WithLengthLimits(wishlistv1alpha1.LengthLimits{MaxTitle: 80, MaxDescription: 500})(srv)
+	WithAllowedURLDomains([]string{"ozon.ru"})(srv)
+
+	assert.Equal(t, http.StatusUnauthorized, adminRequest(t, srv, "/admin/config", "").Code)
+
+	rec := adminRequest(t, srv, "/admin/config", testAdminToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	assert.NotContains(t, rec.Body.String(), testAdminToken)
+
+	var config adminConfig
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &config))
+
+	assert.Equal(t, testNamespace, config.Namespace)
+	assert.Equal(t, srv.rateLimit, config.RateLimit)
+	assert.Equal(t, srv.rateBurst, config.RateBurst)
+	assert.Equal(t, maxWeeks, config.MaxWeeks)
+	assert.Equal(t, maxDays, config.MaxDays)
+	assert.True(t, config.Notifications)
+	assert.False(t, config.ImageProxy)
+	assert.Equal(t, PolicySingle, config.TagPolicies["experience"])
+	assert.True(t, config.WholeSets)
+	assert.True(t, config.ConfirmReserve)
+	assert.Equal(t, "10m0s", config.PendingTTL)
+	assert.Equal(t, int32(3), config.PopularAt)
+	assert.Equal(t, "30s", config.PollInterval)
+	assert.Equal(t, "1h0m0s", config.ReserveDelay)
+	assert.Equal(t, map[string]string{"alice.example.com": "alice"}, config.HostNamespaces)
+	assert.False(t, config.RejectUnknownHosts)
+	assert.True(t, config.CSVAdminOnly)
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, config.TrustedProxies)
+	assert.Equal(t, 80, config.MaxTitleLength)
+	assert.Equal(t, 500, config.MaxDescriptionLength)
+	assert.Equal(t, []string{"ozon.ru"}, config.AllowedURLDomains)
+}
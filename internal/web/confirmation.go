@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/notify"
+	"github.com/lexfrei/wish-operator/internal/templates"
+)
+
+// WithPublicURL sets the externally reachable base URL of the web UI, such as
+// https://wishes.example.com, used for links sent outside the site, like the
+// release link in reservation confirmations. Without it those links are
+// paths relative to the site.
+func WithPublicURL(base string) Option {
+	return func(s *Server) {
+		s.publicURL = strings.TrimSuffix(base, "/")
+	}
+}
+
+// parseContact reads the optional address a giver leaves to get a
+// reservation confirmation, normalized like a note. It reports false if the
+// address is longer than maxNoteLength runes.
+func parseContact(r *http.Request) (string, bool) {
+	return sanitizeNote(r.FormValue("contact"))
+}
+
+// unreserveLink is where the holder of token can release their reservation
+// of wish without the reserver cookie, e.g. from another device.
+func (s *Server) unreserveLink(wish *wishlistv1alpha1.Wish, token, lang string) string {
+	path := i18n.LocalizedPath(lang, "/wishes/"+url.PathEscape(wish.Name)+"/unreserve")
+
+	return s.publicURL + path + "?token=" + url.QueryEscape(token)
+}
+
+// reservationConfirmation composes the confirmation sent to contact for the
+// reservation made with the reserver token.
+func (s *Server) reservationConfirmation(
+	wish *wishlistv1alpha1.Wish, res *wishlistv1alpha1.Reservation, token, contact, lang string,
+) notify.Event {
+	link := s.unreserveLink(wish, token, lang)
+	expiresAt := res.ExpiresAt.UTC()
+
+	return notify.Event{
+		Type:      notify.EventReservationConfirmed,
+		Namespace: wish.Namespace,
+		Wish:      wish.Name,
+		Title:     wish.Spec.Title,
+		Message: fmt.Sprintf(i18n.T(lang, "reserve_confirm_msg"),
+			wish.Spec.Title, i18n.FormatDate(lang, res.ExpiresAt.Time), link),
+		Time:      s.clock.Now().UTC(),
+		Contact:   contact,
+		ExpiresAt: &expiresAt,
+		Link:      link,
+	}
+}
+
+// sendConfirmation delivers a reservation confirmation in the background, so
+// a slow or failing webhook neither delays nor fails the reservation it
+// confirms. Failures are only logged.
+func (s *Server) sendConfirmation(ctx context.Context, event notify.Event) {
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		if err := s.notifier.Send(ctx, event); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to send reservation confirmation", "wish", event.Wish)
+		}
+	}()
+}
+
+// handleUnreservePage shows the holder of the token in the query a button
+// to release their reservation of the wish. It is the target of the link in
+// reservation confirmations; the release itself is a POST, so link previews
+// in mail clients cannot trigger it.
+func (s *Server) handleUnreservePage(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	token := r.URL.Query().Get("token")
+	if token == "" || len(token) > maxReserverToken {
+		http.Error(w, i18n.T(lang, "err_no_reservation"), http.StatusForbidden)
+
+		return
+	}
+
+	wish := &wishlistv1alpha1.Wish{}
+	if err := s.client.Get(r.Context(), s.objectKey(r, r.PathValue("name")), wish); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, i18n.T(lang, "err_get_wish"), http.StatusInternalServerError)
+
+		return
+	}
+
+	if wish.HeldQuantity(hashToken(token)) == 0 {
+		http.Error(w, i18n.T(lang, "err_no_reservation"), http.StatusForbidden)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+
+	if err := templates.UnreservePage(wish, token, lang).Render(r.Context(), w); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/wish-operator/internal/notify"
+)
+
+const testConfirmToken = "confirm-token"
+
+func reserveWithContact(handler http.Handler, name, contact string) *httptest.ResponseRecorder {
+	form := url.Values{"weeks": {"2"}, "contact": {contact}}
+
+	req := httptest.NewRequest(http.MethodPost, "/wishes/"+name+"/reserve", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.AddCookie(&http.Cookie{Name: reserverCookie, Value: testConfirmToken})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+// sentEvents waits for n events to reach the notifier, as confirmations are
+// sent in the background.
+func sentEvents(t *testing.T, notifier *fakeNotifier, n int) []notify.Event {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		notifier.mu.Lock()
+		defer notifier.mu.Unlock()
+
+		return len(notifier.events) >= n
+	}, time.Second, 5*time.Millisecond)
+
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+
+	return append([]notify.Event(nil), notifier.events...)
+}
+
+func TestServer_HandleReserve_SendsConfirmation(t *testing.T) {
+	t.Parallel()
+
+	notifier := &fakeNotifier{}
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	WithNotifier(notifier)(srv)
+	WithPublicURL("https://wishes.example.com/")(srv)
+
+	rec := reserveWithContact(srv.Handler(), "lamp", " alice@example.com ")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	events := sentEvents(t, notifier, 1)
+	require.Len(t, events, 1)
+
+	event := events[0]
+	link := "https://wishes.example.com/en/wishes/lamp/unreserve?token=" + testConfirmToken
+
+	assert.Equal(t, notify.EventReservationConfirmed, event.Type)
+	assert.Equal(t, "alice@example.com", event.Contact)
+	assert.Equal(t, testTitleGift, event.Title)
+	assert.Equal(t, link, event.Link)
+	require.NotNil(t, event.ExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(2*7*24*time.Hour), *event.ExpiresAt, time.Minute)
+	assert.Contains(t, event.Message, testTitleGift)
+	assert.Contains(t, event.Message, link)
+}
+
+func TestServer_HandleReserve_NoConfirmationWithoutContact(t *testing.T) {
+	t.Parallel()
+
+	notifier := &fakeNotifier{}
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	WithNotifier(notifier)(srv)
+
+	rec := reserveWithContact(srv.Handler(), "lamp", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	assert.Never(t, func() bool {
+		notifier.mu.Lock()
+		defer notifier.mu.Unlock()
+
+		return len(notifier.events) > 0
+	}, 50*time.Millisecond, 5*time.Millisecond)
+}
+
+func TestServer_HandleReserve_ConfirmationFailureIsNonFatal(t *testing.T) {
+	t.Parallel()
+
+	notifier := &fakeNotifier{err: errors.New("webhook down")}
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	WithNotifier(notifier)(srv)
+
+	rec := reserveWithContact(srv.Handler(), "lamp", "alice@example.com")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	wish := getFundWish(t, srv, "lamp")
+	assert.Len(t, wish.Status.Reservations, 1)
+}
+
+func TestServer_HandleReserve_ContactTooLong(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	WithNotifier(&fakeNotifier{})(srv)
+
+	rec := reserveWithContact(srv.Handler(), "lamp", strings.Repeat("a", maxNoteLength+1))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Empty(t, getFundWish(t, srv, "lamp").Status.Reservations)
+}
+
+func TestServer_HandleUnreservePage(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	handler := srv.Handler()
+
+	require.Equal(t, http.StatusOK, reserveAs(handler, "lamp", testConfirmToken).Code)
+
+	tests := []struct {
+		name  string
+		token string
+		want  int
+	}{
+		{name: "holder", token: testConfirmToken, want: http.StatusOK},
+		{name: "other token", token: "someone-else", want: http.StatusForbidden},
+		{name: "no token", token: "", want: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := getPath(handler, "/wishes/lamp/unreserve?token="+url.QueryEscape(tt.token))
+
+			assert.Equal(t, tt.want, rec.Code)
+
+			if tt.want == http.StatusOK {
+				assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+				assert.Contains(t, rec.Body.String(), `value="`+testConfirmToken+`"`)
+			}
+		})
+	}
+}
+
+func TestServer_HandleUnreserve_FormToken(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	handler := srv.Handler()
+
+	require.Equal(t, http.StatusOK, reserveAs(handler, "lamp", testConfirmToken).Code)
+
+	form := url.Values{"token": {testConfirmToken}}
+	req := httptest.NewRequest(http.MethodPost, "/wishes/lamp/unreserve", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, getFundWish(t, srv, "lamp").Status.Reservations)
+}
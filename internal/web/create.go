@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// createdWishPrefix is the generateName of wishes created via the admin API.
+const createdWishPrefix = "wish-"
+
+// wishFromForm builds a wish from the admin create form: title (required),
+// description, officialURL, imageURL, priority, quantity (0 for unlimited,
+// default 1), ttl (Go duration), repeated tag fields and repeated linkURL
+// fields, each labeled by the linkLabel field in the same position. On input it cannot
+// parse it returns the reason, translated into lang, so the admin can fix the
+// form; the rules on the parsed wish are left to Wish.Validate.
+func wishFromForm(r *http.Request, lang string) (*wishlistv1alpha1.Wish, string) {
+	spec := wishlistv1alpha1.WishSpec{
+		Title:       strings.TrimSpace(r.FormValue("title")),
+		Description: strings.TrimSpace(r.FormValue("description")),
+		OfficialURL: strings.TrimSpace(r.FormValue("officialURL")),
+		ImageURL:    strings.TrimSpace(r.FormValue("imageURL")),
+		Quantity:    1,
+	}
+
+	switch {
+	case spec.Title == "":
+		return nil, i18n.T(lang, "err_title_required")
+	case !isWebURL(spec.OfficialURL) || !isWebURL(spec.ImageURL):
+		return nil, i18n.T(lang, "err_invalid_url")
+	}
+
+	if value := r.FormValue("priority"); value != "" {
+		priority, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || priority < 0 || priority > maxPriority {
+			return nil, fmt.Sprintf(i18n.T(lang, "err_priority_range"), maxPriority)
+		}
+
+		spec.Priority = int32(priority)
+	}
+
+	if value := r.FormValue("quantity"); value != "" {
+		quantity, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || quantity < 0 {
+			return nil, i18n.T(lang, "err_invalid_quantity")
+		}
+
+		spec.Quantity = int32(quantity)
+	}
+
+	if value := r.FormValue("ttl"); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl <= 0 || ttl > maxExtension {
+			return nil, i18n.T(lang, "err_ttl_invalid")
+		}
+
+		spec.TTL = &metav1.Duration{Duration: ttl}
+	}
+
+	for _, tag := range r.Form["tag"] {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			spec.Tags = append(spec.Tags, tag)
+		}
+	}
+
+	labels := r.Form["linkLabel"]
+	for i, raw := range r.Form["linkURL"] {
+		link := wishlistv1alpha1.Link{URL: strings.TrimSpace(raw)}
+		if link.URL == "" {
+			continue
+		}
+
+		if i < len(labels) {
+			link.Label = strings.TrimSpace(labels[i])
+		}
+
+		spec.Links = append(spec.Links, link)
+	}
+
+	return &wishlistv1alpha1.Wish{Spec: spec}, ""
+}
+
+// isWebURL reports whether raw is empty or an absolute http(s) URL.
+func isWebURL(raw string) bool {
+	if raw == "" {
+		return true
+	}
+
+	parsed, err := url.Parse(raw)
+
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// validationProblem explains the first of errs, as returned by Wish.Validate,
+// in lang. Errors without a message of their own name the form field, so the
+// admin still knows what to fix.
+func (s *Server) validationProblem(errs field.ErrorList, lang string) string {
+	err := errs[0]
+
+	switch {
+	case err.Field == "spec.title" && err.Type == field.ErrorTypeTooLong:
+		return fmt.Sprintf(i18n.T(lang, "err_title_length"), s.validation.Lengths.MaxTitle)
+	case err.Field == "spec.description" && err.Type == field.ErrorTypeTooLong:
+		return fmt.Sprintf(i18n.T(lang, "err_description_length"), s.validation.Lengths.MaxDescription)
+	case err.Field == "spec.officialURL" || strings.HasPrefix(err.Field, "spec.purchaseURLs"):
+		if raw, ok := err.BadValue.(string); ok {
+			if parsed, parseErr := url.Parse(raw); parseErr == nil && parsed.Hostname() != "" {
+				return fmt.Sprintf(i18n.T(lang, "err_url_domain"), parsed.Hostname())
+			}
+		}
+
+		return i18n.T(lang, "err_invalid_url")
+	case strings.HasPrefix(err.Field, "spec.links") && err.Type == field.ErrorTypeTooLong:
+		return fmt.Sprintf(i18n.T(lang, "err_link_label_length"), wishlistv1alpha1.MaxLinkLabelLength)
+	case strings.HasPrefix(err.Field, "spec.links"):
+		return i18n.T(lang, "err_invalid_url")
+	}
+
+	return fmt.Sprintf(i18n.T(lang, "err_invalid_field"), strings.TrimPrefix(err.Field, "spec."))
+}
+
+// handleAdminCreate adds a wish from the form described at wishFromForm,
+// named after createdWishPrefix, and returns it with status 201. The wish
+// must pass Wish.Validate with the server's options, the same checks the
+// validating webhook applies. Validation errors are plain text in the
+// request's language, so a family member filling in the form can act on them.
+func (s *Server) handleAdminCreate(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	if !s.parseForm(w, r, lang) {
+		return
+	}
+
+	wish, problem := wishFromForm(r, lang)
+	if problem != "" {
+		http.Error(w, problem, http.StatusBadRequest)
+
+		return
+	}
+
+	if errs := wish.Validate(s.validation); len(errs) > 0 {
+		http.Error(w, s.validationProblem(errs, lang), http.StatusBadRequest)
+
+		return
+	}
+
+	wish.GenerateName = createdWishPrefix
+	wish.Namespace = s.namespaceFor(r.Context())
+
+	if err := s.client.Create(r.Context(), wish); err != nil {
+		logf.FromContext(r.Context()).Error(err, "Failed to create wish")
+		http.Error(w, i18n.T(lang, "err_create_failed"), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+
+	_ = json.NewEncoder(w).Encode(adminWishDetail{
+		Name:   wish.Name,
+		Spec:   wish.Spec,
+		Status: wish.Status,
+	})
+}
@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func createWish(srv *Server, path string, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+testAdminToken)
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandleAdminCreate(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithAdminToken(testAdminToken)(srv)
+
+	rec := createWish(srv, "/admin/wishes", url.Values{
+		"title":       {" Headphones "},
+		"officialURL": {"https://example.com/headphones"},
+		"priority":    {"4"},
+		"quantity":    {"2"},
+		"ttl":         {"720h"},
+		"tag":         {"music", ""},
+	})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+
+	var detail adminWishDetail
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &detail))
+	assert.True(t, strings.HasPrefix(detail.Name, createdWishPrefix), detail.Name)
+
+	wish := getFundWish(t, srv, detail.Name)
+	assert.Equal(t, "Headphones", wish.Spec.Title)
+	assert.Equal(t, int32(4), wish.Spec.Priority)
+	assert.Equal(t, int32(2), wish.Spec.Quantity)
+	assert.Equal(t, 720*time.Hour, wish.Spec.TTL.Duration)
+	assert.Equal(t, []string{"music"}, wish.Spec.Tags)
+}
+
+func TestServer_HandleAdminCreate_MissingTitleLocalized(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithAdminToken(testAdminToken)(srv)
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/admin/wishes", want: "Title is required"},
+		{path: "/ru/admin/wishes", want: "Укажите название"},
+		{path: "/zh/admin/wishes", want: "请填写名称"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+
+			rec := createWish(srv, tt.path, url.Values{"title": {"  "}, "priority": {"3"}})
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Equal(t, tt.want, strings.TrimSpace(rec.Body.String()))
+		})
+	}
+}
+
+func TestServer_HandleAdminCreate_Rejects(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithAdminToken(testAdminToken)(srv)
+
+	tests := []struct {
+		name  string
+		field string
+		value string
+		want  string
+	}{
+		{name: "long title", field: "title", value: strings.Repeat("a", 201), want: "Название слишком длинное (максимум 200 символов)"},
+		{name: "long description", field: "description", value: strings.Repeat("a", 2001), want: "Описание слишком длинное"},
+		{name: "relative URL", field: "officialURL", value: "/headphones", want: "Ссылки должны быть полными адресами"},
+		{name: "script URL", field: "imageURL", value: "javascript:alert(1)", want: "Ссылки должны быть полными адресами"},
+		{name: "priority too high", field: "priority", value: "6", want: "Приоритет должен быть от 0 до 5"},
+		{name: "negative quantity", field: "quantity", value: "-1", want: "Неверное количество"},
+		{name: "zero TTL", field: "ttl", value: "0s", want: "Неверный срок жизни"},
+		{name: "TTL too long", field: "ttl", value: "8761h", want: "Неверный срок жизни"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			form := url.Values{"title": {"Headphones"}}
+			form.Set(tt.field, tt.value)
+
+			rec := createWish(srv, "/ru/admin/wishes", form)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.want)
+		})
+	}
+
+	list := &wishlistv1alpha1.WishList{}
+	require.NoError(t, srv.client.List(t.Context(), list))
+	assert.Empty(t, list.Items)
+}
+
+func TestServer_HandleAdminCreate_LengthLocalized(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithAdminToken(testAdminToken)(srv)
+	WithLengthLimits(wishlistv1alpha1.LengthLimits{MaxTitle: 5, MaxDescription: 10})(srv)
+
+	tests := []struct {
+		path  string
+		field string
+		want  string
+	}{
+		{path: "/ru/admin/wishes", field: "title", want: "Название слишком длинное (максимум 5 символов)"},
+		{path: "/zh/admin/wishes", field: "title", want: "名称过长（最多 5 个字符）"},
+		{path: "/ru/admin/wishes", field: "description", want: "Описание слишком длинное (максимум 10 символов)"},
+		{path: "/zh/admin/wishes", field: "description", want: "描述过长（最多 10 个字符）"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path+" "+tt.field, func(t *testing.T) {
+			t.Parallel()
+
+			form := url.Values{"title": {"Lamp"}}
+			form.Set(tt.field, strings.Repeat("a", 11))
+
+			rec := createWish(srv, tt.path, form)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Equal(t, tt.want, strings.TrimSpace(rec.Body.String()))
+		})
+	}
+}
+
+func TestServer_ValidationProblem_Fallback(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	errs := field.ErrorList{field.Invalid(field.NewPath("spec", "priceMin"), 3000, "must not exceed priceMax")}
+
+	assert.Equal(t, "The value of priceMin is not valid", srv.validationProblem(errs, "en"))
+	assert.Equal(t, "Недопустимое значение поля priceMin", srv.validationProblem(errs, "ru"))
+	assert.Equal(t, "priceMin 的值无效", srv.validationProblem(errs, "zh"))
+}
+
+func TestServer_HandleAdminCreate_LengthLimits(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithAdminToken(testAdminToken)(srv)
+	WithLengthLimits(wishlistv1alpha1.LengthLimits{MaxTitle: 5, MaxDescription: 10})(srv)
+
+	//nolint:gosmopolitan // CJK input is the point of the test
+	rec := createWish(srv, "/admin/wishes", url.Values{"title": {"愿望清单好"}, "description": {"желаниеabc"}})
+	require.Equal(t, http.StatusCreated, rec.Code, "at the limit, counted in runes: %s", rec.Body.String())
+
+	//nolint:gosmopolitan // CJK input is the point of the test
+	rec = createWish(srv, "/admin/wishes", url.Values{"title": {"愿望清单好吗"}})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Title is too long (max 5 characters)")
+
+	rec = createWish(srv, "/admin/wishes", url.Values{"title": {"Lamp"}, "description": {"желаниеabcd"}})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "10")
+}
+
+func TestServer_HandleAdminCreate_Links(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithAdminToken(testAdminToken)(srv)
+
+	rec := createWish(srv, "/admin/wishes", url.Values{
+		"title":     {"Lamp"},
+		"linkURL":   {"https://reviews.example.com/lamp", "", "https://example.com/specs.pdf"},
+		"linkLabel": {"Review", "", ""},
+	})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	var detail adminWishDetail
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &detail))
+	assert.Equal(t, []wishlistv1alpha1.Link{
+		{Label: "Review", URL: "https://reviews.example.com/lamp"},
+		{URL: "https://example.com/specs.pdf"},
+	}, getFundWish(t, srv, detail.Name).Spec.Links)
+
+	tests := []struct {
+		path string
+		form url.Values
+		want string
+	}{
+		{
+			path: "/ru/admin/wishes",
+			form: url.Values{"title": {"Lamp"}, "linkURL": {"ftp://example.com/specs.pdf"}},
+			want: "Ссылки должны быть полными адресами",
+		},
+		{
+			path: "/zh/admin/wishes",
+			form: url.Values{
+				"title":     {"Lamp"},
+				"linkURL":   {"https://example.com"},
+				"linkLabel": {strings.Repeat("я", wishlistv1alpha1.MaxLinkLabelLength+1)},
+			},
+			want: "链接名称过长（最多 100 个字符）",
+		},
+	}
+
+	for _, tt := range tests {
+		rec := createWish(srv, tt.path, tt.form)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), tt.want)
+	}
+}
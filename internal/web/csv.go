@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/templates"
+)
+
+// utf8BOM starts the CSV export so spreadsheet apps such as Excel read it as
+// UTF-8 rather than the system code page.
+const utf8BOM = "\ufeff"
+
+// Reservation states in the status column of the CSV export.
+const (
+	csvStatusAvailable = "available"
+	csvStatusPartial   = "partly reserved"
+	csvStatusReserved  = "reserved"
+	csvStatusFulfilled = "fulfilled"
+)
+
+// csvHeader names the columns of the CSV export.
+var csvHeader = []string{"title", "price", "priority", "tags", "urls", "quantity", "reserved", "available", "status"}
+
+// WithAdminOnlyCSV serves GET /wishes.csv only to requests carrying the admin
+// token, for owners who share the spreadsheet themselves.
+func WithAdminOnlyCSV() Option {
+	return func(s *Server) {
+		s.csvAdminOnly = true
+	}
+}
+
+// handleCSV exports the public list as CSV for spreadsheet users, honoring
+// the same tag and min_priority filters as the list and the same redaction
+// of reservations and hidden prices.
+func (s *Server) handleCSV(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	minPriority, ok := s.parseMinPriority(r)
+	if !ok {
+		http.Error(w, fmt.Sprintf(i18n.T(lang, "err_min_priority"), maxPriority), http.StatusBadRequest)
+
+		return
+	}
+
+	wishes, _, _, err := s.listWishes(r.Context(), r.URL.Query().Get("tag"), minPriority)
+	if err != nil {
+		http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
+
+		return
+	}
+
+	s.delayListReservations(r, wishes)
+	s.redactWishes(wishes)
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="wishes.csv"`)
+
+	// Past this point the status is sent; a failed write only loses the rest
+	_, _ = w.Write([]byte(utf8BOM))
+
+	out := csv.NewWriter(w)
+	_ = out.Write(csvHeader)
+
+	for i := range wishes {
+		_ = out.Write(csvRow(&wishes[i]))
+	}
+
+	out.Flush()
+}
+
+// csvRow returns the CSV export columns for the wish.
+func csvRow(wish *wishlistv1alpha1.Wish) []string {
+	urls := make([]string, 0, len(wish.Spec.PurchaseURLs)+1)
+	if wish.Spec.OfficialURL != "" {
+		urls = append(urls, wish.Spec.OfficialURL)
+	}
+
+	urls = append(urls, wish.Spec.PurchaseURLs...)
+
+	var available string
+	if !wish.IsUnlimited() {
+		available = strconv.Itoa(int(wish.AvailableQuantity()))
+	}
+
+	var priority string
+	if wish.Spec.Priority > 0 {
+		priority = strconv.Itoa(int(wish.Spec.Priority))
+	}
+
+	return []string{
+		csvCell(wish.Spec.Title),
+		csvCell(templates.PriceText(&wish.Spec)),
+		priority,
+		csvCell(strings.Join(wish.Spec.Tags, ", ")),
+		csvCell(strings.Join(urls, " ")),
+		strconv.Itoa(int(wish.GetQuantity())),
+		strconv.Itoa(int(wish.TotalReserved())),
+		available,
+		csvStatus(wish),
+	}
+}
+
+// csvStatus summarizes the wish's reservation state for the status column.
+func csvStatus(wish *wishlistv1alpha1.Wish) string {
+	switch {
+	case wish.Status.Fulfilled:
+		return csvStatusFulfilled
+	case wish.IsFullyReserved():
+		return csvStatusReserved
+	case wish.TotalReserved() > 0:
+		return csvStatusPartial
+	default:
+		return csvStatusAvailable
+	}
+}
+
+// csvCell defuses text a spreadsheet would run as a formula, such as a title
+// starting with "=", by prefixing it with an apostrophe.
+func csvCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestServer_HandleCSV(t *testing.T) {
+	t.Parallel()
+
+	lamp := newSummaryWish("lamp", 3, 1)
+	lamp.Spec.Title = `Lamp, "Tolomeo" edition`
+	lamp.Spec.Priority = 4
+	lamp.Spec.PriceMin = ptr.To[int64](250)
+	lamp.Spec.Currency = "€"
+	lamp.Spec.Tags = []string{"home", "light"}
+	lamp.Spec.OfficialURL = "https://example.com/lamp"
+	lamp.Spec.PurchaseURLs = []string{"https://shop.example/lamp"}
+
+	formula := newSummaryWish("formula", 1, 1)
+	formula.Spec.Title = "=HYPERLINK(\"https://evil.example\")"
+
+	srv := newTestServer(t, lamp, formula, newSummaryWish("kite", 0, 0))
+
+	rec := getPath(srv.Handler(), "/wishes.csv")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), "wishes.csv")
+
+	body, found := strings.CutPrefix(rec.Body.String(), utf8BOM)
+	require.True(t, found, "starts with a UTF-8 BOM")
+	assert.Contains(t, body, `"Lamp, ""Tolomeo"" edition"`, "commas and quotes are escaped")
+
+	rows, err := csv.NewReader(strings.NewReader(body)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	assert.Equal(t, csvHeader, rows[0])
+
+	byTitle := make(map[string][]string)
+	for _, row := range rows[1:] {
+		byTitle[row[0]] = row
+	}
+
+	assert.Equal(t, []string{
+		`Lamp, "Tolomeo" edition`, "€250", "4", "home, light",
+		"https://example.com/lamp https://shop.example/lamp", "3", "1", "2", csvStatusPartial,
+	}, byTitle[`Lamp, "Tolomeo" edition`])
+	assert.Equal(t, []string{
+		`'=HYPERLINK("https://evil.example")`, "", "", "", "", "1", "1", "0", csvStatusReserved,
+	}, byTitle[`'=HYPERLINK("https://evil.example")`], "formulas are defused")
+	assert.Equal(t, []string{testTitleGift, "", "", "", "", "0", "0", "", csvStatusAvailable}, byTitle[testTitleGift])
+}
+
+func TestServer_HandleCSV_AdminOnly(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newSummaryWish("lamp", 1, 0))
+	WithAdminToken(testAdminToken)(srv)
+	WithAdminOnlyCSV()(srv)
+
+	assert.Equal(t, http.StatusUnauthorized, getPath(srv.Handler(), "/wishes.csv").Code)
+
+	rec := adminRequest(t, srv, "/wishes.csv", testAdminToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), testTitleGift)
+}
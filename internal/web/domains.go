@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ParseURLDomains parses a comma-separated allowlist of shop domains, e.g.
+// "ozon.ru,*.amazon.com". A leading "*." is dropped, since every domain
+// admits its subdomains anyway. An empty string yields no domains, allowing
+// all of them.
+func ParseURLDomains(value string) ([]string, error) {
+	var domains []string
+
+	for domain := range strings.SplitSeq(value, ",") {
+		domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "*.")
+		if domain == "" {
+			continue
+		}
+
+		if errs := validation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid domain %q: %s", domain, strings.Join(errs, "; "))
+		}
+
+		domains = append(domains, domain)
+	}
+
+	return domains, nil
+}
+
+// WithAllowedURLDomains restricts the official and purchase URLs of wishes
+// created through the admin API to the given domains and their subdomains,
+// as the validating webhook does. Empty allows every domain.
+func WithAllowedURLDomains(domains []string) Option {
+	return func(s *Server) {
+		s.validation.AllowedURLDomains = domains
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseURLDomains(t *testing.T) {
+	t.Parallel()
+
+	domains, err := ParseURLDomains(" Ozon.ru, *.amazon.com ,,")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ozon.ru", "amazon.com"}, domains)
+
+	domains, err = ParseURLDomains("")
+	require.NoError(t, err)
+	assert.Empty(t, domains)
+
+	for _, value := range []string{"https://ozon.ru", "ozon.ru/shop", "shop_1.com"} {
+		_, err := ParseURLDomains(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestServer_HandleAdminCreate_AllowedURLDomains(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithAdminToken(testAdminToken)(srv)
+	WithAllowedURLDomains([]string{"example.com"})(srv)
+
+	tests := []struct {
+		path string
+		url  string
+		want int
+		body string
+	}{
+		{path: "/admin/wishes", url: "https://example.com/lamp", want: http.StatusCreated},
+		{path: "/admin/wishes", url: "https://shop.example.com/lamp", want: http.StatusCreated},
+		{path: "/admin/wishes", url: "https://badexample.com/lamp", want: http.StatusBadRequest, body: "badexample.com is not an allowed shop"},
+		{path: "/ru/admin/wishes", url: "https://scam.test/lamp", want: http.StatusBadRequest, body: "scam.test нет в списке разрешённых магазинов"},
+		{path: "/zh/admin/wishes", url: "https://scam.test/lamp", want: http.StatusBadRequest, body: "scam.test 不在允许的商店列表中"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url+tt.path, func(t *testing.T) {
+			t.Parallel()
+
+			rec := createWish(srv, tt.path, url.Values{"title": {"Lamp"}, "officialURL": {tt.url}})
+
+			assert.Equal(t, tt.want, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tt.body)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+// ParseCIDRs parses a comma-separated list of CIDR ranges, e.g.
+// "10.0.0.0/8,fd00::/8". A bare address stands for a single host. An empty
+// string yields no ranges.
+func ParseCIDRs(value string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+
+	for cidr := range strings.SplitSeq(value, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+
+		if !strings.Contains(cidr, "/") {
+			addr, err := netip.ParseAddr(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid address %q: %w", cidr, err)
+			}
+
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q: %w", cidr, err)
+		}
+
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
+// WithRateLimitExempt lets clients from the given ranges, such as uptime
+// checkers or a Prometheus scraper, bypass the per-IP rate limit. Ranges are
+// matched against the same client IP the limiter uses.
+func WithRateLimitExempt(prefixes []netip.Prefix) Option {
+	return func(s *Server) {
+		s.rateLimitExempt = prefixes
+	}
+}
+
+// WithTrustedProxies believes the X-Forwarded-For and X-Real-IP headers of
+// requests from the given ranges, such as the ingress controller. Requests
+// from anywhere else are known by their peer address, so a client cannot
+// pick the address it is rate limited or exempted by.
+func WithTrustedProxies(prefixes []netip.Prefix) Option {
+	return func(s *Server) {
+		s.trustedProxies = prefixes
+	}
+}
+
+// isRateLimitExempt reports whether ip falls in an exempt range.
+func (s *Server) isRateLimitExempt(ip string) bool {
+	return containsIP(s.rateLimitExempt, ip)
+}
+
+// isTrustedProxy reports whether ip falls in a trusted proxy range.
+func (s *Server) isTrustedProxy(ip string) bool {
+	return containsIP(s.trustedProxies, ip)
+}
+
+// containsIP reports whether ip parses and falls in one of prefixes.
+func containsIP(prefixes []netip.Prefix, ip string) bool {
+	if len(prefixes) == 0 {
+		return false
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+
+	return slices.ContainsFunc(prefixes, func(prefix netip.Prefix) bool {
+		return prefix.Contains(addr)
+	})
+}
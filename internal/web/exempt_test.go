@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCIDRs(t *testing.T) {
+	t.Parallel()
+
+	prefixes, err := ParseCIDRs(" 10.1.2.3/8, 192.168.1.5 ,fd00::/8,")
+	require.NoError(t, err)
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("192.168.1.5/32"),
+		netip.MustParsePrefix("fd00::/8"),
+	}, prefixes)
+
+	prefixes, err = ParseCIDRs("")
+	require.NoError(t, err)
+	assert.Empty(t, prefixes)
+
+	for _, value := range []string{"10.0.0.0/33", "monitoring", "10.0.0/8"} {
+		_, err := ParseCIDRs(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestServer_RateLimitExempt(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	srv.rateLimit = 1
+	srv.rateBurst = 1
+	WithRateLimitExempt([]netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("2001:db8::/32"),
+	})(srv)
+	WithTrustedProxies([]netip.Prefix{netip.MustParsePrefix("172.16.0.0/12")})(srv)
+
+	handler := srv.Handler()
+
+	get := func(remoteAddr, forwardedFor string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		exempt       bool
+	}{
+		{name: "exempt direct client", remoteAddr: "10.1.2.3:1234", exempt: true},
+		{name: "exempt IPv6 client", remoteAddr: "[2001:db8::1]:1234", exempt: true},
+		{name: "exempt client behind a proxy", remoteAddr: "172.16.0.1:1234", forwardedFor: "10.9.9.9", exempt: true},
+		{name: "exempt client behind two proxies", remoteAddr: "172.16.0.1:1234", forwardedFor: "10.9.9.9, 172.16.0.2", exempt: true},
+		{name: "other client", remoteAddr: "192.168.1.1:1234", exempt: false},
+		{name: "other client through a trusted proxy", remoteAddr: "172.16.0.1:1234", forwardedFor: "203.0.113.7", exempt: false},
+		{
+			name:         "spoofed hop before a trusted proxy",
+			remoteAddr:   "172.16.0.1:1234",
+			forwardedFor: "10.9.9.9, 203.0.113.8",
+			exempt:       false,
+		},
+		{name: "spoofed header from an untrusted peer", remoteAddr: "192.168.1.2:1234", forwardedFor: "10.9.9.9", exempt: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, http.StatusOK, get(tt.remoteAddr, tt.forwardedFor))
+
+			for range 5 {
+				code := get(tt.remoteAddr, tt.forwardedFor)
+				if !tt.exempt {
+					assert.Equal(t, http.StatusTooManyRequests, code)
+
+					return
+				}
+
+				assert.Equal(t, http.StatusOK, code)
+			}
+		})
+	}
+}
+
+func TestServer_GetClientIP(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithTrustedProxies([]netip.Prefix{netip.MustParsePrefix("172.16.0.0/12")})(srv)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		header     http.Header
+		want       string
+	}{
+		{name: "direct", remoteAddr: "192.0.2.1:1234", want: "192.0.2.1"},
+		{
+			name:       "forwarded by an untrusted peer",
+			remoteAddr: "192.0.2.1:1234",
+			header:     http.Header{"X-Forwarded-For": {"10.9.9.9"}, "X-Real-Ip": {"10.9.9.9"}},
+			want:       "192.0.2.1",
+		},
+		{
+			name:       "forwarded by a trusted proxy",
+			remoteAddr: "172.16.0.1:1234",
+			header:     http.Header{"X-Forwarded-For": {"10.9.9.9, 198.51.100.4, 172.16.0.2"}},
+			want:       "198.51.100.4",
+		},
+		{name: "real IP from a trusted proxy", remoteAddr: "172.16.0.1:1234", header: http.Header{"X-Real-Ip": {"198.51.100.4"}}, want: "198.51.100.4"},
+		{name: "trusted proxy without headers", remoteAddr: "172.16.0.1:1234", want: "172.16.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			maps.Copy(req.Header, tt.header)
+
+			assert.Equal(t, tt.want, srv.getClientIP(req))
+		})
+	}
+}
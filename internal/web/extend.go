@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// maxExtension bounds a single TTL extension; owners can extend again.
+const maxExtension = 365 * 24 * time.Hour
+
+// handleAdminExtend keeps a wish alive for the form's duration (Go syntax,
+// e.g. 168h) past the later of now and its current expiry, by growing
+// Spec.TTL. An expired wish is thus active again for the full duration; the
+// spec change makes the controller flip Status.Active back on.
+func (s *Server) handleAdminExtend(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	if !s.parseForm(w, r, lang) {
+		return
+	}
+
+	extension, err := time.ParseDuration(r.FormValue("duration"))
+	if err != nil || extension <= 0 || extension > maxExtension {
+		http.Error(w, i18n.T(lang, "err_extend_duration"), http.StatusBadRequest)
+
+		return
+	}
+
+	wish := &wishlistv1alpha1.Wish{}
+	if err := s.client.Get(r.Context(), s.objectKey(r, r.PathValue("name")), wish); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, i18n.T(lang, "err_get_wish"), http.StatusInternalServerError)
+
+		return
+	}
+
+	expiresAt, ok := wish.ExpirationTime()
+	if !ok {
+		http.Error(w, i18n.T(lang, "err_extend_no_ttl"), http.StatusConflict)
+
+		return
+	}
+
+	if now := s.clock.Now(); expiresAt.Before(now) {
+		expiresAt = now
+	}
+
+	wish.Spec.TTL = &metav1.Duration{Duration: expiresAt.Add(extension).Sub(wish.CreationTimestamp.Time)}
+
+	if err := s.client.Update(r.Context(), wish); err != nil {
+		http.Error(w, i18n.T(lang, "err_extend_failed"), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if err := json.NewEncoder(w).Encode(adminWishDetail{
+		Name:   wish.Name,
+		Spec:   wish.Spec,
+		Status: wish.Status,
+	}); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func extendWish(srv *Server, name, token, duration string) *httptest.ResponseRecorder {
+	form := url.Values{"duration": {duration}}
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/wishes/"+name+"/extend", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandleAdminExtend_ReactivatesExpiredWish(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+
+	srv := newTestServer(t, newArchiveWish("lamp", testNamespace, "Lamp", 10*24*time.Hour, 24*time.Hour))
+	WithAdminToken(testAdminToken)(srv)
+	WithClock(clocktesting.NewFakePassiveClock(now))(srv)
+
+	require.True(t, getFundWish(t, srv, "lamp").IsExpiredAt(now))
+
+	rec := extendWish(srv, "lamp", testAdminToken, "168h")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	wish := getFundWish(t, srv, "lamp")
+	assert.False(t, wish.IsExpiredAt(now))
+
+	expiresAt, ok := wish.ExpirationTime()
+	require.True(t, ok)
+	assert.WithinDuration(t, now.Add(7*24*time.Hour), expiresAt, time.Second)
+}
+
+func TestServer_HandleAdminExtend_ActiveWishFromExpiry(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newArchiveWish("lamp", testNamespace, "Lamp", time.Hour, 24*time.Hour))
+	WithAdminToken(testAdminToken)(srv)
+
+	require.Equal(t, http.StatusOK, extendWish(srv, "lamp", testAdminToken, "24h").Code)
+
+	assert.Equal(t, metav1.Duration{Duration: 48 * time.Hour}, *getFundWish(t, srv, "lamp").Spec.TTL)
+}
+
+func TestServer_HandleAdminExtend_Rejects(t *testing.T) {
+	t.Parallel()
+
+	noTTL := newSummaryWish("forever", 1, 0)
+
+	srv := newTestServer(t, newArchiveWish("lamp", testNamespace, "Lamp", time.Hour, 24*time.Hour), noTTL)
+	WithAdminToken(testAdminToken)(srv)
+
+	tests := []struct {
+		name     string
+		wish     string
+		token    string
+		duration string
+		want     int
+	}{
+		{name: "missing token", wish: "lamp", duration: "24h", want: http.StatusUnauthorized},
+		{name: "missing duration", wish: "lamp", token: testAdminToken, want: http.StatusBadRequest},
+		{name: "malformed duration", wish: "lamp", token: testAdminToken, duration: "a week", want: http.StatusBadRequest},
+		{name: "negative duration", wish: "lamp", token: testAdminToken, duration: "-24h", want: http.StatusBadRequest},
+		{name: "too long", wish: "lamp", token: testAdminToken, duration: "9000h", want: http.StatusBadRequest},
+		{name: "no TTL", wish: "forever", token: testAdminToken, duration: "24h", want: http.StatusConflict},
+		{name: "unknown wish", wish: "missing", token: testAdminToken, duration: "24h", want: http.StatusNotFound},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, extendWish(srv, tc.wish, tc.token, tc.duration).Code)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/templates"
+)
+
+// handleContribute records a pledge towards a fund wish. Amounts beyond what
+// is still needed are capped at the target, and the wish is marked fulfilled
+// once the target is reached.
+func (s *Server) handleContribute(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+	name := r.PathValue("name")
+
+	if name == "" {
+		http.Error(w, i18n.T(lang, "err_missing_name"), http.StatusBadRequest)
+
+		return
+	}
+
+	if !s.parseForm(w, r, lang) {
+		return
+	}
+
+	amount, err := strconv.ParseInt(r.FormValue("amount"), 10, 64)
+	if err != nil || amount < 1 {
+		http.Error(w, i18n.T(lang, "err_invalid_amount"), http.StatusBadRequest)
+
+		return
+	}
+
+	wish := &wishlistv1alpha1.Wish{}
+	if err := s.client.Get(r.Context(), s.objectKey(r, name), wish); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, i18n.T(lang, "err_get_wish"), http.StatusInternalServerError)
+
+		return
+	}
+
+	if !wish.Spec.Fund || wish.Spec.FundTarget <= 0 {
+		http.Error(w, i18n.T(lang, "err_not_fund"), http.StatusBadRequest)
+
+		return
+	}
+
+	if wish.IsFullyFunded() {
+		http.Error(w, i18n.T(lang, "err_fully_funded"), http.StatusConflict)
+
+		return
+	}
+
+	wish.Status.FundRaised += min(amount, wish.FundRemaining())
+	wish.Status.Fulfilled = wish.IsFulfilled()
+
+	if err := s.client.Status().Update(r.Context(), wish); err != nil {
+		http.Error(w, i18n.T(lang, "err_contribute_failed"), http.StatusInternalServerError)
+
+		return
+	}
+
+	s.redactWish(wish)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	if err := templates.WishCard(wish, lang).Render(s.renderContext(r.Context()), w); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func newFundWish(name string, target int64) *wishlistv1alpha1.Wish {
+	wish := newIdempotencyWish(name)
+	wish.Spec.Fund = true
+	wish.Spec.FundTarget = target
+	wish.Spec.Currency = "$"
+
+	return wish
+}
+
+func contribute(handler http.Handler, name, amount string) *httptest.ResponseRecorder {
+	form := url.Values{}
+	form.Set("amount", amount)
+
+	req := httptest.NewRequest(http.MethodPost, "/wishes/"+name+"/contribute", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func getFundWish(t *testing.T, srv *Server, name string) *wishlistv1alpha1.Wish {
+	t.Helper()
+
+	wish := &wishlistv1alpha1.Wish{}
+	require.NoError(t, srv.client.Get(t.Context(), client.ObjectKey{Name: name, Namespace: testNamespace}, wish))
+
+	return wish
+}
+
+func TestServer_HandleContribute_Accumulates(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newFundWish("bike", 1000))
+	handler := srv.Handler()
+
+	require.Equal(t, http.StatusOK, contribute(handler, "bike", "300").Code)
+
+	rec := contribute(handler, "bike", "200")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "$500 of $1000 raised")
+	assert.Contains(t, rec.Body.String(), `max="500"`)
+
+	wish := getFundWish(t, srv, "bike")
+	assert.Equal(t, int64(500), wish.Status.FundRaised)
+	assert.False(t, wish.Status.Fulfilled)
+}
+
+func TestServer_HandleContribute_CapsAtTarget(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newFundWish("bike", 1000))
+	handler := srv.Handler()
+
+	require.Equal(t, http.StatusOK, contribute(handler, "bike", "800").Code)
+
+	rec := contribute(handler, "bike", "500")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Fully funded")
+	assert.NotContains(t, rec.Body.String(), "/contribute")
+
+	wish := getFundWish(t, srv, "bike")
+	assert.Equal(t, int64(1000), wish.Status.FundRaised)
+	assert.True(t, wish.Status.Fulfilled)
+
+	rec = contribute(handler, "bike", "1")
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, int64(1000), getFundWish(t, srv, "bike").Status.FundRaised)
+}
+
+func TestServer_HandleContribute_Rejects(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newFundWish("bike", 1000), newIdempotencyWish("book"))
+	handler := srv.Handler()
+
+	tests := []struct {
+		name     string
+		wish     string
+		amount   string
+		wantCode int
+	}{
+		{"zero amount", "bike", "0", http.StatusBadRequest},
+		{"negative amount", "bike", "-5", http.StatusBadRequest},
+		{"fractional amount", "bike", "2.5", http.StatusBadRequest},
+		{"missing amount", "bike", "", http.StatusBadRequest},
+		{"not a fund", "book", "10", http.StatusBadRequest},
+		{"missing wish", "missing", "10", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.wantCode, contribute(handler, tt.wish, tt.amount).Code)
+		})
+	}
+}
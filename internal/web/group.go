@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"net/http"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/templates"
+)
+
+// viewerContext is like renderContext for a single wish, additionally marking
+// the viewer as coordinator when tokenHash coordinates the wish's group gift.
+// Call it before the wish is anonymized.
+func (s *Server) viewerContext(ctx context.Context, wish *wishlistv1alpha1.Wish, tokenHash string) context.Context {
+	opts := s.renderOptions()
+	opts.Coordinator = wish.Spec.GroupGift && tokenHash != "" && wish.Status.Coordinator == tokenHash
+
+	return templates.WithOptions(ctx, opts)
+}
+
+// joinGroupGift records tokenHash as a pledger of a group gift that already
+// has a coordinator. Joining again is a no-op.
+func (s *Server) joinGroupGift(w http.ResponseWriter, r *http.Request, wish *wishlistv1alpha1.Wish, tokenHash string) {
+	lang := i18n.DetectLanguage(r)
+
+	if !wish.HasPledger(tokenHash) {
+		wish.Status.Pledgers = append(wish.Status.Pledgers, tokenHash)
+
+		if err := s.client.Status().Update(r.Context(), wish); err != nil {
+			http.Error(w, i18n.T(lang, "err_join_failed"), http.StatusInternalServerError)
+
+			return
+		}
+	}
+
+	s.renderGroupCard(w, r, wish, tokenHash)
+}
+
+// handleCloseGroup stops a group gift from taking new pledgers. Only its
+// coordinator, identified by the reserver token cookie, may close it.
+func (s *Server) handleCloseGroup(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+	name := r.PathValue("name")
+
+	token := reserverTokenFromRequest(r)
+	if token == "" {
+		http.Error(w, i18n.T(lang, "err_not_coordinator"), http.StatusForbidden)
+
+		return
+	}
+
+	wish := &wishlistv1alpha1.Wish{}
+	if err := s.client.Get(r.Context(), s.objectKey(r, name), wish); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, i18n.T(lang, "err_get_wish"), http.StatusInternalServerError)
+
+		return
+	}
+
+	if !wish.Spec.GroupGift {
+		http.Error(w, i18n.T(lang, "err_not_group_gift"), http.StatusBadRequest)
+
+		return
+	}
+
+	tokenHash := hashToken(token)
+	if wish.Status.Coordinator != tokenHash {
+		http.Error(w, i18n.T(lang, "err_not_coordinator"), http.StatusForbidden)
+
+		return
+	}
+
+	if !wish.Status.GroupClosed {
+		wish.Status.GroupClosed = true
+
+		if err := s.client.Status().Update(r.Context(), wish); err != nil {
+			http.Error(w, i18n.T(lang, "err_join_failed"), http.StatusInternalServerError)
+
+			return
+		}
+	}
+
+	s.renderGroupCard(w, r, wish, tokenHash)
+}
+
+// renderGroupCard renders the updated card of a group gift for the viewer.
+func (s *Server) renderGroupCard(w http.ResponseWriter, r *http.Request, wish *wishlistv1alpha1.Wish, tokenHash string) {
+	ctx := s.viewerContext(r.Context(), wish, tokenHash)
+
+	s.delayReservations(r, wish)
+	s.redactWish(wish)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	if err := templates.WishCard(wish, i18n.DetectLanguage(r)).Render(ctx, w); err != nil {
+		http.Error(w, i18n.T(i18n.DetectLanguage(r), "err_render"), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func newGroupWish(name string) *wishlistv1alpha1.Wish {
+	wish := newIdempotencyWish(name)
+	wish.Spec.Quantity = 1
+	wish.Spec.GroupGift = true
+
+	return wish
+}
+
+func groupPost(handler http.Handler, path, token string) *httptest.ResponseRecorder {
+	form := url.Values{}
+	form.Set("weeks", "4")
+
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.AddCookie(&http.Cookie{Name: reserverCookie, Value: token})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_GroupGift_FirstReserverCoordinates(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newGroupWish("tv"))
+	handler := srv.Handler()
+
+	rec := groupPost(handler, "/wishes/tv/reserve", "alice")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Close group")
+	assert.NotContains(t, rec.Body.String(), hashToken("alice"))
+
+	rec = groupPost(handler, "/wishes/tv/reserve", "bob")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "1 joined")
+	assert.NotContains(t, rec.Body.String(), "Close group")
+
+	// Joining twice does not count the same giver again
+	require.Equal(t, http.StatusOK, groupPost(handler, "/wishes/tv/reserve", "bob").Code)
+	require.Equal(t, http.StatusOK, groupPost(handler, "/wishes/tv/reserve", "carol").Code)
+
+	wish := getFundWish(t, srv, "tv")
+	assert.Equal(t, hashToken("alice"), wish.Status.Coordinator)
+	assert.Equal(t, []string{hashToken("bob"), hashToken("carol")}, wish.Status.Pledgers)
+	assert.Len(t, wish.Status.Reservations, 1)
+}
+
+func TestServer_GroupGift_CoordinatorCloses(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newGroupWish("tv"))
+	handler := srv.Handler()
+
+	require.Equal(t, http.StatusOK, groupPost(handler, "/wishes/tv/reserve", "alice").Code)
+
+	assert.Equal(t, http.StatusForbidden, groupPost(handler, "/wishes/tv/close-group", "bob").Code)
+	assert.False(t, getFundWish(t, srv, "tv").Status.GroupClosed)
+
+	rec := groupPost(handler, "/wishes/tv/close-group", "alice")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Group closed")
+	assert.True(t, getFundWish(t, srv, "tv").Status.GroupClosed)
+
+	assert.Equal(t, http.StatusConflict, groupPost(handler, "/wishes/tv/reserve", "bob").Code)
+	assert.Empty(t, getFundWish(t, srv, "tv").Status.Pledgers)
+}
+
+func TestServer_GroupGift_CloseRejects(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("book"), newGroupWish("tv"))
+	handler := srv.Handler()
+
+	assert.Equal(t, http.StatusBadRequest, groupPost(handler, "/wishes/book/close-group", "alice").Code)
+	assert.Equal(t, http.StatusNotFound, groupPost(handler, "/wishes/missing/close-group", "alice").Code)
+	assert.Equal(t, http.StatusForbidden, groupPost(handler, "/wishes/tv/close-group", "alice").Code)
+}
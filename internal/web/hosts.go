@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// namespaceKey carries the namespace selected by the request's host.
+type namespaceKey struct{}
+
+// ParseHostNamespaces parses a comma-separated list of host=namespace pairs,
+// e.g. "alice.example.com=alice,bob.example.com=bob". Hosts are matched
+// case-insensitively and without a port. An empty string yields no mapping.
+func ParseHostNamespaces(value string) (map[string]string, error) {
+	hosts := make(map[string]string)
+
+	for pair := range strings.SplitSeq(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		host, namespace, found := strings.Cut(pair, "=")
+		host = normalizeHost(strings.TrimSpace(host))
+		namespace = strings.TrimSpace(namespace)
+
+		if !found || host == "" {
+			return nil, fmt.Errorf("invalid host mapping %q: want host=namespace", pair)
+		}
+
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid namespace %q for host %q: %s", namespace, host, strings.Join(errs, "; "))
+		}
+
+		if _, dup := hosts[host]; dup {
+			return nil, fmt.Errorf("host %q is mapped twice", host)
+		}
+
+		hosts[host] = namespace
+	}
+
+	return hosts, nil
+}
+
+// WithHostNamespaces serves each mapped host the wishlist of its namespace,
+// so one deployment can host several people's lists, e.g. alice.example.com
+// and bob.example.com. Other hosts get the server's namespace unless
+// WithUnknownHostsRejected is set.
+func WithHostNamespaces(hosts map[string]string) Option {
+	return func(s *Server) {
+		s.hostNamespaces = hosts
+	}
+}
+
+// WithUnknownHostsRejected answers requests for hosts missing from
+// WithHostNamespaces with 404 instead of the server's namespace.
+func WithUnknownHostsRejected() Option {
+	return func(s *Server) {
+		s.rejectUnknownHosts = true
+	}
+}
+
+// normalizeHost lowercases host and drops its port and trailing dot.
+func normalizeHost(host string) string {
+	if name, _, err := net.SplitHostPort(host); err == nil {
+		host = name
+	}
+
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
+
+// hostScopeMiddleware selects the namespace mapped to the request's host for
+// the handlers below it.
+func (s *Server) hostScopeMiddleware(next http.Handler) http.Handler {
+	if len(s.hostNamespaces) == 0 {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		namespace, ok := s.hostNamespaces[normalizeHost(r.Host)]
+
+		switch {
+		case ok:
+			r = r.WithContext(context.WithValue(r.Context(), namespaceKey{}, namespace))
+		case s.rejectUnknownHosts:
+			http.Error(w, i18n.T(i18n.DetectLanguage(r), "err_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// namespaceFor returns the namespace a request is served from: the one
+// mapped to its host, or the server's.
+func (s *Server) namespaceFor(ctx context.Context) string {
+	if namespace, ok := ctx.Value(namespaceKey{}).(string); ok {
+		return namespace
+	}
+
+	return s.namespace
+}
+
+// objectKey returns the key of the named object in the request's namespace.
+func (s *Server) objectKey(r *http.Request, name string) client.ObjectKey {
+	return client.ObjectKey{Name: name, Namespace: s.namespaceFor(r.Context())}
+}
+
+// cacheFor returns the stale-list cache of the namespace.
+func (s *Server) cacheFor(namespace string) *wishCache {
+	if namespace == s.namespace {
+		return &s.wishCache
+	}
+
+	v, _ := s.hostCaches.LoadOrStore(namespace, &wishCache{})
+
+	cache, ok := v.(*wishCache)
+	if !ok {
+		return &wishCache{}
+	}
+
+	return cache
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// newHostWish returns an available wish titled title in namespace.
+func newHostWish(namespace, name, title string) *wishlistv1alpha1.Wish {
+	wish := newIdempotencyWish(name)
+	wish.Namespace = namespace
+	wish.Spec.Title = title
+
+	return wish
+}
+
+// getHost requests path as if addressed to host.
+func getHost(handler http.Handler, host, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Host = host
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestParseHostNamespaces(t *testing.T) {
+	t.Parallel()
+
+	hosts, err := ParseHostNamespaces(" Alice.Example.com=alice , bob.example.com:8080=bob,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"alice.example.com": "alice", "bob.example.com": "bob"}, hosts)
+
+	hosts, err = ParseHostNamespaces("")
+	require.NoError(t, err)
+	assert.Empty(t, hosts)
+
+	for _, value := range []string{"alice.example.com", "=alice", "alice.example.com=Alice_NS", "a.example=x,A.example=y"} {
+		_, err := ParseHostNamespaces(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestServer_HostNamespaces(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t,
+		newHostWish("alice", "lamp", "Alice Lamp"),
+		newHostWish("bob", "lamp", "Bob Lamp"),
+		newHostWish("bob", "kite", "Bob Kite"),
+		newHostWish(testNamespace, "mug", "Default Mug"),
+	)
+	WithHostNamespaces(map[string]string{"alice.example.com": "alice", "bob.example.com": "bob"})(srv)
+
+	handler := srv.Handler()
+
+	alice := getHost(handler, "alice.example.com", "/wishes").Body.String()
+	assert.Contains(t, alice, "Alice Lamp")
+	assert.NotContains(t, alice, "Bob")
+	assert.NotContains(t, alice, "Default Mug")
+
+	bob := getHost(handler, "Bob.Example.com:8443", "/wishes").Body.String()
+	assert.Contains(t, bob, "Bob Lamp")
+	assert.Contains(t, bob, "Bob Kite")
+	assert.NotContains(t, bob, "Alice")
+
+	assert.Contains(t, getHost(handler, "alice.example.com", "/wishes/lamp").Body.String(), "Alice Lamp")
+	assert.Equal(t, http.StatusNotFound, getHost(handler, "alice.example.com", "/wishes/kite").Code,
+		"another host's wish")
+
+	other := getHost(handler, "example.com", "/wishes")
+	require.Equal(t, http.StatusOK, other.Code)
+	assert.Contains(t, other.Body.String(), "Default Mug", "unknown hosts get the default namespace")
+}
+
+func TestServer_HostNamespaces_Reserve(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newHostWish("alice", "lamp", "Alice Lamp"), newHostWish("bob", "lamp", "Bob Lamp"))
+	WithHostNamespaces(map[string]string{"alice.example.com": "alice", "bob.example.com": "bob"})(srv)
+
+	form := url.Values{"weeks": {"2"}}
+
+	req := httptest.NewRequest(http.MethodPost, "/wishes/lamp/reserve", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Host = "bob.example.com"
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	bobWish := &wishlistv1alpha1.Wish{}
+	require.NoError(t, srv.client.Get(t.Context(), client.ObjectKey{Name: "lamp", Namespace: "bob"}, bobWish))
+	assert.Len(t, bobWish.Status.Reservations, 1)
+
+	aliceWish := &wishlistv1alpha1.Wish{}
+	require.NoError(t, srv.client.Get(t.Context(), client.ObjectKey{Name: "lamp", Namespace: "alice"}, aliceWish))
+	assert.Empty(t, aliceWish.Status.Reservations, "the same name on another host is untouched")
+}
+
+func TestServer_HostNamespaces_RejectUnknown(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newHostWish("alice", "lamp", "Alice Lamp"), newHostWish(testNamespace, "mug", "Default Mug"))
+	WithHostNamespaces(map[string]string{"alice.example.com": "alice"})(srv)
+	WithUnknownHostsRejected()(srv)
+
+	handler := srv.Handler()
+
+	assert.Equal(t, http.StatusOK, getHost(handler, "alice.example.com", "/wishes").Code)
+	assert.Equal(t, http.StatusNotFound, getHost(handler, "example.com", "/wishes").Code)
+	assert.Equal(t, http.StatusNotFound, getHost(handler, "example.com", "/").Code)
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"bytes"
+	"maps"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+const (
+	idempotencyHeader    = "Idempotency-Key"
+	idempotencyReplayed  = "Idempotent-Replayed"
+	idempotencyTTL       = 10 * time.Minute
+	maxIdempotencyKeyLen = 255
+)
+
+// idempotencyEntry holds the outcome of the first request seen for a key.
+// done is closed once the first request has finished; ok reports whether
+// its response was stored for replay.
+type idempotencyEntry struct {
+	done    chan struct{}
+	ok      bool
+	status  int
+	header  http.Header
+	body    []byte
+	expires time.Time
+}
+
+// idempotencyStore remembers recent successful responses by key so that
+// retried or double-submitted requests replay the stored result instead of
+// repeating the side effect.
+type idempotencyStore struct {
+	mu      sync.Mutex
+	entries map[string]*idempotencyEntry
+	ttl     time.Duration
+}
+
+func newIdempotencyStore(ttl time.Duration) *idempotencyStore {
+	return &idempotencyStore{
+		entries: make(map[string]*idempotencyEntry),
+		ttl:     ttl,
+	}
+}
+
+// claim returns the entry for key. owner is true when the caller created the
+// entry and must finish it with complete.
+func (st *idempotencyStore) claim(key string) (*idempotencyEntry, bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	now := time.Now()
+	for k, e := range st.entries {
+		if e.ok && now.After(e.expires) {
+			delete(st.entries, k)
+		}
+	}
+
+	if e, exists := st.entries[key]; exists {
+		return e, false
+	}
+
+	e := &idempotencyEntry{done: make(chan struct{})}
+	st.entries[key] = e
+
+	return e, true
+}
+
+// complete records the response for a claimed key. Only successful responses
+// are kept; anything else releases the key so the request can be retried.
+func (st *idempotencyStore) complete(key string, e *idempotencyEntry, rec *recordingWriter) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if rec.status >= http.StatusOK && rec.status < http.StatusMultipleChoices {
+		e.ok = true
+		e.status = rec.status
+		e.header = rec.Header().Clone()
+		// Keys are not bound to a client, so the first requester's reserver
+		// cookie must never be handed to whoever repeats the key
+		e.header.Del("Set-Cookie")
+		e.body = rec.body.Bytes()
+		e.expires = time.Now().Add(st.ttl)
+	} else {
+		delete(st.entries, key)
+	}
+
+	close(e.done)
+}
+
+// recordingWriter passes the response through while keeping a copy of the
+// status code and body.
+type recordingWriter struct {
+	http.ResponseWriter
+
+	status int
+	body   bytes.Buffer
+}
+
+func (rw *recordingWriter) WriteHeader(status int) {
+	rw.status = status
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+func (rw *recordingWriter) Write(b []byte) (int, error) {
+	rw.body.Write(b)
+
+	return rw.ResponseWriter.Write(b)
+}
+
+// withIdempotency wraps a per-wish handler so that requests carrying the same
+// Idempotency-Key for the same wish are executed at most once within the
+// idempotency window. Duplicates receive the stored response.
+func (s *Server) withIdempotency(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyHeader)
+		if key == "" {
+			next(w, r)
+
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLen {
+			lang := i18n.DetectLanguage(r)
+			http.Error(w, i18n.T(lang, "err_invalid_idempotency_key"), http.StatusBadRequest)
+
+			return
+		}
+
+		// Scope keys per wish so the same key can be reused across wishes,
+		// including same-named wishes of different hosts' lists.
+		scoped := s.namespaceFor(r.Context()) + "/" + r.PathValue("name") + "/" + key
+
+		for {
+			entry, owner := s.idempotency.claim(scoped)
+			if owner {
+				rec := &recordingWriter{ResponseWriter: w, status: http.StatusOK}
+				next(rec, r)
+				s.idempotency.complete(scoped, entry, rec)
+
+				return
+			}
+
+			select {
+			case <-entry.done:
+			case <-r.Context().Done():
+				return
+			}
+
+			if entry.ok {
+				maps.Copy(w.Header(), entry.header)
+				w.Header().Set(idempotencyReplayed, "true")
+				w.WriteHeader(entry.status)
+				_, _ = w.Write(entry.body)
+
+				return
+			}
+			// The first request failed and released the key; try again.
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func newIdempotencyWish(name string) *wishlistv1alpha1.Wish {
+	return &wishlistv1alpha1.Wish{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+		Spec: wishlistv1alpha1.WishSpec{
+			Title:    testTitleGift,
+			Quantity: 5,
+		},
+		Status: wishlistv1alpha1.WishStatus{
+			Active: true,
+		},
+	}
+}
+
+func reserveWithKey(handler http.Handler, name, key string) *httptest.ResponseRecorder {
+	form := url.Values{}
+	form.Set("weeks", "2")
+
+	req := httptest.NewRequest(http.MethodPost, "/wishes/"+name+"/reserve", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if key != "" {
+		req.Header.Set(idempotencyHeader, key)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandleReserve_IdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("idem-wish"))
+	handler := srv.Handler()
+
+	first := reserveWithKey(handler, "idem-wish", "key-1")
+	second := reserveWithKey(handler, "idem-wish", "key-1")
+
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, http.StatusOK, second.Code)
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Empty(t, first.Header().Get(idempotencyReplayed))
+	assert.Equal(t, "true", second.Header().Get(idempotencyReplayed))
+	assert.NotEmpty(t, first.Header().Get("Set-Cookie"))
+	assert.Empty(t, second.Header().Get("Set-Cookie"), "the reserver token is not replayed")
+
+	updated := &wishlistv1alpha1.Wish{}
+	err := srv.client.Get(context.Background(), client.ObjectKey{Name: "idem-wish", Namespace: testNamespace}, updated)
+	require.NoError(t, err)
+	assert.Len(t, updated.Status.Reservations, 1)
+}
+
+func TestServer_HandleReserve_IdempotencyKeyDistinct(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("idem-a"), newIdempotencyWish("idem-b"))
+	handler := srv.Handler()
+
+	// Different keys on the same wish reserve twice.
+	assert.Equal(t, http.StatusOK, reserveWithKey(handler, "idem-a", "key-1").Code)
+	assert.Equal(t, http.StatusOK, reserveWithKey(handler, "idem-a", "key-2").Code)
+
+	// Keys are scoped per wish, so reusing key-1 on another wish reserves it.
+	rec := reserveWithKey(handler, "idem-b", "key-1")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(idempotencyReplayed))
+
+	wishA := &wishlistv1alpha1.Wish{}
+	require.NoError(t, srv.client.Get(context.Background(), client.ObjectKey{Name: "idem-a", Namespace: testNamespace}, wishA))
+	assert.Len(t, wishA.Status.Reservations, 2)
+
+	wishB := &wishlistv1alpha1.Wish{}
+	require.NoError(t, srv.client.Get(context.Background(), client.ObjectKey{Name: "idem-b", Namespace: testNamespace}, wishB))
+	assert.Len(t, wishB.Status.Reservations, 1)
+}
+
+func TestServer_HandleReserve_IdempotencyKeyFailureNotCached(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	handler := srv.Handler()
+
+	// A failed request must not poison the key for later retries.
+	assert.Equal(t, http.StatusNotFound, reserveWithKey(handler, "missing", "key-1").Code)
+
+	rec := reserveWithKey(handler, "missing", "key-1")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Empty(t, rec.Header().Get(idempotencyReplayed))
+}
+
+func TestServer_HandleReserve_IdempotencyKeyTooLong(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("idem-long"))
+
+	rec := reserveWithKey(srv.Handler(), "idem-long", strings.Repeat("k", maxIdempotencyKeyLen+1))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+const (
+	imageFetchTimeout = 10 * time.Second
+	imageCacheMaxAge  = 5 * time.Minute
+	maxImageSize      = 5 << 20 // 5 MB
+)
+
+// handleImage proxies an image referenced by a wish so that visitors only
+// talk to this server. Only URLs that some wish uses as its image are
+// fetched, which keeps the endpoint from becoming an open proxy.
+func (s *Server) handleImage(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+	imageURL := r.URL.Query().Get("url")
+
+	parsed, err := url.Parse(imageURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		http.Error(w, i18n.T(lang, "err_image_forbidden"), http.StatusBadRequest)
+
+		return
+	}
+
+	allowed, err := s.isWishImage(r.Context(), imageURL)
+	if err != nil {
+		http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
+
+		return
+	}
+
+	if !allowed {
+		http.Error(w, i18n.T(lang, "err_image_forbidden"), http.StatusForbidden)
+
+		return
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, imageURL, http.NoBody)
+	if err != nil {
+		http.Error(w, i18n.T(lang, "err_image_fetch"), http.StatusBadGateway)
+
+		return
+	}
+
+	resp, err := s.imageClient.Do(req)
+	if err != nil {
+		http.Error(w, i18n.T(lang, "err_image_fetch"), http.StatusBadGateway)
+
+		return
+	}
+	defer resp.Body.Close()
+
+	contentType, ok := imageContentType(resp.Header.Get("Content-Type"))
+	if resp.StatusCode != http.StatusOK || !ok || resp.ContentLength > maxImageSize {
+		http.Error(w, i18n.T(lang, "err_image_fetch"), http.StatusBadGateway)
+
+		return
+	}
+
+	// Read one byte past the limit so oversized bodies without a
+	// Content-Length are still rejected before anything is written.
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxImageSize+1))
+	if err != nil || len(body) > maxImageSize {
+		http.Error(w, i18n.T(lang, "err_image_fetch"), http.StatusBadGateway)
+
+		return
+	}
+
+	etag := strongETag(imageURL, strconv.Itoa(len(body)))
+	setCacheHeaders(w, etag, imageCacheMaxAge)
+
+	if writeNotModified(w, r, etag) {
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	_, _ = w.Write(body)
+}
+
+// isWishImage reports whether imageURL is the image of any wish in the namespace.
+func (s *Server) isWishImage(ctx context.Context, imageURL string) (bool, error) {
+	wishList := &wishlistv1alpha1.WishList{}
+	if err := s.reader.List(ctx, wishList, client.InNamespace(s.namespaceFor(ctx))); err != nil {
+		return false, err
+	}
+
+	for i := range wishList.Items {
+		if wishList.Items[i].Spec.ImageURL == imageURL {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// imageContentType validates an upstream Content-Type and returns the media
+// type to serve. SVG is refused because it can carry scripts that would run
+// on this origin.
+func imageContentType(header string) (string, bool) {
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil || !strings.HasPrefix(mediaType, "image/") || mediaType == "image/svg+xml" {
+		return "", false
+	}
+
+	return mediaType, true
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+var testPNG = []byte("\x89PNG\r\n\x1a\nfake image data")
+
+func newImageUpstream(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/gift.png", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(testPNG)
+	})
+	mux.HandleFunc("/page.html", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte("<html></html>"))
+	})
+
+	upstream := httptest.NewServer(mux)
+	t.Cleanup(upstream.Close)
+
+	return upstream
+}
+
+func newImageWish(name, imageURL string) *wishlistv1alpha1.Wish {
+	return &wishlistv1alpha1.Wish{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+		Spec: wishlistv1alpha1.WishSpec{
+			Title:    testTitleGift,
+			ImageURL: imageURL,
+		},
+		Status: wishlistv1alpha1.WishStatus{
+			Active: true,
+		},
+	}
+}
+
+func getImage(t *testing.T, srv *Server, imageURL, ifNoneMatch string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "/img?url="+url.QueryEscape(imageURL), nil)
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandleImage_Valid(t *testing.T) {
+	t.Parallel()
+
+	upstream := newImageUpstream(t)
+	imageURL := upstream.URL + "/gift.png"
+
+	srv := newTestServer(t, newImageWish("with-image", imageURL))
+	WithImageProxy(upstream.Client())(srv)
+
+	rec := getImage(t, srv, imageURL, "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	assert.Contains(t, rec.Header().Get("Cache-Control"), "max-age=300")
+	assert.Equal(t, testPNG, rec.Body.Bytes())
+
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	cached := getImage(t, srv, imageURL, etag)
+	assert.Equal(t, http.StatusNotModified, cached.Code)
+	assert.Empty(t, cached.Body.Bytes())
+}
+
+func TestServer_HandleImage_Rejected(t *testing.T) {
+	t.Parallel()
+
+	upstream := newImageUpstream(t)
+	pageURL := upstream.URL + "/page.html"
+
+	srv := newTestServer(t,
+		newImageWish("with-image", upstream.URL+"/gift.png"),
+		newImageWish("with-page", pageURL),
+	)
+	WithImageProxy(upstream.Client())(srv)
+
+	tests := []struct {
+		name     string
+		imageURL string
+		want     int
+	}{
+		{name: "not on any wish", imageURL: upstream.URL + "/other.png", want: http.StatusForbidden},
+		{name: "non-image content", imageURL: pageURL, want: http.StatusBadGateway},
+		{name: "unsupported scheme", imageURL: "file:///etc/passwd", want: http.StatusBadRequest},
+		{name: "missing url", imageURL: "", want: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, getImage(t, srv, tt.imageURL, "").Code)
+		})
+	}
+}
+
+func TestServer_HandleImage_Disabled(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	// Without the proxy, /img falls through to the index page.
+	rec := getImage(t, srv, "https://example.com/gift.png", "")
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+}
+
+func TestServer_ImageProxyRendersProxiedSrc(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newImageWish("with-image", "https://example.com/gift.png"))
+	WithImageProxy(nil)(srv)
+
+	req := httptest.NewRequest(http.MethodGet, "/wishes", nil)
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `src="/img?url=https%3A%2F%2Fexample.com%2Fgift.png"`)
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// languagePrefixMiddleware serves /ru/..., /zh/... and /en/... by the same
+// handlers as the unprefixed paths, with the language pinned to the prefix.
+// Paths whose first segment is not a supported language pass through as is,
+// leaving the language to DetectLanguage's usual rules.
+func languagePrefixMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lang, rest, ok := i18n.SplitPathPrefix(r.URL.Path)
+		if !ok {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		stripped := r.Clone(i18n.WithLanguage(r.Context(), lang))
+		stripped.URL.Path = rest
+		stripped.URL.RawPath = ""
+
+		next.ServeHTTP(w, stripped)
+	})
+}
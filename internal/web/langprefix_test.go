@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_LanguagePrefix(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("gift"))
+	handler := srv.Handler()
+
+	tests := []struct {
+		name      string
+		path      string
+		wantTitle string
+		canonical string
+	}{
+		{name: "russian index", path: "/ru/", wantTitle: "Список желаний", canonical: `href="/ru/"`},
+		{name: "prefix without slash", path: "/ru", wantTitle: "Список желаний", canonical: `href="/ru/"`},
+		{name: "russian permalink", path: "/ru/wishes/gift", wantTitle: "Список желаний", canonical: `href="/ru/wishes/gift"`},
+		{name: "unknown prefix falls back", path: "/xx/", wantTitle: "Wishlist", canonical: `href="/en/"`},
+		{name: "no prefix", path: "/", wantTitle: "Wishlist", canonical: `href="/en/"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Contains(t, rec.Body.String(), "<title>"+tc.wantTitle+"</title>")
+			assert.Contains(t, rec.Body.String(), `<link rel="canonical" `+tc.canonical)
+		})
+	}
+}
+
+func TestServer_LanguagePrefix_OverridesHeader(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("gift"))
+
+	req := httptest.NewRequest(http.MethodGet, "/zh/", nil)
+	req.Header.Set("Accept-Language", "ru")
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	assert.Contains(t, rec.Body.String(), `<html lang="zh">`)
+}
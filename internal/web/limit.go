@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// WithReserverLimit caps how many active reservations, pending ones
+// included, a single reserver token may hold across all wishes in the
+// namespace, so one giver can't hoard the list. Zero disables the cap.
+func WithReserverLimit(limit int) Option {
+	return func(s *Server) {
+		s.reserverLimit = limit
+	}
+}
+
+// reservationsHeld counts the active reservations held by tokenHash across
+// the namespace's wishes. It reads through the client, not the cache, since
+// the count gates a write.
+func (s *Server) reservationsHeld(ctx context.Context, tokenHash string, now time.Time) (int, error) {
+	wishList := &wishlistv1alpha1.WishList{}
+	if err := s.client.List(ctx, wishList, client.InNamespace(s.namespaceFor(ctx))); err != nil {
+		return 0, fmt.Errorf("listing wishes: %w", err)
+	}
+
+	held := 0
+
+	for i := range wishList.Items {
+		for _, res := range wishList.Items[i].ActiveReservationsAt(now) {
+			if res.TokenHash == tokenHash {
+				held++
+			}
+		}
+	}
+
+	return held, nil
+}
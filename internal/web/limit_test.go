@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func reserveAs(handler http.Handler, name, token string) *httptest.ResponseRecorder {
+	form := url.Values{"weeks": {"2"}}
+
+	req := httptest.NewRequest(http.MethodPost, "/wishes/"+name+"/reserve", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.AddCookie(&http.Cookie{Name: reserverCookie, Value: token})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandleReserve_ReserverLimit(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	held := newIdempotencyWish("held")
+	held.Status.Reservations = []wishlistv1alpha1.Reservation{{
+		Quantity:  1,
+		CreatedAt: metav1.NewTime(now.Add(-time.Hour)),
+		ExpiresAt: metav1.NewTime(now.Add(time.Hour)),
+		TokenHash: hashToken("giver"),
+	}}
+
+	lapsed := newIdempotencyWish("lapsed")
+	lapsed.Status.Reservations = []wishlistv1alpha1.Reservation{{
+		Quantity:  1,
+		CreatedAt: metav1.NewTime(now.Add(-2 * time.Hour)),
+		ExpiresAt: metav1.NewTime(now.Add(-time.Hour)),
+		TokenHash: hashToken("giver"),
+	}}
+
+	srv := newTestServer(t, held, lapsed, newIdempotencyWish("lamp"), newIdempotencyWish("vase"))
+	WithReserverLimit(2)(srv)
+
+	handler := srv.Handler()
+
+	// Below the cap: one active reservation, the lapsed one doesn't count
+	assert.Equal(t, http.StatusOK, reserveAs(handler, "lamp", "giver").Code)
+
+	// At the cap
+	rec := reserveAs(handler, "vase", "giver")
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Reservation limit per giver reached (2)")
+	assert.Empty(t, getFundWish(t, srv, "vase").Status.Reservations)
+
+	// Other givers are unaffected
+	assert.Equal(t, http.StatusOK, reserveAs(handler, "vase", "someone-else").Code)
+}
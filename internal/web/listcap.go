@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// WithMaxListed caps how many wishes the list shows, as a safeguard against
+// an accidentally huge list degrading the page. The cap applies after
+// sorting, so the wishes kept are the first by list order. Zero disables it.
+func WithMaxListed(limit int) Option {
+	return func(s *Server) {
+		s.maxListed = limit
+	}
+}
+
+// capListed truncates sorted wishes to the configured cap, logging when it
+// drops any.
+func (s *Server) capListed(ctx context.Context, wishes []wishlistv1alpha1.Wish) []wishlistv1alpha1.Wish {
+	if s.maxListed <= 0 || len(wishes) <= s.maxListed {
+		return wishes
+	}
+
+	logf.FromContext(ctx).Info("Wish list truncated, raise --max-listed-wishes to show all",
+		"shown", s.maxListed, "total", len(wishes))
+
+	return wishes[:s.maxListed]
+}
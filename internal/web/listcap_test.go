@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_MaxListed(t *testing.T) {
+	t.Parallel()
+
+	srv := newPriorityServer(t)
+	assert.Equal(t, []string{"top", "mid", "low", "unset"}, priorityListNames(t, srv, ""))
+
+	WithMaxListed(2)(srv)
+	assert.Equal(t, []string{"top", "mid"}, priorityListNames(t, srv, ""))
+
+	// The cap applies after other filters
+	assert.Equal(t, []string{"top"}, priorityListNames(t, srv, "&min_priority=5"))
+}
+
+func TestServer_MaxListed_KeepsExplicitOrder(t *testing.T) {
+	t.Parallel()
+
+	order := int32(1)
+	pinned := newSummaryWish("pinned", 1, 0)
+	pinned.Spec.Order = &order
+
+	top := newSummaryWish("top", 1, 0)
+	top.Spec.Priority = 5
+
+	srv := newTestServer(t, newSummaryWish("plain", 1, 0), top, pinned)
+	WithMaxListed(2)(srv)
+
+	assert.Equal(t, []string{"pinned", "top"}, priorityListNames(t, srv, ""))
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/time/rate"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/notify"
+)
+
+const (
+	maxMessageLength = 500
+	messageBurst     = 3
+)
+
+// messageRate allows one owner message per minute per IP once the burst is spent.
+var messageRate = rate.Every(time.Minute) //nolint:gochecknoglobals // immutable rate constant
+
+// handleMessage forwards a short question from a giver to the wish owner
+// through the configured notifier. Only wishes with an OwnerContact accept
+// messages.
+func (s *Server) handleMessage(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+	name := r.PathValue("name")
+
+	if limiter := loadLimiter(&s.messageLimiters, s.getClientIP(r), messageRate, messageBurst); !limiter.Allow() {
+		writeRateLimited(w, r, limiter)
+
+		return
+	}
+
+	if !s.parseForm(w, r, lang) {
+		return
+	}
+
+	message := strings.TrimSpace(r.FormValue("message"))
+	if message == "" || utf8.RuneCountInString(message) > maxMessageLength {
+		http.Error(w, fmt.Sprintf(i18n.T(lang, "err_message_length"), maxMessageLength), http.StatusBadRequest)
+
+		return
+	}
+
+	wish := &wishlistv1alpha1.Wish{}
+	if err := s.client.Get(r.Context(), s.objectKey(r, name), wish); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, i18n.T(lang, "err_get_wish"), http.StatusInternalServerError)
+
+		return
+	}
+
+	if !wish.Status.Active || wish.Spec.OwnerContact == "" {
+		http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
+
+		return
+	}
+
+	err := s.notifier.Send(r.Context(), notify.Event{
+		Type:      notify.EventOwnerMessage,
+		Namespace: wish.Namespace,
+		Wish:      wish.Name,
+		Title:     wish.Spec.Title,
+		Message:   message,
+		Time:      s.clock.Now().UTC(),
+	})
+	if err != nil {
+		http.Error(w, i18n.T(lang, "err_message_failed"), http.StatusBadGateway)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte(i18n.T(lang, "message_sent")))
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/notify"
+)
+
+const testContactWishName = "contact-wish"
+
+var errTestNotify = errors.New("notify failed")
+
+type fakeNotifier struct {
+	mu     sync.Mutex
+	events []notify.Event
+	err    error
+}
+
+func (f *fakeNotifier) Send(_ context.Context, event notify.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.err != nil {
+		return f.err
+	}
+
+	f.events = append(f.events, event)
+
+	return nil
+}
+
+func newContactWish(contact string) *wishlistv1alpha1.Wish {
+	return &wishlistv1alpha1.Wish{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testContactWishName,
+			Namespace: testNamespace,
+		},
+		Spec: wishlistv1alpha1.WishSpec{
+			Title:        testTitleGift,
+			OwnerContact: contact,
+		},
+		Status: wishlistv1alpha1.WishStatus{
+			Active: true,
+		},
+	}
+}
+
+func postMessage(handler http.Handler, message string) *httptest.ResponseRecorder {
+	form := url.Values{}
+	form.Set("message", message)
+
+	req := httptest.NewRequest(http.MethodPost, "/wishes/"+testContactWishName+"/message", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_OwnerContactRendering(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		contact string
+		want    string
+	}{
+		{"url contact is a link", "https://t.me/alice", `href="https://t.me/alice"`},
+		{"mailto contact is a link", "mailto:alice@example.com", `href="mailto:alice@example.com"`},
+		{"handle is plain text", "@alice", "Ask owner: @alice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newTestServer(t, newContactWish(tt.contact))
+
+			req := httptest.NewRequest(http.MethodGet, "/?lang=en", nil)
+			rec := httptest.NewRecorder()
+			srv.Handler().ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.want)
+		})
+	}
+}
+
+func TestServer_OwnerContactUnsafeScheme(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newContactWish("javascript:alert(1)"))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	assert.NotContains(t, rec.Body.String(), `href="javascript:`)
+}
+
+func TestServer_HandleMessage(t *testing.T) {
+	t.Parallel()
+
+	notifier := &fakeNotifier{}
+	srv := newTestServer(t, newContactWish("@alice"))
+	WithNotifier(notifier)(srv)
+
+	rec := postMessage(srv.Handler(), "  Which color do you prefer?  ")
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, notifier.events, 1)
+	assert.Equal(t, notify.EventOwnerMessage, notifier.events[0].Type)
+	assert.Equal(t, testContactWishName, notifier.events[0].Wish)
+	assert.Equal(t, "Which color do you prefer?", notifier.events[0].Message)
+}
+
+func TestServer_HandleMessage_Validation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		contact  string
+		message  string
+		expected int
+	}{
+		{"empty message", "@alice", "   ", http.StatusBadRequest},
+		{"at rune limit", "@alice", strings.Repeat("愿", maxMessageLength), http.StatusOK}, //nolint:gosmopolitan // multibyte boundary
+		{"over rune limit", "@alice", strings.Repeat("a", maxMessageLength+1), http.StatusBadRequest},
+		{"wish without contact", "", "hello", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			notifier := &fakeNotifier{}
+			srv := newTestServer(t, newContactWish(tt.contact))
+			WithNotifier(notifier)(srv)
+
+			rec := postMessage(srv.Handler(), tt.message)
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
+
+func TestServer_HandleMessage_RateLimited(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newContactWish("@alice"))
+	WithNotifier(&fakeNotifier{})(srv)
+
+	handler := srv.Handler()
+
+	for range messageBurst {
+		assert.Equal(t, http.StatusOK, postMessage(handler, "hi").Code)
+	}
+
+	assert.Equal(t, http.StatusTooManyRequests, postMessage(handler, "hi").Code)
+}
+
+func TestServer_HandleMessage_NotifierFailure(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newContactWish("@alice"))
+	WithNotifier(&fakeNotifier{err: errTestNotify})(srv)
+
+	assert.Equal(t, http.StatusBadGateway, postMessage(srv.Handler(), "hi").Code)
+}
+
+func TestServer_HandleMessage_DisabledWithoutNotifier(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newContactWish("@alice"))
+
+	assert.NotEqual(t, http.StatusOK, postMessage(srv.Handler(), "hi").Code)
+}
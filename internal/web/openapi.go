@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+// openAPIDocument describes the public API for clients such as companion
+// apps. It is maintained by hand; tests check it against the response types.
+//
+//go:embed openapi.json
+var openAPIDocument []byte
+
+// handleOpenAPI serves the OpenAPI 3 document of the public API.
+func (s *Server) handleOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", staticCacheControl)
+	_, _ = w.Write(openAPIDocument)
+}
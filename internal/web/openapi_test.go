@@ -0,0 +1,264 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loadOpenAPI fetches the served OpenAPI document.
+func loadOpenAPI(t *testing.T) map[string]any {
+	t.Helper()
+
+	rec := getPath(newTestServer(t).Handler(), "/api/openapi.json")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+
+	return doc
+}
+
+// resolveRef follows a local "#/..." reference within the document.
+func resolveRef(t *testing.T, doc map[string]any, ref string) map[string]any {
+	t.Helper()
+
+	require.True(t, strings.HasPrefix(ref, "#/"), ref)
+
+	var node any = doc
+	for part := range strings.SplitSeq(strings.TrimPrefix(ref, "#/"), "/") {
+		obj, ok := node.(map[string]any)
+		require.True(t, ok, ref)
+
+		node, ok = obj[part]
+		require.True(t, ok, "unresolved reference %s", ref)
+	}
+
+	resolved, ok := node.(map[string]any)
+	require.True(t, ok, ref)
+
+	return resolved
+}
+
+// validateJSON checks a decoded JSON value against the subset of JSON Schema
+// used by the document: type, required, properties, additionalProperties,
+// items, enum and $ref.
+func validateJSON(t *testing.T, doc, schema map[string]any, value any, path string) {
+	t.Helper()
+
+	if ref, ok := schema["$ref"].(string); ok {
+		schema = resolveRef(t, doc, ref)
+	}
+
+	if enum, ok := schema["enum"].([]any); ok {
+		assert.Contains(t, enum, value, path)
+	}
+
+	switch schema["type"] {
+	case "object":
+		obj, ok := value.(map[string]any)
+		require.True(t, ok, "%s: want object, got %T", path, value)
+
+		properties, _ := schema["properties"].(map[string]any)
+
+		required, _ := schema["required"].([]any)
+		for _, name := range required {
+			assert.Contains(t, obj, name, "%s: missing required property", path)
+		}
+
+		for name, field := range obj {
+			propSchema, ok := properties[name].(map[string]any)
+			if !ok {
+				assert.NotEqual(t, false, schema["additionalProperties"], "%s: unexpected property %q", path, name)
+
+				continue
+			}
+
+			validateJSON(t, doc, propSchema, field, path+"."+name)
+		}
+	case "array":
+		items, ok := value.([]any)
+		require.True(t, ok, "%s: want array, got %T", path, value)
+
+		itemSchema, _ := schema["items"].(map[string]any)
+		for i, item := range items {
+			validateJSON(t, doc, itemSchema, item, fmt.Sprintf("%s[%d]", path, i))
+		}
+	case "string":
+		str, ok := value.(string)
+		require.True(t, ok, "%s: want string, got %T", path, value)
+
+		if schema["format"] == "date-time" {
+			_, err := time.Parse(time.RFC3339, str)
+			assert.NoError(t, err, path)
+		}
+	case "integer":
+		number, ok := value.(float64)
+		require.True(t, ok, "%s: want integer, got %T", path, value)
+		assert.Equal(t, float64(int64(number)), number, "%s: want integer", path)
+	case "boolean":
+		_, ok := value.(bool)
+		assert.True(t, ok, "%s: want boolean, got %T", path, value)
+	}
+}
+
+// collectRefs returns every $ref value found in node.
+func collectRefs(node any) []string {
+	var refs []string
+
+	switch n := node.(type) {
+	case map[string]any:
+		for key, value := range n {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				refs = append(refs, ref)
+			}
+
+			refs = append(refs, collectRefs(value)...)
+		}
+	case []any:
+		for _, value := range n {
+			refs = append(refs, collectRefs(value)...)
+		}
+	}
+
+	return refs
+}
+
+func TestServer_OpenAPI_Document(t *testing.T) {
+	t.Parallel()
+
+	doc := loadOpenAPI(t)
+
+	version, _ := doc["openapi"].(string)
+	assert.True(t, strings.HasPrefix(version, "3."), "openapi version %q", version)
+
+	for _, ref := range collectRefs(doc) {
+		resolveRef(t, doc, ref)
+	}
+
+	paths, ok := doc["paths"].(map[string]any)
+	require.True(t, ok)
+
+	routes := []struct {
+		method string
+		path   string
+	}{
+		{"get", "/wishes"},
+		{"get", "/wishes/archive"},
+		{"get", "/wishes/compare"},
+		{"get", "/wishes/{name}"},
+		{"post", "/wishes/{name}/reserve"},
+		{"post", "/wishes/{name}/unreserve"},
+		{"post", "/wishes/{name}/contribute"},
+		{"post", "/wishes/{name}/close-group"},
+		{"get", "/api/openapi.json"},
+	}
+
+	for _, route := range routes {
+		item, ok := paths[route.path].(map[string]any)
+		if assert.True(t, ok, "missing path %s", route.path) {
+			assert.Contains(t, item, route.method, route.path)
+		}
+	}
+}
+
+// jsonFields returns the JSON names of a struct's fields and which of them
+// are always present.
+func jsonFields(typ reflect.Type) (names, required []string) {
+	for field := range typ.Fields() {
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		names = append(names, name)
+
+		if !strings.Contains(opts, "omitempty") {
+			required = append(required, name)
+		}
+	}
+
+	return names, required
+}
+
+func TestServer_OpenAPI_SchemasMatchResponseTypes(t *testing.T) {
+	t.Parallel()
+
+	doc := loadOpenAPI(t)
+
+	tests := map[string]reflect.Type{
+		"Wish":         reflect.TypeFor[publicWish](),
+		"Reservation":  reflect.TypeFor[publicReservation](),
+		"ArchivedWish": reflect.TypeFor[archiveItem](),
+	}
+
+	for name, typ := range tests {
+		schema := resolveRef(t, doc, "#/components/schemas/"+name)
+		names, required := jsonFields(typ)
+
+		properties, _ := schema["properties"].(map[string]any)
+		assert.ElementsMatch(t, names, slices.Collect(maps.Keys(properties)), name)
+
+		var schemaRequired []string
+		if list, ok := schema["required"].([]any); ok {
+			for _, field := range list {
+				schemaRequired = append(schemaRequired, fmt.Sprint(field))
+			}
+		}
+
+		assert.ElementsMatch(t, required, schemaRequired, name)
+	}
+}
+
+func TestServer_OpenAPI_ResponsesMatchSchemas(t *testing.T) {
+	t.Parallel()
+
+	wish := newNotedWish("noted")
+	priceMin, priceMax := int64(100), int64(200)
+	wish.Spec.PriceMin = &priceMin
+	wish.Spec.PriceMax = &priceMax
+	wish.Spec.Currency = "$"
+	wish.Spec.Priority = 3
+	wish.Spec.Tags = []string{"home"}
+	wish.Spec.PurchaseURLs = []string{"https://example.com/buy"}
+
+	wishes := append(archiveFixtures(), wish)
+	handler := newTestServer(t, wishes...).Handler()
+	doc := loadOpenAPI(t)
+
+	tests := []struct {
+		path   string
+		schema map[string]any
+	}{
+		{"/wishes?format=json", map[string]any{
+			"type": "array", "items": map[string]any{"$ref": "#/components/schemas/Wish"},
+		}},
+		{"/wishes/noted?format=json", map[string]any{"$ref": "#/components/schemas/Wish"}},
+		{"/wishes/archive?format=json", map[string]any{
+			"type": "array", "items": map[string]any{"$ref": "#/components/schemas/ArchivedWish"},
+		}},
+	}
+
+	for _, tt := range tests {
+		rec := getPath(handler, tt.path)
+		require.Equal(t, http.StatusOK, rec.Code, tt.path)
+
+		var body any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body), tt.path)
+
+		if list, ok := body.([]any); ok {
+			require.NotEmpty(t, list, tt.path)
+		}
+
+		validateJSON(t, doc, tt.schema, body, tt.path)
+	}
+}
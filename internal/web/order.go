@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// maxOrderNames bounds how many wishes one ordering request may place.
+const maxOrderNames = 1000
+
+// orderResult is the JSON body of POST /admin/wishes/order. Missing lists
+// the requested names with no wish in the namespace; Failed lists the
+// wishes whose update was rejected.
+type orderResult struct {
+	Updated int      `json:"updated"`
+	Missing []string `json:"missing,omitempty"`
+	Failed  []string `json:"failed,omitempty"`
+}
+
+// parseOrderNames reads the repeated name form field, reporting false if it
+// is empty, too long or names a wish twice.
+func parseOrderNames(r *http.Request) ([]string, bool) {
+	names := r.Form["name"]
+	if len(names) == 0 || len(names) > maxOrderNames {
+		return nil, false
+	}
+
+	seen := make(map[string]struct{}, len(names))
+
+	for _, name := range names {
+		if _, dup := seen[name]; dup || name == "" {
+			return nil, false
+		}
+
+		seen[name] = struct{}{}
+	}
+
+	return names, true
+}
+
+// handleAdminOrder sets Spec.Order of the wishes in the repeated name form
+// field to their position in it, so the list shows them in that order ahead
+// of unordered wishes, e.g. after dragging them around. Wishes left out lose
+// any earlier order and go back among the unordered ones, so a stale position
+// can't tie with a new one. Names with no wish are reported rather than refused, since a
+// wish may be deleted while the list is being rearranged. A wish that fails
+// to update does not stop the rest; the response lists it and the status is
+// 500.
+func (s *Server) handleAdminOrder(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	if !s.parseForm(w, r, lang) {
+		return
+	}
+
+	names, ok := parseOrderNames(r)
+	if !ok {
+		http.Error(w, i18n.T(lang, "err_order_names"), http.StatusBadRequest)
+
+		return
+	}
+
+	wishList := &wishlistv1alpha1.WishList{}
+	if err := s.client.List(r.Context(), wishList, client.InNamespace(s.namespaceFor(r.Context()))); err != nil {
+		http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
+
+		return
+	}
+
+	byName := make(map[string]*wishlistv1alpha1.Wish, len(wishList.Items))
+	for i := range wishList.Items {
+		byName[wishList.Items[i].Name] = &wishList.Items[i]
+	}
+
+	result := orderResult{}
+	orders := make(map[string]*int32, len(byName))
+
+	for position, name := range names {
+		if _, ok := byName[name]; !ok {
+			result.Missing = append(result.Missing, name)
+
+			continue
+		}
+
+		order := int32(position) //nolint:gosec // bounded by maxOrderNames
+		orders[name] = &order
+	}
+
+	for i := range wishList.Items {
+		wish := &wishList.Items[i]
+
+		order := orders[wish.Name]
+		if ptr.Equal(wish.Spec.Order, order) {
+			continue
+		}
+
+		wish.Spec.Order = order
+
+		if err := s.client.Update(r.Context(), wish); err != nil {
+			logf.FromContext(r.Context()).Error(err, "Failed to update wish order", "wish", wish.Name)
+			result.Failed = append(result.Failed, wish.Name)
+
+			continue
+		}
+
+		result.Updated++
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if len(result.Failed) > 0 {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+
+	_ = json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func orderWishes(srv *Server, token string, names ...string) *httptest.ResponseRecorder {
+	form := url.Values{"name": names}
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/wishes/order", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func newOrderWish(name, title string, priority int32) *wishlistv1alpha1.Wish {
+	wish := newSummaryWish(name, 1, 0)
+	wish.Spec.Title = title
+	wish.Spec.Priority = priority
+
+	return wish
+}
+
+// listedTitles returns the titles in the order the public list shows them.
+func listedTitles(t *testing.T, srv *Server, titles ...string) []string {
+	t.Helper()
+
+	body := getWishes(srv).Body.String()
+	positions := make(map[string]int, len(titles))
+
+	for _, title := range titles {
+		positions[title] = strings.Index(body, title)
+		require.NotEqual(t, -1, positions[title], title)
+	}
+
+	ordered := slices.Clone(titles)
+	slices.SortFunc(ordered, func(a, b string) int { return positions[a] - positions[b] })
+
+	return ordered
+}
+
+func TestServer_HandleAdminOrder(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t,
+		newOrderWish("lamp", "Desk Lamp", 5),
+		newOrderWish("book", "Cook Book", 3),
+		newOrderWish("kite", "Red Kite", 1),
+		newOrderWish("mug", "Tea Mug", 4),
+	)
+	WithAdminToken(testAdminToken)(srv)
+
+	titles := []string{"Desk Lamp", "Cook Book", "Red Kite", "Tea Mug"}
+	require.Equal(t, []string{"Desk Lamp", "Tea Mug", "Cook Book", "Red Kite"}, listedTitles(t, srv, titles...),
+		"by priority before ordering")
+
+	rec := orderWishes(srv, testAdminToken, "kite", "gone", "book", "lamp")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+
+	var result orderResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, orderResult{Updated: 3, Missing: []string{"gone"}}, result)
+
+	assert.Equal(t, []string{"Red Kite", "Cook Book", "Desk Lamp", "Tea Mug"}, listedTitles(t, srv, titles...),
+		"ordered wishes first, unordered after")
+
+	require.NotNil(t, getFundWish(t, srv, "book").Spec.Order)
+	assert.Equal(t, int32(2), *getFundWish(t, srv, "book").Spec.Order)
+	assert.Nil(t, getFundWish(t, srv, "mug").Spec.Order)
+
+	require.Equal(t, http.StatusOK, orderWishes(srv, testAdminToken, "lamp", "book", "kite", "mug").Code)
+	assert.Equal(t, []string{"Desk Lamp", "Cook Book", "Red Kite", "Tea Mug"}, listedTitles(t, srv, titles...),
+		"reordered")
+}
+
+func TestServer_HandleAdminOrder_Partial(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t,
+		newOrderWish("a", "Apple", 1),
+		newOrderWish("b", "Banana", 5),
+		newOrderWish("c", "Cherry", 1),
+	)
+	WithAdminToken(testAdminToken)(srv)
+
+	titles := []string{"Apple", "Banana", "Cherry"}
+
+	require.Equal(t, http.StatusOK, orderWishes(srv, testAdminToken, "a", "b", "c").Code)
+	require.Equal(t, []string{"Apple", "Banana", "Cherry"}, listedTitles(t, srv, titles...))
+
+	rec := orderWishes(srv, testAdminToken, "c", "a")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var result orderResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, orderResult{Updated: 3}, result)
+
+	assert.Nil(t, getFundWish(t, srv, "b").Spec.Order, "a wish left out loses its old position")
+	assert.Equal(t, []string{"Cherry", "Apple", "Banana"}, listedTitles(t, srv, titles...),
+		"the left-out wish goes after the ordered ones")
+}
+
+func TestServer_HandleAdminOrder_Rejects(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newOrderWish("lamp", "Desk Lamp", 5))
+	WithAdminToken(testAdminToken)(srv)
+
+	tests := []struct {
+		name  string
+		token string
+		names []string
+		want  int
+	}{
+		{name: "no token", names: []string{"lamp"}, want: http.StatusUnauthorized},
+		{name: "no names", token: testAdminToken, want: http.StatusBadRequest},
+		{name: "duplicate", token: testAdminToken, names: []string{"lamp", "lamp"}, want: http.StatusBadRequest},
+		{name: "blank name", token: testAdminToken, names: []string{"lamp", ""}, want: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, orderWishes(srv, tt.token, tt.names...).Code)
+		})
+	}
+
+	assert.Nil(t, getFundWish(t, srv, "lamp").Spec.Order)
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"crypto/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/templates"
+)
+
+// defaultPendingTTL is how long a pending reservation is held for the giver
+// to confirm it. The controller drops it afterwards.
+const defaultPendingTTL = 10 * time.Minute
+
+// parseConfirmStep reads the confirm query parameter of a reserve request.
+// Without it the reservation is made in one step; confirm=false asks for a
+// pending reservation and confirm=true commits one. ok is false for any
+// other value.
+func parseConfirmStep(r *http.Request) (pending, confirm, ok bool) {
+	raw := r.URL.Query().Get("confirm")
+	if raw == "" {
+		return false, false, true
+	}
+
+	confirm, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, false, false
+	}
+
+	return !confirm, confirm, true
+}
+
+// holdPending stores reservation as pending, to be confirmed within the
+// pending TTL, and renders the confirmation step with its token, carrying
+// over the giver's contact for the confirmation message.
+func (s *Server) holdPending(
+	w http.ResponseWriter, r *http.Request, wish *wishlistv1alpha1.Wish, reservation wishlistv1alpha1.Reservation,
+	contact string,
+) {
+	lang := i18n.DetectLanguage(r)
+	token := rand.Text()
+
+	pendingUntil := metav1.NewTime(reservation.CreatedAt.Add(s.pendingTTL))
+	reservation.PendingUntil = &pendingUntil
+	reservation.PendingTokenHash = hashToken(token)
+	wish.Status.Reservations = append(wish.Status.Reservations, reservation)
+
+	if err := s.client.Status().Update(r.Context(), wish); err != nil {
+		http.Error(w, i18n.T(lang, "err_reserve_failed"), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	holdMinutes := int(s.pendingTTL.Round(time.Minute) / time.Minute)
+	if err := templates.ReserveConfirm(wish, reservation.Quantity, max(holdMinutes, 1), token, contact, lang).
+		Render(r.Context(), w); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+	}
+}
+
+// confirmReservation commits the pending reservation matching the request's
+// pending token. Lapsed or unknown tokens get 409 so the giver starts over.
+func (s *Server) confirmReservation(w http.ResponseWriter, r *http.Request, name string) {
+	lang := i18n.DetectLanguage(r)
+
+	token := r.FormValue("pending")
+	if token == "" {
+		writeReserveError(w, r, i18n.T(lang, "err_pending_expired"), http.StatusConflict)
+
+		return
+	}
+
+	wish := &wishlistv1alpha1.Wish{}
+	if err := s.client.Get(r.Context(), s.objectKey(r, name), wish); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, i18n.T(lang, "err_get_wish"), http.StatusInternalServerError)
+
+		return
+	}
+
+	res := findPending(wish, hashToken(token), s.clock.Now())
+	if res == nil {
+		writeReserveError(w, r, i18n.T(lang, "err_pending_expired"), http.StatusConflict)
+
+		return
+	}
+
+	res.PendingUntil = nil
+	res.PendingTokenHash = ""
+
+	if wish.Spec.GroupGift && wish.Status.Coordinator == "" {
+		wish.Status.Coordinator = res.TokenHash
+	}
+
+	tokenHash := res.TokenHash
+	confirmed := *res
+
+	if err := s.client.Status().Update(r.Context(), wish); err != nil {
+		http.Error(w, i18n.T(lang, "err_reserve_failed"), http.StatusInternalServerError)
+
+		return
+	}
+
+	// The release link needs the reserver token, which only the giver's own
+	// cookie carries
+	contact, ok := parseContact(r)
+	if reserver := reserverTokenFromRequest(r); ok && contact != "" && s.notifier != nil && hashToken(reserver) == tokenHash {
+		s.sendConfirmation(r.Context(), s.reservationConfirmation(wish, &confirmed, reserver, contact, lang))
+	}
+
+	ctx := s.viewerContext(r.Context(), wish, tokenHash)
+
+	setReservedTrigger(w, wish)
+	s.redactWish(wish)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	if err := templates.WishCard(wish, lang).Render(ctx, w); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+	}
+}
+
+// findPending returns the wish's pending reservation confirmed by
+// pendingHash, or nil if there is none or it lapsed before now.
+func findPending(wish *wishlistv1alpha1.Wish, pendingHash string, now time.Time) *wishlistv1alpha1.Reservation {
+	for i := range wish.Status.Reservations {
+		res := &wish.Status.Reservations[i]
+		if res.IsPending() && res.PendingTokenHash == pendingHash && res.PendingUntil.After(now) {
+			return res
+		}
+	}
+
+	return nil
+}
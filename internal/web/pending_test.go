@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+var pendingTokenPattern = regexp.MustCompile(`name="pending" value="([^"]+)"`)
+
+func reserveStep(handler http.Handler, name, confirm string, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/wishes/"+name+"/reserve?confirm="+confirm, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+// holdPendingReservation reserves quantity 2 of the wish in pending state and
+// returns the confirmation token from the rendered confirm step.
+func holdPendingReservation(t *testing.T, handler http.Handler, name string) string {
+	t.Helper()
+
+	rec := reserveStep(handler, name, "false", url.Values{"weeks": {"2"}, "quantity": {"2"}})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	match := pendingTokenPattern.FindStringSubmatch(rec.Body.String())
+	require.Len(t, match, 2)
+
+	return match[1]
+}
+
+func TestServer_HandleReserve_PendingHold(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	WithReserveConfirmation(15 * time.Minute)(srv)
+
+	token := holdPendingReservation(t, srv.Handler(), "lamp")
+
+	wish := getFundWish(t, srv, "lamp")
+	require.Len(t, wish.Status.Reservations, 1)
+
+	res := wish.Status.Reservations[0]
+	assert.True(t, res.IsPending())
+	assert.Equal(t, hashToken(token), res.PendingTokenHash)
+	assert.WithinDuration(t, time.Now().Add(15*time.Minute), res.PendingUntil.Time, time.Minute)
+	assert.Equal(t, int32(3), wish.AvailableQuantity(), "a pending hold keeps its quantity")
+}
+
+func TestServer_HandleReserve_Confirm(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	handler := srv.Handler()
+
+	token := holdPendingReservation(t, handler, "lamp")
+
+	rec := reserveStep(handler, "lamp", "true", url.Values{"pending": {token}})
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), token)
+
+	wish := getFundWish(t, srv, "lamp")
+	require.Len(t, wish.Status.Reservations, 1)
+	assert.False(t, wish.Status.Reservations[0].IsPending())
+	assert.Empty(t, wish.Status.Reservations[0].PendingTokenHash)
+	assert.Equal(t, int32(2), wish.TotalReserved())
+
+	// The token is spent once the reservation is confirmed
+	rec = reserveStep(handler, "lamp", "true", url.Values{"pending": {token}})
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestServer_HandleReserve_ConfirmRejects(t *testing.T) {
+	t.Parallel()
+
+	lapsed := newIdempotencyWish("lapsed")
+	now := time.Now()
+	pendingUntil := metav1.NewTime(now.Add(-time.Minute))
+	lapsed.Status.Reservations = []wishlistv1alpha1.Reservation{{
+		Quantity:         1,
+		CreatedAt:        metav1.NewTime(now.Add(-time.Hour)),
+		ExpiresAt:        metav1.NewTime(now.Add(time.Hour)),
+		PendingUntil:     &pendingUntil,
+		PendingTokenHash: hashToken("old-token"),
+	}}
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"), lapsed)
+	handler := srv.Handler()
+
+	tests := []struct {
+		name     string
+		wish     string
+		confirm  string
+		form     url.Values
+		wantCode int
+	}{
+		{"unknown token", "lamp", "true", url.Values{"pending": {"nope"}}, http.StatusConflict},
+		{"missing token", "lamp", "true", url.Values{}, http.StatusConflict},
+		{"lapsed hold", "lapsed", "true", url.Values{"pending": {"old-token"}}, http.StatusConflict},
+		{"missing wish", "missing", "true", url.Values{"pending": {"old-token"}}, http.StatusNotFound},
+		{"invalid confirm value", "lamp", "maybe", url.Values{"weeks": {"2"}}, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.wantCode, reserveStep(handler, tt.wish, tt.confirm, tt.form).Code)
+		})
+	}
+}
+
+func TestServer_HandleReserve_ConfirmAfterHoldLapses(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, time.May, 1, 12, 0, 0, 0, time.UTC)
+	fakeClock := clocktesting.NewFakePassiveClock(start)
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	WithReserveConfirmation(15 * time.Minute)(srv)
+	WithClock(fakeClock)(srv)
+
+	handler := srv.Handler()
+	token := holdPendingReservation(t, handler, "lamp")
+
+	res := getFundWish(t, srv, "lamp").Status.Reservations[0]
+	assert.True(t, res.CreatedAt.Time.Equal(start))
+	assert.True(t, res.PendingUntil.Time.Equal(start.Add(15*time.Minute)))
+
+	fakeClock.SetTime(start.Add(16 * time.Minute))
+
+	rec := reserveStep(handler, "lamp", "true", url.Values{"pending": {token}})
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.True(t, getFundWish(t, srv, "lamp").Status.Reservations[0].IsPending())
+}
+
+func TestServer_ReserveForm_ConfirmStep(t *testing.T) {
+	t.Parallel()
+
+	plain := newTestServer(t, newIdempotencyWish("lamp"))
+	assert.NotContains(t, getPath(plain.Handler(), "/").Body.String(), "confirm=false")
+
+	confirming := newTestServer(t, newIdempotencyWish("lamp"))
+	WithReserveConfirmation(0)(confirming)
+	assert.Equal(t, defaultPendingTTL, confirming.pendingTTL)
+	assert.Contains(t, getPath(confirming.Handler(), "/").Body.String(), "confirm=false")
+}
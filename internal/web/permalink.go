@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/templates"
+)
+
+// handleWish serves a single wish by name, as a page or as public JSON. It is
+// the only public way to reach unlisted wishes.
+func (s *Server) handleWish(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+	name := r.PathValue("name")
+
+	wish := &wishlistv1alpha1.Wish{}
+	if err := s.client.Get(r.Context(), s.objectKey(r, name), wish); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, i18n.T(lang, "err_get_wish"), http.StatusInternalServerError)
+
+		return
+	}
+
+	s.serveWish(w, r, wish)
+}
+
+// handlePreview renders a wish's page in the language given by the lang
+// parameter whatever the request headers say, so the owner can check how a
+// description reads in each supported language.
+func (s *Server) handlePreview(w http.ResponseWriter, r *http.Request) {
+	lang := strings.ToLower(r.URL.Query().Get("lang"))
+	if !i18n.IsSupported(lang) {
+		message := fmt.Sprintf(i18n.T(i18n.DetectLanguage(r), "err_preview_lang"), strings.Join(i18n.Languages(), ", "))
+		http.Error(w, message, http.StatusBadRequest)
+
+		return
+	}
+
+	s.handleWish(w, r.WithContext(i18n.WithLanguage(r.Context(), lang)))
+}
+
+// serveWish writes a single wish as a page or as public JSON.
+func (s *Server) serveWish(w http.ResponseWriter, r *http.Request, wish *wishlistv1alpha1.Wish) {
+	lang := i18n.DetectLanguage(r)
+	ctx := s.viewerContext(r.Context(), wish, hashTokenIfSet(reserverTokenFromRequest(r)))
+
+	s.delayReservations(r, wish)
+	s.redactWish(wish)
+
+	if wantsJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if err := json.NewEncoder(w).Encode(s.toPublicWish(wish)); err != nil {
+			http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	if err := templates.WishPage(wish, lang).Render(ctx, w); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+
+		return
+	}
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+func newUnlistedWish(name string) *wishlistv1alpha1.Wish {
+	wish := newIdempotencyWish(name)
+	wish.Spec.Title = "Secret Surprise"
+	wish.Spec.Tags = []string{"hidden-tag"}
+	wish.Spec.Unlisted = true
+
+	return wish
+}
+
+func getPath(handler http.Handler, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+	return rec
+}
+
+func TestServer_ListWishes_HidesUnlisted(t *testing.T) {
+	t.Parallel()
+
+	expired := newArchiveWish("old-secret", testNamespace, "Old Secret", 10*24*time.Hour, 24*time.Hour)
+	expired.Spec.Unlisted = true
+
+	srv := newTestServer(t, newIdempotencyWish("listed"), newUnlistedWish("secret"), expired)
+
+	wishes, tags, _, err := srv.listWishes(t.Context(), "", 0)
+	require.NoError(t, err)
+	require.Len(t, wishes, 1)
+	assert.Equal(t, "listed", wishes[0].Name)
+	assert.NotContains(t, tags, "hidden-tag")
+
+	handler := srv.Handler()
+	assert.NotContains(t, getPath(handler, "/").Body.String(), "Secret Surprise")
+	assert.NotContains(t, getPath(handler, "/wishes?format=json").Body.String(), "secret")
+	assert.NotContains(t, getPath(handler, "/wishes/archive").Body.String(), "Old Secret")
+}
+
+func TestServer_HandleWish_ReachesUnlistedByName(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newUnlistedWish("secret"))
+	WithAdminToken(testAdminToken)(srv)
+
+	handler := srv.Handler()
+
+	rec := getPath(handler, "/wishes/secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+	assert.Contains(t, rec.Body.String(), "Secret Surprise")
+	assert.Contains(t, rec.Body.String(), "/wishes/secret/reserve")
+
+	rec = getPath(handler, "/wishes/secret?format=json")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var item publicWish
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &item))
+	assert.Equal(t, "Secret Surprise", item.Title)
+
+	assert.Equal(t, http.StatusOK, reserveWithNote(handler, "secret", "").Code)
+	assert.Equal(t, http.StatusOK, adminRequest(t, srv, "/admin/wishes/secret", testAdminToken).Code)
+}
+
+func TestServer_HandleWish_NotFound(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	assert.Equal(t, http.StatusNotFound, getPath(srv.Handler(), "/wishes/missing").Code)
+}
+
+// getPreview requests the preview of name in lang from a browser preferring
+// acceptLanguage.
+func getPreview(handler http.Handler, name, lang, acceptLanguage string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/wishes/"+name+"/preview?lang="+lang, nil)
+	req.Header.Set("Accept-Language", acceptLanguage)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandlePreview_ForcesLanguage(t *testing.T) {
+	t.Parallel()
+
+	handler := newTestServer(t, newIdempotencyWish("lamp")).Handler()
+
+	for _, lang := range i18n.Languages() {
+		rec := getPreview(handler, "lamp", lang, "ru-RU,ru;q=0.9")
+		require.Equal(t, http.StatusOK, rec.Code, lang)
+		assert.Contains(t, rec.Body.String(), `<html lang="`+lang+`">`, lang)
+		assert.Contains(t, rec.Body.String(), i18n.T(lang, "reserve_btn"), lang)
+	}
+
+	assert.Contains(t, getPreview(handler, "lamp", "ZH", "en").Body.String(), `<html lang="zh">`)
+	assert.Equal(t, http.StatusNotFound, getPreview(handler, "missing", "en", "en").Code)
+}
+
+func TestServer_HandlePreview_RejectsLanguage(t *testing.T) {
+	t.Parallel()
+
+	handler := newTestServer(t, newIdempotencyWish("lamp")).Handler()
+
+	for _, lang := range []string{"", "de", "en-US"} {
+		rec := getPreview(handler, "lamp", lang, "ru")
+		assert.Equal(t, http.StatusBadRequest, rec.Code, lang)
+		assert.Contains(t, rec.Body.String(), "en, ru, zh", lang)
+	}
+}
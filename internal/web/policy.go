@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"fmt"
+	"strings"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// ReservationPolicy controls how many reservations a wish accepts.
+type ReservationPolicy string
+
+const (
+	// PolicyDefault bounds reservations by the wish's quantity.
+	PolicyDefault ReservationPolicy = ""
+
+	// PolicySingle allows a single active reservation per wish, such as for
+	// experience gifts that only one giver can provide.
+	PolicySingle ReservationPolicy = "single"
+
+	// PolicyMulti accepts any number of reservations regardless of quantity,
+	// such as for cash funds that many givers contribute to.
+	PolicyMulti ReservationPolicy = "multi"
+)
+
+// ParseTagPolicies parses a comma-separated list of tag=policy pairs, e.g.
+// "experience=single,cash-fund=multi". An empty string yields no policies.
+func ParseTagPolicies(value string) (map[string]ReservationPolicy, error) {
+	policies := make(map[string]ReservationPolicy)
+
+	for pair := range strings.SplitSeq(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		tag, policy, found := strings.Cut(pair, "=")
+		tag = strings.TrimSpace(tag)
+
+		if !found || tag == "" {
+			return nil, fmt.Errorf("invalid tag policy %q: want tag=policy", pair)
+		}
+
+		switch p := ReservationPolicy(strings.TrimSpace(policy)); p {
+		case PolicySingle, PolicyMulti:
+			policies[tag] = p
+		default:
+			return nil, fmt.Errorf("invalid reservation policy %q for tag %q: want %q or %q",
+				policy, tag, PolicySingle, PolicyMulti)
+		}
+	}
+
+	return policies, nil
+}
+
+// WithTagPolicies applies reservation policies by tag. A wish follows the
+// policy of its first tag that has one; other wishes keep the default.
+func WithTagPolicies(policies map[string]ReservationPolicy) Option {
+	return func(s *Server) {
+		s.tagPolicies = policies
+	}
+}
+
+// policyFor returns the reservation policy for the wish. A wish that allows
+// multiple reservations is always PolicyMulti.
+func (s *Server) policyFor(wish *wishlistv1alpha1.Wish) ReservationPolicy {
+	if wish.Spec.AllowMultipleReservations {
+		return PolicyMulti
+	}
+
+	for _, tag := range wish.Spec.Tags {
+		if policy, ok := s.tagPolicies[tag]; ok {
+			return policy
+		}
+	}
+
+	return PolicyDefault
+}
+
+// canReserve reports whether the wish accepts another reservation under its
+// policy.
+func (s *Server) canReserve(wish *wishlistv1alpha1.Wish) bool {
+	switch s.policyFor(wish) {
+	case PolicyMulti:
+		return true
+	case PolicySingle:
+		// Reservations in their grace period still hold the wish
+		if len(wish.Status.Reservations) > 0 {
+			return false
+		}
+	}
+
+	return wish.IsUnlimited() || wish.AvailableQuantity() > 0
+}
+
+// reservable reports whether the wish page offers the reserve form: the
+// wish accepts another reservation, is neither a fund nor a group gift that
+// already has a coordinator, and the server takes writes.
+func (s *Server) reservable(wish *wishlistv1alpha1.Wish) bool {
+	if s.readOnly || wish.Spec.Fund || (wish.Spec.GroupGift && wish.Status.Coordinator != "") {
+		return false
+	}
+
+	return s.canReserve(wish)
+}
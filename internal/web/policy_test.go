@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// newTaggedWish returns a single-item wish with the given tags.
+func newTaggedWish(name string, tags ...string) *wishlistv1alpha1.Wish {
+	wish := newIdempotencyWish(name)
+	wish.Spec.Quantity = 1
+	wish.Spec.Tags = tags
+
+	return wish
+}
+
+func TestParseTagPolicies(t *testing.T) {
+	t.Parallel()
+
+	policies, err := ParseTagPolicies(" experience=single, cash-fund = multi ,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]ReservationPolicy{
+		"experience": PolicySingle,
+		"cash-fund":  PolicyMulti,
+	}, policies)
+
+	policies, err = ParseTagPolicies("")
+	require.NoError(t, err)
+	assert.Empty(t, policies)
+
+	for _, value := range []string{"experience", "=single", "experience=many"} {
+		_, err := ParseTagPolicies(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestServer_HandleReserve_SinglePolicyRejectsSecond(t *testing.T) {
+	t.Parallel()
+
+	wish := newTaggedWish("balloon-ride", "experience")
+	wish.Spec.Quantity = 3
+
+	srv := newTestServer(t, wish)
+	WithTagPolicies(map[string]ReservationPolicy{"experience": PolicySingle})(srv)
+
+	handler := srv.Handler()
+
+	require.Equal(t, http.StatusOK, reserveWithNote(handler, "balloon-ride", "").Code)
+
+	rec := reserveWithNote(handler, "balloon-ride", "")
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "already reserved")
+}
+
+func TestServer_HandleReserve_MultiPolicyAllowsSecond(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newTaggedWish("honeymoon", "cash-fund"))
+	WithTagPolicies(map[string]ReservationPolicy{"cash-fund": PolicyMulti})(srv)
+
+	handler := srv.Handler()
+
+	require.Equal(t, http.StatusOK, reserveWithNote(handler, "honeymoon", "").Code)
+
+	rec := reserveWithNote(handler, "honeymoon", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	// The card keeps offering the reserve form after the quantity is reached.
+	assert.Contains(t, rec.Body.String(), "reserve-form")
+
+	sorted, _, _, err := srv.listWishes(t.Context(), "", 0)
+	require.NoError(t, err)
+	require.Len(t, sorted, 1)
+	assert.Len(t, sorted[0].Status.Reservations, 2)
+}
+
+func TestServer_HandleReserve_DefaultPolicyKeepsQuantityLimit(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newTaggedWish("book", "reading"))
+	WithTagPolicies(map[string]ReservationPolicy{"cash-fund": PolicyMulti})(srv)
+
+	handler := srv.Handler()
+
+	require.Equal(t, http.StatusOK, reserveWithNote(handler, "book", "").Code)
+	assert.Equal(t, http.StatusConflict, reserveWithNote(handler, "book", "").Code)
+}
+
+func TestServer_PolicyFor_FirstMatchingTag(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithTagPolicies(map[string]ReservationPolicy{
+		"experience": PolicySingle,
+		"cash-fund":  PolicyMulti,
+	})(srv)
+
+	assert.Equal(t, PolicyMulti, srv.policyFor(newTaggedWish("a", "travel", "cash-fund", "experience")))
+	assert.Equal(t, PolicySingle, srv.policyFor(newTaggedWish("b", "experience", "cash-fund")))
+	assert.Equal(t, PolicyDefault, srv.policyFor(newTaggedWish("c", "travel")))
+}
+
+func TestServer_HandleReserve_AllowMultipleReservations(t *testing.T) {
+	t.Parallel()
+
+	pot := newTaggedWish("honeymoon", "experience")
+	pot.Spec.AllowMultipleReservations = true
+
+	srv := newTestServer(t, pot, newTaggedWish("book"))
+	WithTagPolicies(map[string]ReservationPolicy{"experience": PolicySingle})(srv)
+
+	handler := srv.Handler()
+
+	for range 3 {
+		require.Equal(t, http.StatusOK, reserveWithNote(handler, "honeymoon", "").Code)
+	}
+
+	assert.Len(t, getFundWish(t, srv, "honeymoon").Status.Reservations, 3, "the flag overrides the tag policy")
+	assert.True(t, getPublicWish(t, handler, "/wishes/honeymoon").Reservable)
+
+	require.Equal(t, http.StatusOK, reserveWithNote(handler, "book", "").Code)
+	assert.Equal(t, http.StatusConflict, reserveWithNote(handler, "book", "").Code, "blocked without the flag")
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// WithHiddenPrices keeps prices off public pages and the JSON API, for hosts
+// who would rather givers not see them. Fund targets and progress are still
+// shown, since a fund cannot work without them.
+func WithHiddenPrices() Option {
+	return func(s *Server) {
+		s.hidePrices = true
+	}
+}
+
+// hidePrice clears the wish's price and when it was last checked. The
+// currency stays for funds, whose amounts use it.
+func hidePrice(wish *wishlistv1alpha1.Wish) {
+	wish.Spec.MSRP = ""
+	wish.Spec.PriceMin = nil
+	wish.Spec.PriceMax = nil
+	wish.Spec.Approximate = false
+	wish.Status.PriceCheckedAt = nil
+
+	if !wish.Spec.Fund {
+		wish.Spec.Currency = ""
+	}
+}
+
+// redactWish prepares a copy of the wish to leave the server publicly:
+// anonymized, and without its price when prices are hidden.
+func (s *Server) redactWish(wish *wishlistv1alpha1.Wish) {
+	anonymizeWish(wish)
+
+	if s.hidePrices {
+		hidePrice(wish)
+	}
+}
+
+// redactWishes applies redactWish to every wish in the slice.
+func (s *Server) redactWishes(wishes []wishlistv1alpha1.Wish) {
+	for i := range wishes {
+		s.redactWish(&wishes[i])
+	}
+}
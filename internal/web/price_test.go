@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testPriceMSRP = "$1234"
+
+func TestServer_HiddenPrices(t *testing.T) {
+	t.Parallel()
+
+	priceMin, priceMax := int64(5000), int64(7000)
+	checked := metav1.Now()
+
+	paths := []string{
+		"/",
+		"/wishes",
+		"/wishes?format=json",
+		"/wishes/lamp",
+		"/wishes/lamp?format=json",
+		"/wishes/ranged?format=json",
+	}
+
+	for _, hide := range []bool{false, true} {
+		lamp := newIdempotencyWish("lamp")
+		lamp.Spec.MSRP = testPriceMSRP
+		lamp.Status.PriceCheckedAt = &checked
+
+		ranged := newIdempotencyWish("ranged")
+		ranged.Spec.PriceMin = &priceMin
+		ranged.Spec.PriceMax = &priceMax
+		ranged.Spec.Currency = "€"
+
+		srv := newTestServer(t, lamp, ranged)
+		if hide {
+			WithHiddenPrices()(srv)
+		}
+
+		for _, path := range paths {
+			body := getPath(srv.Handler(), path).Body.String()
+
+			shown := assert.Contains
+			if hide {
+				shown = assert.NotContains
+			}
+
+			if path != "/wishes/ranged?format=json" {
+				shown(t, body, "1234", "hide=%v %s", hide, path)
+			}
+
+			if path != "/wishes/lamp" && path != "/wishes/lamp?format=json" {
+				shown(t, body, "7000", "hide=%v %s", hide, path)
+			}
+		}
+	}
+}
+
+func TestHidePrice_KeepsFundCurrency(t *testing.T) {
+	t.Parallel()
+
+	wish := newIdempotencyWish("fund")
+	wish.Spec.Fund = true
+	wish.Spec.FundTarget = 300
+	wish.Spec.Currency = "€"
+	wish.Spec.MSRP = testPriceMSRP
+
+	hidePrice(wish)
+
+	assert.Empty(t, wish.Spec.MSRP)
+	assert.Equal(t, "€", wish.Spec.Currency)
+	assert.Equal(t, int64(300), wish.Spec.FundTarget)
+}
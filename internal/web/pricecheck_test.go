@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func markPriceChecked(srv *Server, name, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/admin/wishes/"+name+"/price-checked", nil)
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandleAdminPriceChecked(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newSummaryWish("lamp", 1, 0))
+	WithAdminToken(testAdminToken)(srv)
+
+	rec := markPriceChecked(srv, "lamp", testAdminToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var detail adminWishDetail
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &detail))
+	require.NotNil(t, detail.Status.PriceCheckedAt)
+
+	wish := getFundWish(t, srv, "lamp")
+	require.NotNil(t, wish.Status.PriceCheckedAt)
+	assert.WithinDuration(t, time.Now(), wish.Status.PriceCheckedAt.Time, time.Minute)
+
+	item := getPublicWish(t, srv.Handler(), "/wishes/lamp")
+	require.NotNil(t, item.PriceChecked)
+	assert.True(t, item.PriceChecked.Equal(wish.Status.PriceCheckedAt.Time))
+}
+
+func TestServer_HandleAdminPriceChecked_Rejects(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newSummaryWish("lamp", 1, 0))
+	WithAdminToken(testAdminToken)(srv)
+
+	assert.Equal(t, http.StatusUnauthorized, markPriceChecked(srv, "lamp", "").Code)
+	assert.Equal(t, http.StatusNotFound, markPriceChecked(srv, "missing", testAdminToken).Code)
+	assert.Nil(t, getFundWish(t, srv, "lamp").Status.PriceCheckedAt)
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// Priority bounds of the Wish CRD.
+const (
+	minPriority = 1
+	maxPriority = 5
+)
+
+// minPriorityParam overrides the configured minimum priority of the list.
+const minPriorityParam = "min_priority"
+
+// WithMinPriority hides wishes below the given priority from the public list,
+// so a long list can show only its top items. Wishes without a priority count
+// as 0. The permalink pages of hidden wishes keep working.
+func WithMinPriority(priority int32) Option {
+	return func(s *Server) {
+		s.listMinPriority = priority
+	}
+}
+
+// parseMinPriority returns the minimum priority for the list: the
+// min_priority query parameter when given, the configured one otherwise.
+// It reports false for values outside 0 to maxPriority.
+func (s *Server) parseMinPriority(r *http.Request) (int32, bool) {
+	value := r.URL.Query().Get(minPriorityParam)
+	if value == "" {
+		return s.listMinPriority, true
+	}
+
+	priority, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || priority < 0 || priority > maxPriority {
+		return 0, false
+	}
+
+	return int32(priority), true
+}
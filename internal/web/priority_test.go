@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// priorityListNames returns the names of wishes on the public JSON list.
+func priorityListNames(t *testing.T, srv *Server, query string) []string {
+	t.Helper()
+
+	rec := getPath(srv.Handler(), "/wishes?format=json"+query)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var items []publicWish
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &items))
+
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+
+	return names
+}
+
+func newPriorityServer(t *testing.T) *Server {
+	t.Helper()
+
+	unset := newSummaryWish("unset", 1, 0)
+
+	low := newSummaryWish("low", 1, 0)
+	low.Spec.Priority = 2
+
+	mid := newSummaryWish("mid", 1, 0)
+	mid.Spec.Priority = 3
+
+	top := newSummaryWish("top", 1, 0)
+	top.Spec.Priority = 5
+
+	return newTestServer(t, unset, low, mid, top)
+}
+
+func TestServer_MinPriority(t *testing.T) {
+	t.Parallel()
+
+	srv := newPriorityServer(t)
+	assert.ElementsMatch(t, []string{"unset", "low", "mid", "top"}, priorityListNames(t, srv, ""))
+
+	WithMinPriority(3)(srv)
+	assert.ElementsMatch(t, []string{"mid", "top"}, priorityListNames(t, srv, ""))
+
+	// The query parameter overrides the configured threshold either way
+	assert.ElementsMatch(t, []string{"top"}, priorityListNames(t, srv, "&min_priority=5"))
+	assert.ElementsMatch(t, []string{"unset", "low", "mid", "top"}, priorityListNames(t, srv, "&min_priority=0"))
+	assert.ElementsMatch(t, []string{"low", "mid", "top"}, priorityListNames(t, srv, "&min_priority=1"))
+
+	// Hidden wishes keep their permalink
+	assert.Equal(t, http.StatusOK, getPath(srv.Handler(), "/wishes/low").Code)
+}
+
+func TestServer_MinPriority_InvalidParam(t *testing.T) {
+	t.Parallel()
+
+	srv := newPriorityServer(t)
+
+	for _, value := range []string{"-1", "6", "high", "2.5"} {
+		rec := getPath(srv.Handler(), "/?min_priority="+value)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, value)
+		assert.Contains(t, rec.Body.String(), "between 0 and 5", value)
+	}
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// publicReservation is the anonymous view of a reservation: how much is held
+// and until when, but not by whom or why.
+type publicReservation struct {
+	Quantity  int32     `json:"quantity"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// publicWish is the JSON representation of an active wish in public responses.
+// Quantity 0 means unlimited, in which case Available and AvailableQuantity
+// are omitted. Reserved and Available are kept for older clients;
+// ReservedQuantity and AvailableQuantity carry the same numbers.
+type publicWish struct {
+	Name          string              `json:"name"`
+	Slug          string              `json:"slug,omitempty"`
+	Title         string              `json:"title"`
+	Description   string              `json:"description,omitempty"`
+	MSRP          string              `json:"msrp,omitempty"`
+	PriceMin      *int64              `json:"priceMin,omitempty"`
+	PriceMax      *int64              `json:"priceMax,omitempty"`
+	Currency      string              `json:"currency,omitempty"`
+	Approximate   bool                `json:"approximate,omitempty"`
+	NeededBy      *time.Time          `json:"neededBy,omitempty"`
+	PriceChecked  *time.Time          `json:"priceCheckedAt,omitempty"`
+	Fund          bool                `json:"fund,omitempty"`
+	FundTarget    int64               `json:"fundTarget,omitempty"`
+	FundRaised    int64               `json:"fundRaised,omitempty"`
+	Fulfilled     bool                `json:"fulfilled,omitempty"`
+	GroupGift     bool                `json:"groupGift,omitempty"`
+	Pledgers      int                 `json:"pledgers,omitempty"`
+	GroupClosed   bool                `json:"groupClosed,omitempty"`
+	PartOfSet     string              `json:"partOfSet,omitempty"`
+	ImageURL      string              `json:"imageURL,omitempty"`
+	OfficialURL   string              `json:"officialURL,omitempty"`
+	PurchaseURLs  []string            `json:"purchaseURLs,omitempty"`
+	Tags          []string            `json:"tags,omitempty"`
+	ContextTags   []string            `json:"contextTags,omitempty"`
+	Priority      int32               `json:"priority,omitempty"`
+	Quantity      int32               `json:"quantity"`
+	Reserved      int32               `json:"reserved"`
+	Available     *int32              `json:"available,omitempty"`
+	FullyReserved bool                `json:"fullyReserved"`
+	Reservations  []publicReservation `json:"reservations,omitempty"`
+
+	ReservedQuantity   int32      `json:"reservedQuantity"`
+	PurchasedQuantity  int32      `json:"purchasedQuantity,omitempty"`
+	AvailableQuantity  *int32     `json:"availableQuantity,omitempty"`
+	Reservable         bool       `json:"reservable"`
+	ReservationExpires *time.Time `json:"reservationExpires,omitempty"`
+}
+
+// redactedHash replaces group gift token hashes in public views, so their
+// presence and count still render without identifying anyone.
+const redactedHash = "redacted"
+
+// anonymizeWish strips reserver identity, notes, who the owner reserved for
+// and pending confirmation tokens from the wish's reservations, keeping
+// quantities and expiry so aggregate state still renders. Group gift
+// coordinator and pledger hashes are redacted likewise.
+// Call it on copies that are about to leave the server publicly, never
+// before writing the wish back.
+func anonymizeWish(wish *wishlistv1alpha1.Wish) {
+	for i := range wish.Status.Reservations {
+		wish.Status.Reservations[i].TokenHash = ""
+		wish.Status.Reservations[i].Note = ""
+		wish.Status.Reservations[i].PendingTokenHash = ""
+		wish.Status.Reservations[i].ReservedFor = ""
+		wish.Status.Reservations[i].AdminCreated = false
+	}
+
+	if wish.Status.Coordinator != "" {
+		wish.Status.Coordinator = redactedHash
+	}
+
+	for i := range wish.Status.Pledgers {
+		wish.Status.Pledgers[i] = redactedHash
+	}
+}
+
+// anonymizeWishes applies anonymizeWish to every wish in the slice.
+func anonymizeWishes(wishes []wishlistv1alpha1.Wish) {
+	for i := range wishes {
+		anonymizeWish(&wishes[i])
+	}
+}
+
+// toPublicWish builds the public JSON view of a wish. Reservable matches
+// whether the HTML view offers the reserve form.
+func (s *Server) toPublicWish(wish *wishlistv1alpha1.Wish) publicWish {
+	item := publicWish{
+		Name:          wish.Name,
+		Slug:          wish.Spec.Slug,
+		Title:         wish.Spec.Title,
+		Description:   wish.Spec.Description,
+		MSRP:          wish.Spec.MSRP,
+		PriceMin:      wish.Spec.PriceMin,
+		PriceMax:      wish.Spec.PriceMax,
+		Currency:      wish.Spec.Currency,
+		Approximate:   wish.Spec.Approximate,
+		Fund:          wish.Spec.Fund,
+		FundTarget:    wish.Spec.FundTarget,
+		FundRaised:    wish.Status.FundRaised,
+		Fulfilled:     wish.Status.Fulfilled,
+		GroupGift:     wish.Spec.GroupGift,
+		Pledgers:      len(wish.Status.Pledgers),
+		GroupClosed:   wish.Status.GroupClosed,
+		PartOfSet:     wish.Spec.PartOfSet,
+		ImageURL:      wish.Spec.ImageURL,
+		OfficialURL:   wish.Spec.OfficialURL,
+		PurchaseURLs:  wish.Spec.PurchaseURLs,
+		Tags:          wish.Spec.Tags,
+		ContextTags:   wish.Spec.ContextTags,
+		Priority:      wish.Spec.Priority,
+		Quantity:      wish.GetQuantity(),
+		Reserved:      wish.TotalReserved(),
+		FullyReserved: wish.IsFullyReserved(),
+
+		ReservedQuantity:  wish.TotalReserved(),
+		PurchasedQuantity: wish.Status.PurchasedQuantity,
+		Reservable:        s.reservable(wish),
+	}
+
+	if neededBy := wish.Spec.NeededBy; neededBy != nil {
+		item.NeededBy = &neededBy.Time
+	}
+
+	if checked := wish.Status.PriceCheckedAt; checked != nil {
+		item.PriceChecked = &checked.Time
+	}
+
+	if !wish.IsUnlimited() {
+		available := wish.AvailableQuantity()
+		item.Available = &available
+		item.AvailableQuantity = &available
+	}
+
+	if expires := wish.NextReservationExpiry(); expires != nil {
+		item.ReservationExpires = &expires.Time
+	}
+
+	for _, res := range wish.ActiveReservationsAt(s.clock.Now()) {
+		item.Reservations = append(item.Reservations, publicReservation{
+			Quantity:  res.Quantity,
+			ExpiresAt: res.ExpiresAt.Time,
+		})
+	}
+
+	return item
+}
+
+// writePublicWishes encodes the wishes as a public JSON list.
+func (s *Server) writePublicWishes(w http.ResponseWriter, wishes []wishlistv1alpha1.Wish) error {
+	items := make([]publicWish, 0, len(wishes))
+	for i := range wishes {
+		items = append(items, s.toPublicWish(&wishes[i]))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	return json.NewEncoder(w).Encode(items)
+}
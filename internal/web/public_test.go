@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+const (
+	testSecretNote  = "secret-note-for-owner"
+	testSecretToken = "secret-token-hash"
+)
+
+func newNotedWish(name string) *wishlistv1alpha1.Wish {
+	wish := newSummaryWish(name, 3, 0)
+	wish.Status.Reservations = []wishlistv1alpha1.Reservation{{
+		Quantity:  2,
+		CreatedAt: metav1.Now(),
+		ExpiresAt: metav1.NewTime(time.Now().Add(7 * 24 * time.Hour)),
+		TokenHash: testSecretToken,
+		Note:      testSecretNote,
+	}}
+
+	return wish
+}
+
+func TestServer_PublicJSON_OmitsReserverDetail(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newNotedWish("noted"))
+
+	req := httptest.NewRequest(http.MethodGet, "/wishes?format=json", nil)
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.NotContains(t, rec.Body.String(), testSecretNote)
+	assert.NotContains(t, rec.Body.String(), testSecretToken)
+	assert.NotContains(t, rec.Body.String(), "tokenHash")
+
+	var items []publicWish
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &items))
+	require.Len(t, items, 1)
+
+	// Aggregate reservation state is still public.
+	assert.Equal(t, int32(3), items[0].Quantity)
+	assert.Equal(t, int32(2), items[0].Reserved)
+	require.NotNil(t, items[0].Available)
+	assert.Equal(t, int32(1), *items[0].Available)
+	assert.False(t, items[0].FullyReserved)
+	require.Len(t, items[0].Reservations, 1)
+	assert.Equal(t, int32(2), items[0].Reservations[0].Quantity)
+}
+
+func TestServer_PublicHTML_OmitsReserverDetail(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newNotedWish("noted"))
+
+	for _, path := range []string{"/", "/wishes"} {
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+		require.Equal(t, http.StatusOK, rec.Code, path)
+		assert.NotContains(t, rec.Body.String(), testSecretNote, path)
+		assert.NotContains(t, rec.Body.String(), testSecretToken, path)
+	}
+}
+
+func TestServer_AdminJSON_IncludesReserverDetail(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newNotedWish("noted"))
+	WithAdminToken(testAdminToken)(srv)
+
+	rec := adminRequest(t, srv, "/admin/wishes/noted", testAdminToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var detail adminWishDetail
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &detail))
+	require.Len(t, detail.Status.Reservations, 1)
+	assert.Equal(t, testSecretNote, detail.Status.Reservations[0].Note)
+	assert.Equal(t, testSecretToken, detail.Status.Reservations[0].TokenHash)
+}
+
+func TestAnonymizeWish(t *testing.T) {
+	t.Parallel()
+
+	wish := newNotedWish("noted")
+	anonymizeWish(wish)
+
+	require.Len(t, wish.Status.Reservations, 1)
+	assert.Empty(t, wish.Status.Reservations[0].TokenHash)
+	assert.Empty(t, wish.Status.Reservations[0].Note)
+	assert.Equal(t, int32(2), wish.Status.Reservations[0].Quantity)
+}
+
+func TestAnonymizeWish_RedactsGroupGift(t *testing.T) {
+	t.Parallel()
+
+	wish := newGroupWish("tv")
+	wish.Status.Coordinator = hashToken("alice")
+	wish.Status.Pledgers = []string{hashToken("bob"), hashToken("carol")}
+	anonymizeWish(wish)
+
+	assert.Equal(t, redactedHash, wish.Status.Coordinator)
+	assert.Equal(t, []string{redactedHash, redactedHash}, wish.Status.Pledgers)
+	assert.Equal(t, 2, newTestServer(t).toPublicWish(wish).Pledgers)
+}
+
+func TestServer_PublicJSON_Availability(t *testing.T) {
+	t.Parallel()
+
+	// One active reservation and one expired but still in its grace period.
+	graceEnd := time.Now().Add(-time.Hour).Truncate(time.Second)
+	mixed := newSummaryWish("mixed", 5, 0)
+	mixed.Status.Reservations = []wishlistv1alpha1.Reservation{
+		{Quantity: 2, ExpiresAt: metav1.NewTime(time.Now().Add(7 * 24 * time.Hour))},
+		{Quantity: 1, ExpiresAt: metav1.NewTime(graceEnd)},
+	}
+
+	single := newSummaryWish("single", 5, 1)
+	single.Spec.Tags = []string{"experience"}
+
+	srv := newTestServer(t, mixed, single, newSummaryWish("taken", 2, 2), newSummaryWish("open", 0, 3))
+	WithTagPolicies(map[string]ReservationPolicy{"experience": PolicySingle})(srv)
+
+	handler := srv.Handler()
+
+	item := getPublicWish(t, handler, "/wishes/mixed")
+	html := getPath(handler, "/wishes/mixed").Body.String()
+	assert.Equal(t, int32(5), item.Quantity)
+	assert.Equal(t, int32(3), item.ReservedQuantity, "grace-period reservations still hold items")
+	require.NotNil(t, item.AvailableQuantity)
+	assert.Equal(t, mixed.AvailableQuantity(), *item.AvailableQuantity)
+	assert.Equal(t, int32(2), *item.AvailableQuantity)
+	assert.True(t, item.Reservable)
+	require.NotNil(t, item.ReservationExpires)
+	assert.True(t, graceEnd.Equal(*item.ReservationExpires), "earliest expiry")
+	assert.Contains(t, html, "2/5")
+	assert.Contains(t, html, `hx-post="/wishes/mixed/reserve`)
+
+	tests := []struct {
+		name       string
+		reservable bool
+		available  *int32
+	}{
+		{name: "single", reservable: false, available: ptr.To[int32](4)},
+		{name: "taken", reservable: false, available: ptr.To[int32](0)},
+		{name: "open", reservable: true},
+	}
+
+	for _, tt := range tests {
+		item := getPublicWish(t, handler, "/wishes/"+tt.name)
+		html := getPath(handler, "/wishes/"+tt.name).Body.String()
+		assert.Equal(t, tt.reservable, item.Reservable, tt.name)
+		assert.Equal(t, tt.reservable, strings.Contains(html, `hx-post="/wishes/`+tt.name+`/reserve`), tt.name)
+		assert.Equal(t, tt.available, item.AvailableQuantity, tt.name)
+		assert.Equal(t, item.Reserved, item.ReservedQuantity, tt.name)
+	}
+}
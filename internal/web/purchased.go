@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// handleAdminPurchased records how many of the wish's items have been bought
+// so far, from the quantity form field. The count replaces the previous one,
+// so repeating a request is harmless and mistakes can be corrected. Purchased
+// items are taken out of availability, and the wish is marked fulfilled once
+// all of them are bought.
+func (s *Server) handleAdminPurchased(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	if !s.parseForm(w, r, lang) {
+		return
+	}
+
+	wish := &wishlistv1alpha1.Wish{}
+	if err := s.client.Get(r.Context(), s.objectKey(r, r.PathValue("name")), wish); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, i18n.T(lang, "err_get_wish"), http.StatusInternalServerError)
+
+		return
+	}
+
+	if wish.IsUnlimited() {
+		http.Error(w, i18n.T(lang, "err_purchased_unlimited"), http.StatusConflict)
+
+		return
+	}
+
+	purchased, err := strconv.ParseInt(r.FormValue("quantity"), 10, 32)
+	if err != nil || purchased < 0 || purchased > int64(wish.GetQuantity()) {
+		http.Error(w, fmt.Sprintf(i18n.T(lang, "err_purchased"), wish.GetQuantity()), http.StatusBadRequest)
+
+		return
+	}
+
+	wish.Status.PurchasedQuantity = int32(purchased)
+	wish.Status.Fulfilled = wish.IsFulfilled()
+
+	if err := s.client.Status().Update(r.Context(), wish); err != nil {
+		http.Error(w, i18n.T(lang, "err_purchased_failed"), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if err := json.NewEncoder(w).Encode(adminWishDetail{
+		Name:   wish.Name,
+		Spec:   wish.Spec,
+		Status: wish.Status,
+	}); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func markPurchased(srv *Server, name, token, quantity string) *httptest.ResponseRecorder {
+	form := url.Values{"quantity": {quantity}}
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/wishes/"+name+"/purchased", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandleAdminPurchased(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newSummaryWish("mugs", 5, 1))
+	WithAdminToken(testAdminToken)(srv)
+
+	require.Equal(t, http.StatusOK, markPurchased(srv, "mugs", testAdminToken, "2").Code)
+
+	wish := getFundWish(t, srv, "mugs")
+	assert.Equal(t, int32(2), wish.Status.PurchasedQuantity)
+	assert.Equal(t, int32(2), wish.AvailableQuantity(), "5 less 1 reserved and 2 purchased")
+	assert.False(t, wish.Status.Fulfilled)
+
+	item := getPublicWish(t, srv.Handler(), "/wishes/mugs")
+	assert.Equal(t, int32(2), item.PurchasedQuantity)
+	require.NotNil(t, item.AvailableQuantity)
+	assert.Equal(t, int32(2), *item.AvailableQuantity)
+
+	require.Equal(t, http.StatusOK, markPurchased(srv, "mugs", testAdminToken, "5").Code)
+
+	wish = getFundWish(t, srv, "mugs")
+	assert.Zero(t, wish.AvailableQuantity())
+	assert.True(t, wish.Status.Fulfilled)
+
+	require.Equal(t, http.StatusOK, markPurchased(srv, "mugs", testAdminToken, "4").Code)
+	assert.False(t, getFundWish(t, srv, "mugs").Status.Fulfilled, "a corrected count reopens the wish")
+}
+
+func TestServer_HandleAdminPurchased_Rejects(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newSummaryWish("mugs", 5, 0), newSummaryWish("open", 0, 0))
+	WithAdminToken(testAdminToken)(srv)
+
+	tests := []struct {
+		name     string
+		wish     string
+		token    string
+		quantity string
+		want     int
+	}{
+		{name: "missing token", wish: "mugs", quantity: "1", want: http.StatusUnauthorized},
+		{name: "missing quantity", wish: "mugs", token: testAdminToken, want: http.StatusBadRequest},
+		{name: "negative", wish: "mugs", token: testAdminToken, quantity: "-1", want: http.StatusBadRequest},
+		{name: "more than wished", wish: "mugs", token: testAdminToken, quantity: "6", want: http.StatusBadRequest},
+		{name: "unlimited", wish: "open", token: testAdminToken, quantity: "1", want: http.StatusConflict},
+		{name: "unknown wish", wish: "missing", token: testAdminToken, quantity: "1", want: http.StatusNotFound},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, markPurchased(srv, tc.wish, tc.token, tc.quantity).Code)
+		})
+	}
+}
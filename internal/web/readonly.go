@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// WithReadOnly serves listings and wish pages only: every request that could
+// change a wish is refused with 405, and pages render without reserve forms.
+// This lets a public deployment run without write access while reservations
+// go through a separate, internal instance.
+func WithReadOnly() Option {
+	return func(s *Server) {
+		s.readOnly = true
+	}
+}
+
+// readOnlyMiddleware refuses all but GET and HEAD requests when the server
+// is read-only. Every mutating route is a POST, so checking the method covers
+// routes added later too.
+func (s *Server) readOnlyMiddleware(next http.Handler) http.Handler {
+	if !s.readOnly {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, i18n.T(i18n.DetectLanguage(r), "err_read_only"), http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
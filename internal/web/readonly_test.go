@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_ReadOnly_RefusesMutatingRoutes(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	WithAdminToken(testAdminToken)(srv)
+	WithNotifier(&fakeNotifier{})(srv)
+	WithReadOnly()(srv)
+
+	handler := srv.Handler()
+
+	paths := []string{
+		"/wishes/lamp/reserve",
+		"/wishes/lamp/unreserve",
+		"/wishes/lamp/contribute",
+		"/wishes/lamp/close-group",
+		"/wishes/lamp/message",
+		"/admin/wishes/lamp/received",
+		"/admin/wishes/lamp/price-checked",
+		"/admin/wishes/lamp/extend",
+		"/admin/wishes/ttl",
+		"/admin/wishes/order",
+		"/admin/secret-santa",
+		"/admin/reservations/cleanup",
+		"/ru/wishes/lamp/reserve",
+	}
+
+	for _, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			t.Parallel()
+
+			form := url.Values{"weeks": {"2"}, "duration": {"24h"}, "expiresIn": {"24h"}}
+			req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.Header.Set("Authorization", "Bearer "+testAdminToken)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+			assert.Equal(t, "GET, HEAD", rec.Header().Get("Allow"))
+		})
+	}
+
+	t.Run("localized refusal", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequest(http.MethodPost, "/ru/wishes/lamp/reserve", nil)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Contains(t, rec.Body.String(), "только для просмотра")
+	})
+
+	wish := getFundWish(t, srv, "lamp")
+	assert.Empty(t, wish.Status.Reservations)
+	assert.False(t, wish.Status.Received)
+}
+
+func TestServer_ReadOnly_ServesReads(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	WithReadOnly()(srv)
+
+	handler := srv.Handler()
+
+	for _, path := range []string{"/", "/wishes", "/wishes/lamp", "/wishes/lamp?format=json", "/wishes/archive"} {
+		rec := getPath(handler, path)
+		require.Equal(t, http.StatusOK, rec.Code, path)
+		assert.NotContains(t, rec.Body.String(), `class="reserve-form"`, path)
+	}
+
+	assert.Contains(t, getPath(handler, "/wishes").Body.String(), testTitleGift)
+}
+
+func TestServer_ReadWrite_ShowsReserveForm(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+
+	assert.Contains(t, getPath(srv.Handler(), "/wishes").Body.String(), `class="reserve-form"`)
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/notify"
+)
+
+// handleAdminReceived marks a wish as received, taking it off the public list,
+// and sends the optional thank-you message through the notifier. Marking an
+// already received wish keeps its ReceivedAt, so a failed notification can be
+// retried.
+func (s *Server) handleAdminReceived(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+	name := r.PathValue("name")
+
+	if !s.parseForm(w, r, lang) {
+		return
+	}
+
+	message := strings.TrimSpace(r.FormValue("message"))
+	if utf8.RuneCountInString(message) > maxMessageLength {
+		http.Error(w, fmt.Sprintf(i18n.T(lang, "err_message_length"), maxMessageLength), http.StatusBadRequest)
+
+		return
+	}
+
+	wish := &wishlistv1alpha1.Wish{}
+	if err := s.client.Get(r.Context(), s.objectKey(r, name), wish); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, i18n.T(lang, "err_get_wish"), http.StatusInternalServerError)
+
+		return
+	}
+
+	if !wish.Status.Received {
+		now := metav1.NewTime(s.clock.Now())
+		wish.Status.Received = true
+		wish.Status.ReceivedAt = &now
+
+		if err := s.client.Status().Update(r.Context(), wish); err != nil {
+			http.Error(w, i18n.T(lang, "err_received_failed"), http.StatusInternalServerError)
+
+			return
+		}
+	}
+
+	if s.notifier != nil {
+		err := s.notifier.Send(r.Context(), notify.Event{
+			Type:      notify.EventWishReceived,
+			Namespace: wish.Namespace,
+			Wish:      wish.Name,
+			Title:     wish.Spec.Title,
+			Message:   message,
+			Time:      s.clock.Now().UTC(),
+		})
+		if err != nil {
+			http.Error(w, i18n.T(lang, "err_message_failed"), http.StatusBadGateway)
+
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if err := json.NewEncoder(w).Encode(adminWishDetail{
+		Name:   wish.Name,
+		Spec:   wish.Spec,
+		Status: wish.Status,
+	}); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+	}
+}
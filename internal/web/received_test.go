@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/wish-operator/internal/notify"
+)
+
+func markReceived(srv *Server, name, token string, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/admin/wishes/"+name+"/received", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandleAdminReceived(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newSummaryWish("lamp", 1, 1))
+	WithAdminToken(testAdminToken)(srv)
+
+	notifier := &fakeNotifier{}
+	WithNotifier(notifier)(srv)
+
+	rec := markReceived(srv, "lamp", testAdminToken, url.Values{"message": {"Thank you, I love it!"}})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var detail adminWishDetail
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &detail))
+	assert.True(t, detail.Status.Received)
+	require.NotNil(t, detail.Status.ReceivedAt)
+
+	wish := getFundWish(t, srv, "lamp")
+	assert.True(t, wish.Status.Received)
+	require.NotNil(t, wish.Status.ReceivedAt)
+
+	require.Len(t, notifier.events, 1)
+	assert.Equal(t, notify.EventWishReceived, notifier.events[0].Type)
+	assert.Equal(t, "lamp", notifier.events[0].Wish)
+	assert.Equal(t, "Thank you, I love it!", notifier.events[0].Message)
+
+	// Marking again keeps the original time and resends the notification
+	receivedAt := wish.Status.ReceivedAt.Time
+
+	require.Equal(t, http.StatusOK, markReceived(srv, "lamp", testAdminToken, url.Values{}).Code)
+	assert.True(t, getFundWish(t, srv, "lamp").Status.ReceivedAt.Time.Equal(receivedAt))
+	assert.Len(t, notifier.events, 2)
+}
+
+func TestServer_HandleAdminReceived_Rejects(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newSummaryWish("lamp", 1, 0))
+	WithAdminToken(testAdminToken)(srv)
+
+	assert.Equal(t, http.StatusUnauthorized, markReceived(srv, "lamp", "", url.Values{}).Code)
+	assert.Equal(t, http.StatusNotFound, markReceived(srv, "missing", testAdminToken, url.Values{}).Code)
+	assert.Equal(t, http.StatusBadRequest,
+		markReceived(srv, "lamp", testAdminToken, url.Values{"message": {strings.Repeat("x", maxMessageLength+1)}}).Code)
+	assert.False(t, getFundWish(t, srv, "lamp").Status.Received)
+
+	failing := newTestServer(t, newSummaryWish("lamp", 1, 0))
+	WithAdminToken(testAdminToken)(failing)
+	WithNotifier(&fakeNotifier{err: errTestNotify})(failing)
+
+	assert.Equal(t, http.StatusBadGateway, markReceived(failing, "lamp", testAdminToken, url.Values{}).Code)
+	assert.True(t, getFundWish(t, failing, "lamp").Status.Received, "the wish stays received for a retry")
+}
+
+func TestServer_ReceivedWish_LeavesList(t *testing.T) {
+	t.Parallel()
+
+	received := newSummaryWish("received", 1, 0)
+	received.Spec.Title = "Received Gift"
+	received.Status.Received = true
+
+	srv := newTestServer(t, newSummaryWish("open", 1, 0), received)
+	WithAdminToken(testAdminToken)(srv)
+
+	rec := getPath(srv.Handler(), "/wishes?format=json")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var items []publicWish
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &items))
+	require.Len(t, items, 1)
+	assert.Equal(t, "open", items[0].Name)
+
+	assert.NotContains(t, getPath(srv.Handler(), "/").Body.String(), "Received Gift")
+	assert.Equal(t, http.StatusConflict, reserveWithNote(srv.Handler(), "received", "").Code)
+
+	var summary adminSummary
+	require.NoError(t, json.Unmarshal(adminRequest(t, srv, "/admin/summary", testAdminToken).Body.Bytes(), &summary))
+	assert.Equal(t, 1, summary.Received)
+	assert.Equal(t, 1, summary.Active)
+}
@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	mathrand "math/rand/v2"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/templates"
+)
+
+// santaConfigMap stores the current secret santa round in the served
+// namespace: one entry per giver, keyed by the hash of their link token and
+// holding the recipient. Givers' names are not stored, so not even a
+// kubectl user can tell who gives to whom.
+const santaConfigMap = "wish-secret-santa"
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;update
+
+var errSantaOwners = errors.New("need at least two distinct owners, each a valid label value")
+
+// santaRound is the JSON body returned when a round is drawn: each giver's
+// private link to their recipient's wishes.
+type santaRound struct {
+	Links map[string]string `json:"links"`
+}
+
+// drawSecretSanta pairs each owner with another owner to give to, such that
+// nobody draws themselves and everyone is drawn exactly once. The owners are
+// shuffled into a single gift circle, each giving to the next, so the result
+// is always a derangement.
+func drawSecretSanta(owners []string) (map[string]string, error) {
+	if len(owners) < 2 { //nolint:mnd // a circle needs two people
+		return nil, errSantaOwners
+	}
+
+	seen := make(map[string]struct{}, len(owners))
+
+	for _, owner := range owners {
+		if _, dup := seen[owner]; dup || owner == "" || len(validation.IsValidLabelValue(owner)) > 0 {
+			return nil, errSantaOwners
+		}
+
+		seen[owner] = struct{}{}
+	}
+
+	circle := slices.Clone(owners)
+	mathrand.Shuffle(len(circle), func(i, j int) { //nolint:gosec // the draw is not a secret; link tokens use crypto/rand
+		circle[i], circle[j] = circle[j], circle[i]
+	})
+
+	pairs := make(map[string]string, len(circle))
+	for i, giver := range circle {
+		pairs[giver] = circle[(i+1)%len(circle)]
+	}
+
+	return pairs, nil
+}
+
+// handleAdminSecretSanta draws a new secret santa round among the owners in
+// the repeated owner form field, replacing any previous round, and returns
+// each giver's link. An owner's list is the wishes labeled with LabelOwner.
+func (s *Server) handleAdminSecretSanta(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+
+	if !s.parseForm(w, r, lang) {
+		return
+	}
+
+	pairs, err := drawSecretSanta(r.Form["owner"])
+	if err != nil {
+		http.Error(w, i18n.T(lang, "err_santa_owners"), http.StatusBadRequest)
+
+		return
+	}
+
+	assignments := make(map[string]string, len(pairs))
+	round := santaRound{Links: make(map[string]string, len(pairs))}
+
+	for giver, recipient := range pairs {
+		token := rand.Text()
+		assignments[hashToken(token)] = recipient
+		round.Links[giver] = s.publicURL + "/santa/" + url.PathEscape(token)
+	}
+
+	if err := s.saveSantaAssignments(r.Context(), assignments); err != nil {
+		http.Error(w, i18n.T(lang, "err_santa_failed"), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if err := json.NewEncoder(w).Encode(round); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+	}
+}
+
+// saveSantaAssignments replaces the stored round with assignments.
+func (s *Server) saveSantaAssignments(ctx context.Context, assignments map[string]string) error {
+	cm := &corev1.ConfigMap{}
+
+	err := s.client.Get(ctx, client.ObjectKey{Name: santaConfigMap, Namespace: s.namespaceFor(ctx)}, cm)
+	if client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("getting secret santa assignments: %w", err)
+	}
+
+	if err != nil {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: santaConfigMap, Namespace: s.namespaceFor(ctx)},
+			Data:       assignments,
+		}
+
+		if err := s.client.Create(ctx, cm); err != nil {
+			return fmt.Errorf("creating secret santa assignments: %w", err)
+		}
+
+		return nil
+	}
+
+	cm.Data = assignments
+
+	if err := s.client.Update(ctx, cm); err != nil {
+		return fmt.Errorf("updating secret santa assignments: %w", err)
+	}
+
+	return nil
+}
+
+// handleSecretSanta shows the holder of a secret santa link their
+// recipient's wishes, and only those.
+func (s *Server) handleSecretSanta(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+	token := r.PathValue("token")
+
+	if len(token) > maxReserverToken {
+		http.Error(w, i18n.T(lang, "err_santa_not_found"), http.StatusNotFound)
+
+		return
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := s.client.Get(r.Context(), s.objectKey(r, santaConfigMap), cm); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			http.Error(w, i18n.T(lang, "err_santa_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
+
+		return
+	}
+
+	recipient, ok := cm.Data[hashToken(token)]
+	if !ok {
+		http.Error(w, i18n.T(lang, "err_santa_not_found"), http.StatusNotFound)
+
+		return
+	}
+
+	wishes, stale, err := s.ownerWishes(r.Context(), recipient)
+	if err != nil {
+		http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
+
+		return
+	}
+
+	s.delayListReservations(r, wishes)
+	s.redactWishes(wishes)
+	ctx := s.listContext(r.Context(), w, stale)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+
+	canonical := i18n.LocalizedPath(lang, "/santa/"+url.PathEscape(token))
+	if err := templates.SantaPage(recipient, wishes, canonical, lang).Render(ctx, w); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+	}
+}
+
+// ownerWishes returns the wishes on owner's list that the public list would
+// show, in the same order but without its cap.
+func (s *Server) ownerWishes(ctx context.Context, owner string) ([]wishlistv1alpha1.Wish, bool, error) {
+	items, stale, err := s.listAllWishes(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	wishes := make([]wishlistv1alpha1.Wish, 0, len(items))
+
+	for i := range items {
+		wish := &items[i]
+		if wish.Labels[wishlistv1alpha1.LabelOwner] != owner ||
+			!wish.Status.Active || wish.Spec.Unlisted || wish.Status.Received {
+			continue
+		}
+
+		wishes = append(wishes, *wish)
+	}
+
+	now := s.clock.Now()
+
+	sort.Slice(wishes, func(i, j int) bool {
+		return wishLess(&wishes[i], &wishes[j], now)
+	})
+
+	return wishes, stale, nil
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func newOwnedWish(name, owner, title string) *wishlistv1alpha1.Wish {
+	wish := newIdempotencyWish(name)
+	wish.Labels = map[string]string{wishlistv1alpha1.LabelOwner: owner}
+	wish.Spec.Title = title
+
+	return wish
+}
+
+func drawRound(srv *Server, owners ...string) *httptest.ResponseRecorder {
+	form := url.Values{"owner": owners}
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/secret-santa", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+testAdminToken)
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestDrawSecretSanta_Derangement(t *testing.T) {
+	t.Parallel()
+
+	owners := []string{"alice", "bob", "carol", "dave", "eve"}
+
+	for n := 2; n <= len(owners); n++ {
+		for range 50 {
+			pairs, err := drawSecretSanta(owners[:n])
+			require.NoError(t, err)
+			require.Len(t, pairs, n)
+
+			drawn := make(map[string]bool, n)
+
+			for giver, recipient := range pairs {
+				assert.NotEqual(t, giver, recipient, "owner drew themselves")
+				assert.Contains(t, owners[:n], recipient)
+				assert.False(t, drawn[recipient], "recipient drawn twice")
+				drawn[recipient] = true
+			}
+		}
+	}
+}
+
+func TestDrawSecretSanta_InvalidOwners(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		owners []string
+	}{
+		{name: "none", owners: nil},
+		{name: "single owner would draw themselves", owners: []string{"alice"}},
+		{name: "duplicate owner could draw themselves", owners: []string{"alice", "bob", "alice"}},
+		{name: "empty owner", owners: []string{"alice", ""}},
+		{name: "not a label value", owners: []string{"alice", "bob smith"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := drawSecretSanta(tt.owners)
+			assert.ErrorIs(t, err, errSantaOwners)
+		})
+	}
+}
+
+func TestServer_SecretSanta_LinksShowOnlyRecipientList(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t,
+		newOwnedWish("alice-book", "alice", "Book for Alice"),
+		newOwnedWish("bob-lamp", "bob", "Lamp for Bob"),
+	)
+	WithAdminToken(testAdminToken)(srv)
+	WithPublicURL("https://wishes.example.com")(srv)
+
+	rec := drawRound(srv, "alice", "bob")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var round santaRound
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&round))
+	require.Len(t, round.Links, 2)
+
+	// With two owners each draws the other.
+	for giver, recipient := range map[string]string{"alice": "Lamp for Bob", "bob": "Book for Alice"} {
+		link, err := url.Parse(round.Links[giver])
+		require.NoError(t, err)
+		assert.Equal(t, "wishes.example.com", link.Host)
+
+		page := getPath(srv.Handler(), link.Path)
+		require.Equal(t, http.StatusOK, page.Code)
+		assert.Equal(t, "no-store", page.Header().Get("Cache-Control"))
+
+		body := page.Body.String()
+		assert.Contains(t, body, recipient)
+		assert.NotContains(t, body, map[string]string{"alice": "Book for Alice", "bob": "Lamp for Bob"}[giver])
+	}
+}
+
+func TestServer_SecretSanta_NewRoundReplacesLinks(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithAdminToken(testAdminToken)(srv)
+
+	var first santaRound
+
+	rec := drawRound(srv, "alice", "bob", "carol")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&first))
+
+	require.Equal(t, http.StatusOK, drawRound(srv, "alice", "bob", "carol").Code)
+
+	assert.Equal(t, http.StatusNotFound, getPath(srv.Handler(), first.Links["alice"]).Code)
+}
+
+func TestServer_SecretSanta_Rejects(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithAdminToken(testAdminToken)(srv)
+
+	assert.Equal(t, http.StatusBadRequest, drawRound(srv, "alice").Code)
+	assert.Equal(t, http.StatusNotFound, getPath(srv.Handler(), "/santa/unknown").Code)
+}
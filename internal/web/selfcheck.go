@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/a-h/templ"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/templates"
+)
+
+// ValidateTemplates renders every page and fragment with an empty wish list
+// and a zero-value wish in each supported language, using the server's render
+// options. It catches templates that fail or panic on missing data at startup
+// instead of on the first request. Templates are compiled Go code, so nothing
+// needs to be cached between requests.
+func (s *Server) ValidateTemplates() error {
+	ctx := s.renderContext(context.Background())
+	wish := &wishlistv1alpha1.Wish{}
+
+	for _, lang := range []string{i18n.LangEN, i18n.LangRU, i18n.LangZH} {
+		components := map[string]templ.Component{
+			"Index":          templates.Index(nil, nil, "", lang),
+			"WishContent":    templates.WishContent(nil, nil, "", lang),
+			"WishPage":       templates.WishPage(wish, lang),
+			"WishCard":       templates.WishCard(wish, lang),
+			"Archive":        templates.Archive(nil, lang),
+			"Activity":       templates.Activity(nil, "", lang),
+			"ReserveConfirm": templates.ReserveConfirm(wish, 0, 0, "", "", lang),
+			"ReserveError":   templates.ReserveError(""),
+			"ReserveSuccess": templates.ReserveSuccess(wish, false, lang),
+			"UnreservePage":  templates.UnreservePage(wish, "", lang),
+			"SantaPage":      templates.SantaPage("", nil, "", lang),
+		}
+
+		for name, component := range components {
+			if err := renderSafely(ctx, component); err != nil {
+				return fmt.Errorf("template %s (%s): %w", name, lang, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// renderSafely renders the component to nowhere, turning a panic into an error.
+func renderSafely(ctx context.Context, component templ.Component) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return component.Render(ctx, io.Discard)
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_ValidateTemplates(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, newTestServer(t).ValidateTemplates())
+
+	configured := newTestServer(t)
+	WithImageProxy(nil)(configured)
+	WithReserveConfirmation(time.Minute)(configured)
+	WithPriorityWeeks(map[int32]int{5: 1})(configured)
+	require.NoError(t, configured.ValidateTemplates())
+}
+
+func TestRenderSafely(t *testing.T) {
+	t.Parallel()
+
+	errRender := errors.New("render failed")
+
+	failing := templ.ComponentFunc(func(context.Context, io.Writer) error { return errRender })
+	require.ErrorIs(t, renderSafely(context.Background(), failing), errRender)
+
+	panicking := templ.ComponentFunc(func(context.Context, io.Writer) error { panic("nil wish") })
+	err := renderSafely(context.Background(), panicking)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nil wish")
+}
@@ -5,49 +5,246 @@ package web
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"net/netip"
 	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
 	"github.com/lexfrei/wish-operator/internal/i18n"
+	"github.com/lexfrei/wish-operator/internal/notify"
 	"github.com/lexfrei/wish-operator/internal/templates"
 
 	"golang.org/x/time/rate"
 )
 
 const (
-	minWeeks       = 1
-	maxWeeks       = 8
-	maxRequestBody = 1 << 20 // 1 MB
+	minWeeks              = 1
+	maxWeeks              = 8
+	minDays               = 1
+	maxDays               = 56
+	defaultMaxRequestBody = 1 << 20 // 1 MB
+	maxNoteLength         = 200
+)
+
+// Reservation duration units accepted by the reserve form.
+const (
+	unitWeeks = "weeks"
+	unitDays  = "days"
 )
 
 // Server handles HTTP requests for the wishlist web interface.
 type Server struct {
 	client    client.Client
+	reader    client.Reader
 	namespace string
 	rateLimit float64
 	rateBurst int
 	limiters  sync.Map
+
+	rateLimitExempt []netip.Prefix
+	trustedProxies  []netip.Prefix
+
+	idempotency *idempotencyStore
+	faviconPath string
+
+	notifier        Notifier
+	messageLimiters sync.Map
+
+	imageClient  *http.Client
+	adminToken   string
+	viewPassword string
+	publicURL    string
+
+	staleMaxAge time.Duration
+	wishCache   wishCache
+	hostCaches  sync.Map
+
+	hostNamespaces     map[string]string
+	rejectUnknownHosts bool
+
+	tagPolicies   map[string]ReservationPolicy
+	priorityWeeks map[int32]int
+
+	listMinPriority int32
+	maxListed       int
+	wholeSets       bool
+	hidePrices      bool
+	reserverLimit   int
+
+	popularThreshold int32
+	readOnly         bool
+	csvAdminOnly     bool
+	reservationDelay time.Duration
+	pollInterval     time.Duration
+
+	maxRequestBody int64
+	requestTimeout time.Duration
+
+	confirmReserve bool
+	pendingTTL     time.Duration
+
+	validation wishlistv1alpha1.ValidationOptions
+
+	clock clock.PassiveClock
+}
+
+// Notifier delivers outbound notifications.
+type Notifier interface {
+	Send(ctx context.Context, event notify.Event) error
+}
+
+// Option configures optional Server behavior.
+type Option func(*Server)
+
+// WithNotifier enables outbound notifications, including the owner message endpoint.
+func WithNotifier(n Notifier) Option {
+	return func(s *Server) {
+		s.notifier = n
+	}
+}
+
+// WithFaviconPath serves the file at path as /favicon.ico instead of the
+// embedded default icon.
+func WithFaviconPath(path string) Option {
+	return func(s *Server) {
+		s.faviconPath = path
+	}
+}
+
+// WithImageProxy serves wish images through GET /img using the given client,
+// so visitors' browsers never contact third-party image hosts. A nil client
+// uses a default with imageFetchTimeout.
+func WithImageProxy(c *http.Client) Option {
+	return func(s *Server) {
+		if c == nil {
+			c = &http.Client{Timeout: imageFetchTimeout}
+		}
+
+		s.imageClient = c
+	}
+}
+
+// WithLengthLimits sets the title and description length limits that wishes
+// created through the admin API must meet, as the validating webhook does.
+func WithLengthLimits(limits wishlistv1alpha1.LengthLimits) Option {
+	return func(s *Server) {
+		s.validation.Lengths = limits
+	}
+}
+
+// WithAdminToken enables the /admin endpoints, which require the token as a
+// bearer token. An empty token leaves them disabled.
+func WithAdminToken(token string) Option {
+	return func(s *Server) {
+		s.adminToken = token
+	}
+}
+
+// WithStaleCache keeps the last successful wish list and serves it, marked
+// stale, for up to maxAge while the Kubernetes API is unreachable. Zero
+// disables the fallback.
+func WithStaleCache(maxAge time.Duration) Option {
+	return func(s *Server) {
+		s.staleMaxAge = maxAge
+	}
+}
+
+// WithCachedReader serves wish lists from reader, typically the manager's
+// informer cache, instead of the client. Reads that precede an update and
+// all writes still go through the client.
+func WithCachedReader(reader client.Reader) Option {
+	return func(s *Server) {
+		s.reader = reader
+	}
+}
+
+// WithMaxRequestBody caps request bodies of form endpoints at limit bytes.
+// Larger bodies are rejected with 413. Zero keeps defaultMaxRequestBody.
+func WithMaxRequestBody(limit int64) Option {
+	return func(s *Server) {
+		if limit > 0 {
+			s.maxRequestBody = limit
+		}
+	}
+}
+
+// WithReserveConfirmation makes the reserve form a two-step flow: the first
+// submit holds a pending reservation for pendingTTL, a second one confirms
+// it. Zero keeps defaultPendingTTL.
+func WithReserveConfirmation(pendingTTL time.Duration) Option {
+	return func(s *Server) {
+		s.confirmReserve = true
+
+		if pendingTTL > 0 {
+			s.pendingTTL = pendingTTL
+		}
+	}
+}
+
+// WithPopularThreshold badges wishes whose Status.Demand, as kept by the
+// controller, reaches threshold as popular. Zero disables the badge.
+func WithPopularThreshold(threshold int32) Option {
+	return func(s *Server) {
+		s.popularThreshold = threshold
+	}
+}
+
+// WithPollInterval makes the wish list in the browser refresh itself this
+// often, so reservations by others show up without a reload. Zero, the
+// default, disables polling.
+func WithPollInterval(interval time.Duration) Option {
+	return func(s *Server) {
+		s.pollInterval = interval
+	}
+}
+
+// WithClock sets the time source for reservation times and expiry checks,
+// so tests can drive them deterministically. The default is the real clock.
+func WithClock(c clock.PassiveClock) Option {
+	return func(s *Server) {
+		s.clock = c
+	}
 }
 
 // NewServer creates a new web server.
-func NewServer(c client.Client, namespace string, rateLimit float64, rateBurst int) *Server {
-	return &Server{
+func NewServer(c client.Client, namespace string, rateLimit float64, rateBurst int, opts ...Option) *Server {
+	s := &Server{
 		client:    c,
 		namespace: namespace,
 		rateLimit: rateLimit,
 		rateBurst: rateBurst,
+
+		idempotency:    newIdempotencyStore(idempotencyTTL),
+		maxRequestBody: defaultMaxRequestBody,
+		pendingTTL:     defaultPendingTTL,
+		validation:     wishlistv1alpha1.DefaultValidationOptions(),
+		clock:          clock.RealClock{},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.reader == nil {
+		s.reader = c
 	}
+
+	return s
 }
 
 // Handler returns the HTTP handler for the server.
@@ -56,9 +253,91 @@ func (s *Server) Handler() http.Handler {
 
 	mux.HandleFunc("GET /", s.handleIndex)
 	mux.HandleFunc("GET /wishes", s.handleWishes)
-	mux.HandleFunc("POST /wishes/{name}/reserve", s.handleReserve)
+	mux.HandleFunc("GET /wishes/archive", s.handleArchive)
+
+	if s.csvAdminOnly {
+		mux.HandleFunc("GET /wishes.csv", s.requireAdmin(s.handleCSV))
+	} else {
+		mux.HandleFunc("GET /wishes.csv", s.handleCSV)
+	}
+
+	mux.HandleFunc("GET /wishes/compare", s.handleCompare)
+	mux.HandleFunc("GET /wishes/{name}", s.handleWish)
+	mux.HandleFunc("GET /wishes/{name}/preview", s.handlePreview)
+	mux.HandleFunc("GET /w/{slug}", s.handleSlug)
+	mux.HandleFunc("POST /wishes/{name}/reserve", s.withIdempotency(s.handleReserve))
+	mux.HandleFunc("POST /wishes/{name}/unreserve", s.handleUnreserve)
+	mux.HandleFunc("GET /wishes/{name}/unreserve", s.handleUnreservePage)
+	mux.HandleFunc("POST /wishes/{name}/contribute", s.withIdempotency(s.handleContribute))
+	mux.HandleFunc("POST /wishes/{name}/close-group", s.handleCloseGroup)
+	mux.HandleFunc("GET /santa/{token}", s.handleSecretSanta)
+	mux.HandleFunc("GET /api/openapi.json", s.handleOpenAPI)
+
+	if s.notifier != nil {
+		mux.HandleFunc("POST /wishes/{name}/message", s.handleMessage)
+	}
+
+	if s.imageClient != nil {
+		mux.HandleFunc("GET /img", s.handleImage)
+	}
+
+	if s.adminToken != "" {
+		mux.HandleFunc("GET /admin/summary", s.requireAdmin(s.handleAdminSummary))
+		mux.HandleFunc("GET /admin/wishes/{name}", s.requireAdmin(s.handleAdminWish))
+		mux.HandleFunc("GET /admin/activity", s.requireAdmin(s.handleAdminActivity))
+		mux.HandleFunc("GET /admin/config", s.requireAdmin(s.handleAdminConfig))
+		mux.HandleFunc("POST /admin/wishes/{name}/received", s.requireAdmin(s.handleAdminReceived))
+		mux.HandleFunc("POST /admin/wishes/{name}/price-checked", s.requireAdmin(s.handleAdminPriceChecked))
+		mux.HandleFunc("POST /admin/wishes/{name}/purchased", s.requireAdmin(s.handleAdminPurchased))
+		mux.HandleFunc("POST /admin/wishes/{name}/extend", s.requireAdmin(s.handleAdminExtend))
+		mux.HandleFunc("POST /admin/wishes/{name}/reserve", s.requireAdmin(s.handleAdminReserve))
+		mux.HandleFunc("POST /admin/wishes", s.requireAdmin(s.handleAdminCreate))
+		mux.HandleFunc("POST /admin/wishes/ttl", s.requireAdmin(s.handleAdminBatchTTL))
+		mux.HandleFunc("POST /admin/wishes/order", s.requireAdmin(s.handleAdminOrder))
+		mux.HandleFunc("POST /admin/secret-santa", s.requireAdmin(s.handleAdminSecretSanta))
+		mux.HandleFunc("POST /admin/reservations/cleanup", s.requireAdmin(s.handleAdminCleanup))
+	}
+
+	// Static assets are cheap and cacheable, so they bypass the rate limiter.
+	root := http.NewServeMux()
+	root.Handle("GET /static/", s.staticHandler())
+	root.HandleFunc("GET /favicon.ico", s.handleFavicon)
+	root.Handle("/", s.readOnlyMiddleware(s.rateLimitMiddleware(s.timeoutMiddleware(mux))))
+
+	return languagePrefixMiddleware(s.hostScopeMiddleware(s.requireViewPassword(root)))
+}
+
+// renderOptions returns the template options derived from server configuration.
+func (s *Server) renderOptions() templates.RenderOptions {
+	return templates.RenderOptions{
+		ImageProxy:       s.imageClient != nil,
+		Reservable:       s.canReserve,
+		SuggestWeeks:     s.suggestWeeks,
+		ConfirmReserve:   s.confirmReserve,
+		AskContact:       s.notifier != nil,
+		PopularThreshold: s.popularThreshold,
+		ReadOnly:         s.readOnly,
+		PollInterval:     s.pollInterval,
+		Now:              s.clock.Now(),
+	}
+}
+
+// renderContext attaches the server's render options to ctx for templates.
+func (s *Server) renderContext(ctx context.Context) context.Context {
+	return templates.WithOptions(ctx, s.renderOptions())
+}
+
+// listContext is like renderContext for pages built from a wish list, marking
+// them stale when the list came from the fallback cache.
+func (s *Server) listContext(ctx context.Context, w http.ResponseWriter, stale bool) context.Context {
+	opts := s.renderOptions()
+	opts.Stale = stale
 
-	return s.rateLimitMiddleware(mux)
+	if stale {
+		w.Header().Set(staleHeader, "true")
+	}
+
+	return templates.WithOptions(ctx, opts)
 }
 
 func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
@@ -73,21 +352,40 @@ func (s *Server) renderWishPage(w http.ResponseWriter, r *http.Request, fullPage
 	lang := i18n.DetectLanguage(r)
 	filterTag := r.URL.Query().Get("tag")
 
-	wishes, allTags, err := s.listWishes(r.Context(), filterTag)
+	minPriority, ok := s.parseMinPriority(r)
+	if !ok {
+		http.Error(w, fmt.Sprintf(i18n.T(lang, "err_min_priority"), maxPriority), http.StatusBadRequest)
+
+		return
+	}
+
+	wishes, allTags, stale, err := s.listWishes(r.Context(), filterTag, minPriority)
 	if err != nil {
 		http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
 
 		return
 	}
 
+	s.delayListReservations(r, wishes)
+	s.redactWishes(wishes)
+	ctx := s.listContext(r.Context(), w, stale)
+
+	if !fullPage && wantsJSON(r) {
+		if err := s.writePublicWishes(w, wishes); err != nil {
+			http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 
 	var renderErr error
 
 	if fullPage {
-		renderErr = templates.Index(wishes, allTags, filterTag, lang).Render(r.Context(), w)
+		renderErr = templates.Index(wishes, allTags, filterTag, lang).Render(ctx, w)
 	} else {
-		renderErr = templates.WishContent(wishes, allTags, filterTag, lang).Render(r.Context(), w)
+		renderErr = templates.WishContent(wishes, allTags, filterTag, lang).Render(ctx, w)
 	}
 
 	if renderErr != nil {
@@ -108,36 +406,53 @@ func (s *Server) handleReserve(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBody)
+	if !s.parseForm(w, r, lang) {
+		return
+	}
 
-	if err := r.ParseForm(); err != nil {
-		http.Error(w, i18n.T(lang, "err_invalid_form"), http.StatusBadRequest)
+	pending, confirm, ok := parseConfirmStep(r)
+	if !ok {
+		writeReserveError(w, r, i18n.T(lang, "err_invalid_form"), http.StatusBadRequest)
 
 		return
 	}
 
-	weeks, err := strconv.Atoi(r.FormValue("weeks"))
-	if err != nil || weeks < minWeeks || weeks > maxWeeks {
-		http.Error(w, fmt.Sprintf(i18n.T(lang, "err_weeks_range"), minWeeks, maxWeeks), http.StatusBadRequest)
+	if confirm {
+		s.confirmReservation(w, r, name)
 
 		return
 	}
 
-	// Parse quantity (default to 1)
-	quantity := int32(1)
-	if qStr := r.FormValue("quantity"); qStr != "" {
-		q, err := strconv.ParseInt(qStr, 10, 32)
-		if err != nil || q < 1 {
-			http.Error(w, i18n.T(lang, "err_invalid_quantity"), http.StatusBadRequest)
+	duration, errMsg := parseReservationDuration(r, lang)
+	if errMsg != "" {
+		writeReserveError(w, r, errMsg, http.StatusBadRequest)
 
-			return
-		}
+		return
+	}
+
+	quantity, ok := parseReserveQuantity(r)
+	if !ok {
+		writeReserveError(w, r, i18n.T(lang, "err_invalid_quantity"), http.StatusBadRequest)
 
-		quantity = int32(q)
+		return
+	}
+
+	note, ok := sanitizeNote(r.FormValue("note"))
+	if !ok {
+		writeReserveError(w, r, fmt.Sprintf(i18n.T(lang, "err_note_length"), maxNoteLength), http.StatusBadRequest)
+
+		return
+	}
+
+	contact, ok := parseContact(r)
+	if !ok {
+		writeReserveError(w, r, fmt.Sprintf(i18n.T(lang, "err_contact_length"), maxNoteLength), http.StatusBadRequest)
+
+		return
 	}
 
 	wish := &wishlistv1alpha1.Wish{}
-	if err := s.client.Get(r.Context(), client.ObjectKey{Name: name, Namespace: s.namespace}, wish); err != nil {
+	if err := s.client.Get(r.Context(), s.objectKey(r, name), wish); err != nil {
 		if client.IgnoreNotFound(err) == nil {
 			http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
 
@@ -149,59 +464,368 @@ func (s *Server) handleReserve(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if wish.Status.Received {
+		writeReserveError(w, r, i18n.T(lang, "err_received"), http.StatusConflict)
+
+		return
+	}
+
+	if wish.IsExpiredAt(s.clock.Now()) {
+		writeReserveError(w, r, i18n.T(lang, "err_wish_expired"), http.StatusConflict)
+
+		return
+	}
+
+	token := ensureReserverToken(w, r)
+	tokenHash := hashToken(token)
+
+	// Group gifts with a coordinator take further givers as pledgers
+	if wish.Spec.GroupGift {
+		if wish.Status.GroupClosed {
+			writeReserveError(w, r, i18n.T(lang, "err_group_closed"), http.StatusConflict)
+
+			return
+		}
+
+		if wish.Status.Coordinator != "" && wish.Status.Coordinator != tokenHash {
+			s.joinGroupGift(w, r, wish, tokenHash)
+
+			return
+		}
+	}
+
+	policy := s.policyFor(wish)
+	if policy == PolicySingle && len(wish.Status.Reservations) > 0 {
+		writeReserveError(w, r, i18n.T(lang, "err_already_reserved"), http.StatusConflict)
+
+		return
+	}
+
 	// Check availability using new Reservations model
-	// Skip validation for unlimited wishes (quantity == 0)
-	if !wish.IsUnlimited() {
+	// Skip validation for unlimited wishes (quantity == 0) and multi-reserve policies
+	if !wish.IsUnlimited() && policy != PolicyMulti {
 		available := wish.AvailableQuantity()
 		if available == 0 {
-			http.Error(w, i18n.T(lang, "err_fully_reserved"), http.StatusConflict)
+			writeReserveError(w, r, i18n.T(lang, "err_fully_reserved"), http.StatusConflict)
 
 			return
 		}
 
 		if quantity > available {
-			http.Error(w, fmt.Sprintf(i18n.T(lang, "err_quantity_exceeds"), available), http.StatusBadRequest)
+			writeReserveError(w, r, fmt.Sprintf(i18n.T(lang, "err_quantity_exceeds"), available), http.StatusBadRequest)
 
 			return
 		}
 	}
 
 	// Create new reservation in new format
-	now := metav1.Now()
-	expires := metav1.NewTime(now.Add(time.Duration(weeks) * 7 * 24 * time.Hour))
+	now := metav1.NewTime(s.clock.Now())
+
+	if s.reserverLimit > 0 {
+		held, err := s.reservationsHeld(r.Context(), tokenHash, now.Time)
+		if err != nil {
+			http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
+
+			return
+		}
+
+		if held >= s.reserverLimit {
+			writeReserveError(w, r, fmt.Sprintf(i18n.T(lang, "err_reserver_limit"), s.reserverLimit), http.StatusConflict)
 
-	wish.Status.Reservations = append(wish.Status.Reservations, wishlistv1alpha1.Reservation{
+			return
+		}
+	}
+
+	// A reservation never outlasts the wish's own TTL; the giver is told
+	expiresAt, shortened := wish.CapReservationExpiry(now.Add(duration))
+	expires := metav1.NewTime(expiresAt)
+
+	reservation := wishlistv1alpha1.Reservation{
 		Quantity:  quantity,
 		CreatedAt: now,
 		ExpiresAt: expires,
-	})
+		TokenHash: tokenHash,
+		Note:      note,
+	}
+
+	// Sets are reserved as a whole, in a single step
+	var setMembers []wishlistv1alpha1.Wish
+
+	if s.wholeSets && wish.Spec.PartOfSet != "" {
+		if setMembers, ok = s.reserveSet(w, r, wish, reservation); !ok {
+			return
+		}
+
+		pending = false
+	}
+
+	if pending {
+		s.holdPending(w, r, wish, reservation, contact)
+
+		return
+	}
+
+	wish.Status.Reservations = append(wish.Status.Reservations, reservation)
+
+	if wish.Spec.GroupGift && wish.Status.Coordinator == "" {
+		wish.Status.Coordinator = tokenHash
+	}
 
 	if err := s.client.Status().Update(r.Context(), wish); err != nil {
+		s.releaseSet(r.Context(), setMembers, reservation)
 		http.Error(w, i18n.T(lang, "err_reserve_failed"), http.StatusInternalServerError)
 
 		return
 	}
 
+	// The other cards of the set changed too
+	if len(setMembers) > 0 {
+		w.Header().Set("HX-Refresh", "true")
+	}
+
+	setReservedTrigger(w, wish)
+
+	if contact != "" && s.notifier != nil {
+		s.sendConfirmation(r.Context(), s.reservationConfirmation(wish, &reservation, token, contact, lang))
+	}
+
+	ctx := s.viewerContext(r.Context(), wish, tokenHash)
+
+	s.redactWish(wish)
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 
-	if err := templates.WishCard(wish, lang).Render(r.Context(), w); err != nil {
+	// The HTMX form swaps in the card along with a thank-you note
+	card := templates.WishCard(wish, lang)
+	if r.Header.Get("HX-Request") == "true" {
+		card = templates.ReserveSuccess(wish, shortened, lang)
+	}
+
+	if err := card.Render(ctx, w); err != nil {
 		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
 	}
 }
 
-func (s *Server) listWishes(ctx context.Context, filterTag string) ([]wishlistv1alpha1.Wish, []string, error) {
-	wishList := &wishlistv1alpha1.WishList{}
-	if err := s.client.List(ctx, wishList, client.InNamespace(s.namespace)); err != nil {
-		return nil, nil, err
+// reservedTrigger is the HX-Trigger payload sent after a reservation, so other
+// parts of the page, such as counters, can follow the wish's availability.
+// Available is omitted for unlimited wishes.
+type reservedTrigger struct {
+	WishReserved reservedEvent `json:"wishReserved"`
+}
+
+type reservedEvent struct {
+	Name      string `json:"name"`
+	Available *int32 `json:"available,omitempty"`
+}
+
+// setReservedTrigger announces the wish's remaining availability in the
+// HX-Trigger header. The wish must already hold the new reservation.
+func setReservedTrigger(w http.ResponseWriter, wish *wishlistv1alpha1.Wish) {
+	event := reservedEvent{Name: wish.Name}
+	if !wish.IsUnlimited() {
+		available := wish.AvailableQuantity()
+		event.Available = &available
+	}
+
+	payload, err := json.Marshal(reservedTrigger{WishReserved: event})
+	if err != nil {
+		return
+	}
+
+	w.Header().Set("HX-Trigger", string(payload))
+}
+
+// writeReserveError reports a reserve form validation error. HTMX requests get a
+// rendered fragment retargeted below the wish's reserve form so it shows
+// inline; other clients get plain text. The status code is the same for both.
+func writeReserveError(w http.ResponseWriter, r *http.Request, message string, status int) {
+	if r.Header.Get("HX-Request") != "true" {
+		http.Error(w, message, status)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("HX-Retarget", "#"+templates.ReserveErrorID(r.PathValue("name")))
+	w.Header().Set("HX-Reswap", "innerHTML")
+	w.WriteHeader(status)
+
+	_ = templates.ReserveError(message).Render(r.Context(), w)
+}
+
+// parseForm limits the request body to the configured size and parses the
+// form. On failure it writes 413 for oversized bodies or 400 otherwise and
+// returns false.
+func (s *Server) parseForm(w http.ResponseWriter, r *http.Request, lang string) bool {
+	r.Body = http.MaxBytesReader(w, r.Body, s.maxRequestBody)
+
+	if err := r.ParseForm(); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, i18n.T(lang, "err_body_too_large"), http.StatusRequestEntityTooLarge)
+
+			return false
+		}
+
+		http.Error(w, i18n.T(lang, "err_invalid_form"), http.StatusBadRequest)
+
+		return false
+	}
+
+	return true
+}
+
+// handleUnreserve releases items the requester reserved earlier, identified
+// by the reserver token cookie. An optional quantity releases only part of
+// what is held; without it everything held under the token is released.
+//
+//nolint:funlen // Mirrors handleReserve validation flow
+func (s *Server) handleUnreserve(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+	name := r.PathValue("name")
+
+	if name == "" {
+		http.Error(w, i18n.T(lang, "err_missing_name"), http.StatusBadRequest)
+
+		return
+	}
+
+	if !s.parseForm(w, r, lang) {
+		return
+	}
+
+	// A token in the form, as sent from the unreserve page, stands in for
+	// the reserver cookie
+	token := r.FormValue("token")
+	if token == "" || len(token) > maxReserverToken {
+		token = reserverTokenFromRequest(r)
+	}
+
+	if token == "" {
+		http.Error(w, i18n.T(lang, "err_no_reservation"), http.StatusForbidden)
+
+		return
+	}
+
+	wish := &wishlistv1alpha1.Wish{}
+	if err := s.client.Get(r.Context(), s.objectKey(r, name), wish); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			http.Error(w, i18n.T(lang, "err_not_found"), http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, i18n.T(lang, "err_get_wish"), http.StatusInternalServerError)
+
+		return
+	}
+
+	tokenHash := hashToken(token)
+
+	held := wish.HeldQuantity(tokenHash)
+	if held == 0 {
+		http.Error(w, i18n.T(lang, "err_no_reservation"), http.StatusForbidden)
+
+		return
+	}
+
+	// Release everything held unless a quantity is given
+	release := held
+	if qStr := r.FormValue("quantity"); qStr != "" {
+		q, err := strconv.ParseInt(qStr, 10, 32)
+		if err != nil || q < 1 {
+			http.Error(w, i18n.T(lang, "err_invalid_quantity"), http.StatusBadRequest)
+
+			return
+		}
+
+		if int32(q) > held {
+			http.Error(w, fmt.Sprintf(i18n.T(lang, "err_release_exceeds"), held), http.StatusBadRequest)
+
+			return
+		}
+
+		release = int32(q)
+	}
+
+	wish.ReleaseQuantity(tokenHash, release)
+
+	if err := s.client.Status().Update(r.Context(), wish); err != nil {
+		http.Error(w, i18n.T(lang, "err_unreserve_failed"), http.StatusInternalServerError)
+
+		return
+	}
+
+	s.redactWish(wish)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	if err := templates.WishCard(wish, lang).Render(s.renderContext(r.Context()), w); err != nil {
+		http.Error(w, i18n.T(lang, "err_render"), http.StatusInternalServerError)
+	}
+}
+
+// parseReserveQuantity reads the quantity form field, defaulting to 1.
+func parseReserveQuantity(r *http.Request) (int32, bool) {
+	value := r.FormValue("quantity")
+	if value == "" {
+		return 1, true
+	}
+
+	quantity, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || quantity < 1 {
+		return 0, false
+	}
+
+	return int32(quantity), true
+}
+
+// parseReservationDuration reads the reservation length from the form.
+// The unit field selects weeks (default, for back-compat) or days; the amount
+// is read from the field named after the unit. On failure it returns a
+// localized error message.
+func parseReservationDuration(r *http.Request, lang string) (time.Duration, string) {
+	unit := r.FormValue("unit")
+	if unit == "" {
+		unit = unitWeeks
+	}
+
+	switch unit {
+	case unitWeeks:
+		weeks, err := strconv.Atoi(r.FormValue(unitWeeks))
+		if err != nil || weeks < minWeeks || weeks > maxWeeks {
+			return 0, fmt.Sprintf(i18n.T(lang, "err_weeks_range"), minWeeks, maxWeeks)
+		}
+
+		return time.Duration(weeks) * 7 * 24 * time.Hour, ""
+	case unitDays:
+		days, err := strconv.Atoi(r.FormValue(unitDays))
+		if err != nil || days < minDays || days > maxDays {
+			return 0, fmt.Sprintf(i18n.T(lang, "err_days_range"), minDays, maxDays)
+		}
+
+		return time.Duration(days) * 24 * time.Hour, ""
+	default:
+		return 0, i18n.T(lang, "err_invalid_unit")
+	}
+}
+
+// listWishes returns the active, listed wishes in display order, optionally
+// limited to a tag and to wishes of at least minPriority, together with the
+// tags available for filtering.
+func (s *Server) listWishes(
+	ctx context.Context, filterTag string, minPriority int32,
+) ([]wishlistv1alpha1.Wish, []string, bool, error) {
+	items, stale, err := s.listAllWishes(ctx)
+	if err != nil {
+		return nil, nil, false, err
 	}
 
 	// Collect all unique tags and filter active wishes
 	tagSet := make(map[string]struct{})
-	active := make([]wishlistv1alpha1.Wish, 0, len(wishList.Items))
+	active := make([]wishlistv1alpha1.Wish, 0, len(items))
 
-	for i := range wishList.Items {
-		wish := &wishList.Items[i]
-		if !wish.Status.Active {
+	for i := range items {
+		wish := &items[i]
+		if !wish.Status.Active || wish.Spec.Unlisted || wish.Status.Received || wish.Spec.Priority < minPriority {
 			continue
 		}
 
@@ -224,15 +848,14 @@ func (s *Server) listWishes(ctx context.Context, filterTag string) ([]wishlistv1
 		active = append(active, *wish)
 	}
 
-	// Sort by priority descending (highest stars first), then by title alphabetically
-	sort.Slice(active, func(i, j int) bool {
-		if active[i].Spec.Priority != active[j].Spec.Priority {
-			return active[i].Spec.Priority > active[j].Spec.Priority
-		}
+	now := s.clock.Now()
 
-		return active[i].Spec.Title < active[j].Spec.Title
+	sort.Slice(active, func(i, j int) bool {
+		return wishLess(&active[i], &active[j], now)
 	})
 
+	active = s.capListed(ctx, active)
+
 	// Convert tag set to sorted slice
 	allTags := make([]string, 0, len(tagSet))
 	for tag := range tagSet {
@@ -241,7 +864,42 @@ func (s *Server) listWishes(ctx context.Context, filterTag string) ([]wishlistv1
 
 	sort.Strings(allTags)
 
-	return active, allTags, nil
+	return active, allTags, stale, nil
+}
+
+// wishLess orders wishes by explicit Order ascending (unordered wishes last),
+// then wishes needed soon by their NeededBy date, then by priority descending
+// (highest stars first, decayed if the controller decays priorities), then
+// by title alphabetically.
+func wishLess(a, b *wishlistv1alpha1.Wish, now time.Time) bool {
+	switch {
+	case a.Spec.Order != nil && b.Spec.Order != nil:
+		if *a.Spec.Order != *b.Spec.Order {
+			return *a.Spec.Order < *b.Spec.Order
+		}
+	case a.Spec.Order != nil:
+		return true
+	case b.Spec.Order != nil:
+		return false
+	}
+
+	aSoon, bSoon := templates.NeededSoon(a, now), templates.NeededSoon(b, now)
+	switch {
+	case aSoon && bSoon:
+		if !a.Spec.NeededBy.Equal(b.Spec.NeededBy) {
+			return a.Spec.NeededBy.Before(b.Spec.NeededBy)
+		}
+	case aSoon:
+		return true
+	case bSoon:
+		return false
+	}
+
+	if aPriority, bPriority := a.SortPriority(), b.SortPriority(); aPriority != bPriority {
+		return aPriority > bPriority
+	}
+
+	return a.Spec.Title < b.Spec.Title
 }
 
 func (s *Server) wishHasTag(wish *wishlistv1alpha1.Wish, tag string) bool {
@@ -251,11 +909,15 @@ func (s *Server) wishHasTag(wish *wishlistv1alpha1.Wish, tag string) bool {
 func (s *Server) rateLimitMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ip := s.getClientIP(r)
-		limiter := s.getLimiter(ip)
 
-		if !limiter.Allow() {
-			lang := i18n.DetectLanguage(r)
-			http.Error(w, i18n.T(lang, "err_rate_limit"), http.StatusTooManyRequests)
+		if s.isRateLimitExempt(ip) {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		if limiter := s.getLimiter(ip); !limiter.Allow() {
+			writeRateLimited(w, r, limiter)
 
 			return
 		}
@@ -265,38 +927,64 @@ func (s *Server) rateLimitMiddleware(next http.Handler) http.Handler {
 }
 
 func (s *Server) getLimiter(ip string) *rate.Limiter {
-	if v, ok := s.limiters.Load(ip); ok {
+	return loadLimiter(&s.limiters, ip, rate.Limit(s.rateLimit), s.rateBurst)
+}
+
+// loadLimiter returns the limiter stored under key, creating it on first use.
+func loadLimiter(limiters *sync.Map, key string, limit rate.Limit, burst int) *rate.Limiter {
+	if v, ok := limiters.Load(key); ok {
 		limiter, isLimiter := v.(*rate.Limiter)
 		if isLimiter {
 			return limiter
 		}
 	}
 
-	limiter := rate.NewLimiter(rate.Limit(s.rateLimit), s.rateBurst)
-	s.limiters.Store(ip, limiter)
+	limiter := rate.NewLimiter(limit, burst)
+	limiters.Store(key, limiter)
 
 	return limiter
 }
 
+// getClientIP returns the address rate limits apply to. Forwarding headers
+// are only believed from trusted proxies; X-Forwarded-For is then read from
+// the right, skipping further trusted proxies, since a client can prepend
+// whatever it likes.
 func (s *Server) getClientIP(r *http.Request) string {
-	// Check X-Forwarded-For header
-	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
-		ips := strings.Split(xff, ",")
-		if len(ips) > 0 {
-			return strings.TrimSpace(ips[0])
+	peer, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		peer = r.RemoteAddr
+	}
+
+	if !s.isTrustedProxy(peer) {
+		return peer
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop != "" && (i == 0 || !s.isTrustedProxy(hop)) {
+			return hop
 		}
 	}
 
-	// Check X-Real-IP header
-	if xri := r.Header.Get("X-Real-IP"); xri != "" {
+	if xri := strings.TrimSpace(r.Header.Get("X-Real-IP")); xri != "" {
 		return xri
 	}
 
-	// Fall back to RemoteAddr
-	host, _, err := net.SplitHostPort(r.RemoteAddr)
-	if err != nil {
-		return r.RemoteAddr
-	}
+	return peer
+}
+
+// sanitizeNote normalizes a giver's reservation note: control characters,
+// including line breaks, become spaces and surrounding whitespace is trimmed.
+// It reports false if the result is longer than maxNoteLength runes.
+func sanitizeNote(note string) (string, bool) {
+	note = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+
+		return r
+	}, note))
 
-	return host
+	return note, utf8.RuneCountInString(note) <= maxNoteLength
 }
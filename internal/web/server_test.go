@@ -5,21 +5,28 @@ package web
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
 )
 
 // Shared test fixtures to avoid duplicated string literals.
@@ -39,6 +46,7 @@ func newTestServer(t *testing.T, wishes ...*wishlistv1alpha1.Wish) *Server {
 	scheme := runtime.NewScheme()
 	err := wishlistv1alpha1.AddToScheme(scheme)
 	require.NoError(t, err)
+	require.NoError(t, corev1.AddToScheme(scheme))
 
 	objs := make([]client.Object, len(wishes))
 	for i, w := range wishes {
@@ -49,6 +57,7 @@ func newTestServer(t *testing.T, wishes ...*wishlistv1alpha1.Wish) *Server {
 		WithScheme(scheme).
 		WithObjects(objs...).
 		WithStatusSubresource(objs...).
+		WithIndex(&wishlistv1alpha1.Wish{}, wishlistv1alpha1.SlugField, slugIndexValue).
 		Build()
 
 	return NewServer(fakeClient, testNamespace, 30, 10)
@@ -443,3 +452,688 @@ func TestServer_HandleReserve_UnlimitedMultiple(t *testing.T) {
 	assert.Equal(t, int32(50), final.Status.Reservations[0].Quantity)
 	assert.Equal(t, int32(75), final.Status.Reservations[1].Quantity)
 }
+
+func TestServer_HandleReserve_Days(t *testing.T) {
+	t.Parallel()
+
+	wish := &wishlistv1alpha1.Wish{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "days-wish",
+			Namespace: testNamespace,
+		},
+		Spec: wishlistv1alpha1.WishSpec{
+			Title: testTitleGift,
+		},
+		Status: wishlistv1alpha1.WishStatus{
+			Active: true,
+		},
+	}
+
+	srv := newTestServer(t, wish)
+	handler := srv.Handler()
+
+	form := url.Values{}
+	form.Set("unit", "days")
+	form.Set("days", "3")
+
+	req := httptest.NewRequest(http.MethodPost, "/wishes/days-wish/reserve", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	updated := &wishlistv1alpha1.Wish{}
+	err := srv.client.Get(context.Background(), client.ObjectKey{Name: "days-wish", Namespace: testNamespace}, updated)
+	require.NoError(t, err)
+	require.Len(t, updated.Status.Reservations, 1)
+
+	expectedExpiry := updated.Status.Reservations[0].CreatedAt.Add(3 * 24 * time.Hour)
+	assert.WithinDuration(t, expectedExpiry, updated.Status.Reservations[0].ExpiresAt.Time, time.Second)
+}
+
+func TestServer_HandleReserve_ClampedToTTL(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+
+	ttlWish := func(name string, age time.Duration, ttl *metav1.Duration) *wishlistv1alpha1.Wish {
+		return &wishlistv1alpha1.Wish{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Spec: wishlistv1alpha1.WishSpec{
+				Title:    testTitleGift,
+				Quantity: 1,
+				TTL:      ttl,
+			},
+			Status: wishlistv1alpha1.WishStatus{Active: true},
+		}
+	}
+
+	srv := newTestServer(t,
+		ttlWish("short", 5*24*time.Hour, &metav1.Duration{Duration: 19 * 24 * time.Hour}),
+		ttlWish("long", 0, &metav1.Duration{Duration: 365 * 24 * time.Hour}),
+		ttlWish("gone", 3*24*time.Hour, &metav1.Duration{Duration: 24 * time.Hour}),
+		ttlWish("forever", 0, nil),
+	)
+	WithClock(clocktesting.NewFakePassiveClock(now))(srv)
+
+	handler := srv.Handler()
+	form := url.Values{"weeks": {"8"}}
+
+	tests := []struct {
+		name      string
+		want      time.Time
+		shortened bool
+	}{
+		{name: "short", want: now.Add(14 * 24 * time.Hour), shortened: true},
+		{name: "long", want: now.Add(8 * 7 * 24 * time.Hour)},
+		{name: "forever", want: now.Add(8 * 7 * 24 * time.Hour)},
+	}
+
+	for _, tc := range tests {
+		rec := htmxReserve(handler, tc.name, form)
+		require.Equal(t, http.StatusOK, rec.Code, tc.name)
+
+		wish := &wishlistv1alpha1.Wish{}
+		require.NoError(t, srv.client.Get(t.Context(), client.ObjectKey{Name: tc.name, Namespace: testNamespace}, wish))
+		require.Len(t, wish.Status.Reservations, 1, tc.name)
+		assert.True(t, tc.want.Equal(wish.Status.Reservations[0].ExpiresAt.Time), "%s expires at %s", tc.name, wish.Status.Reservations[0].ExpiresAt)
+
+		shortened := fmt.Sprintf(i18n.T(i18n.LangEN, "reserve_success_shortened"), i18n.FormatDate(i18n.LangEN, tc.want))
+		if tc.shortened {
+			assert.Contains(t, rec.Body.String(), shortened, tc.name)
+		} else {
+			assert.NotContains(t, rec.Body.String(), "when this wish ends", tc.name)
+		}
+	}
+
+	rec := htmxReserve(handler, "gone", form)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), i18n.T(i18n.LangEN, "err_wish_expired"))
+
+	gone := &wishlistv1alpha1.Wish{}
+	require.NoError(t, srv.client.Get(t.Context(), client.ObjectKey{Name: "gone", Namespace: testNamespace}, gone))
+	assert.Empty(t, gone.Status.Reservations)
+}
+
+func TestServer_HandleReserve_InvalidDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		unit     string
+		field    string
+		value    string
+		expected int
+	}{
+		{"min days", "days", "days", "1", http.StatusOK},
+		{"max days", "days", "days", "56", http.StatusOK},
+		{"zero days", "days", "days", "0", http.StatusBadRequest},
+		{"too many days", "days", "days", "57", http.StatusBadRequest},
+		{"days unit reads days field", "days", "weeks", "2", http.StatusBadRequest},
+		{"explicit weeks unit", "weeks", "weeks", "8", http.StatusOK},
+		{"unknown unit", "months", "months", "1", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "duration-wish",
+					Namespace: testNamespace,
+				},
+				Spec: wishlistv1alpha1.WishSpec{
+					Title: testTitleGift,
+				},
+				Status: wishlistv1alpha1.WishStatus{
+					Active: true,
+				},
+			}
+
+			srv := newTestServer(t, wish)
+			handler := srv.Handler()
+
+			form := url.Values{}
+			form.Set("unit", tt.unit)
+			form.Set(tt.field, tt.value)
+
+			req := httptest.NewRequest(http.MethodPost, "/wishes/duration-wish/reserve", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
+
+func TestServer_HandleUnreserve(t *testing.T) {
+	t.Parallel()
+
+	const name = "unreserve-wish"
+
+	tests := []struct {
+		name      string
+		release   string
+		wantCode  int
+		wantTotal int32
+	}{
+		{"partial release", "1", http.StatusOK, 2},
+		{"full release by quantity", "3", http.StatusOK, 0},
+		{"full release by default", "", http.StatusOK, 0},
+		{"release more than held", "4", http.StatusBadRequest, 3},
+		{"invalid quantity", "0", http.StatusBadRequest, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := &wishlistv1alpha1.Wish{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+				Spec:       wishlistv1alpha1.WishSpec{Title: testTitleGift, Quantity: 5},
+				Status:     wishlistv1alpha1.WishStatus{Active: true},
+			}
+
+			srv := newTestServer(t, wish)
+			handler := srv.Handler()
+
+			form := url.Values{}
+			form.Set("weeks", "1")
+			form.Set("quantity", "3")
+
+			req := httptest.NewRequest(http.MethodPost, "/wishes/"+name+"/reserve", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			cookies := rec.Result().Cookies()
+			require.Len(t, cookies, 1)
+			assert.Equal(t, reserverCookie, cookies[0].Name)
+			assert.True(t, cookies[0].HttpOnly)
+
+			release := url.Values{}
+			if tt.release != "" {
+				release.Set("quantity", tt.release)
+			}
+
+			req = httptest.NewRequest(http.MethodPost, "/wishes/"+name+"/unreserve", strings.NewReader(release.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.AddCookie(cookies[0])
+			rec = httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+
+			updated := &wishlistv1alpha1.Wish{}
+			require.NoError(t, srv.client.Get(context.Background(), client.ObjectKey{Name: name, Namespace: testNamespace}, updated))
+			assert.Equal(t, tt.wantTotal, updated.TotalReserved())
+
+			if tt.wantTotal == 0 {
+				assert.Empty(t, updated.Status.Reservations)
+			}
+		})
+	}
+}
+
+func TestServer_HandleUnreserve_ForeignToken(t *testing.T) {
+	t.Parallel()
+
+	now := metav1.Now()
+	wish := &wishlistv1alpha1.Wish{
+		ObjectMeta: metav1.ObjectMeta{Name: "held-wish", Namespace: testNamespace},
+		Spec:       wishlistv1alpha1.WishSpec{Title: testTitleGift, Quantity: 2},
+		Status: wishlistv1alpha1.WishStatus{
+			Active: true,
+			Reservations: []wishlistv1alpha1.Reservation{{
+				Quantity:  2,
+				CreatedAt: now,
+				ExpiresAt: metav1.NewTime(now.Add(time.Hour)),
+				TokenHash: hashToken("owner-token"),
+			}},
+		},
+	}
+
+	srv := newTestServer(t, wish)
+	handler := srv.Handler()
+
+	for _, cookie := range []*http.Cookie{nil, {Name: reserverCookie, Value: "someone-else"}} {
+		req := httptest.NewRequest(http.MethodPost, "/wishes/held-wish/unreserve", nil)
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	}
+}
+
+func TestWishLess_Order(t *testing.T) {
+	t.Parallel()
+
+	order := func(n int32) *int32 { return &n }
+
+	newWish := func(name string, ord *int32, priority int32) wishlistv1alpha1.Wish {
+		return wishlistv1alpha1.Wish{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+			Spec: wishlistv1alpha1.WishSpec{
+				Title:    name,
+				Order:    ord,
+				Priority: priority,
+			},
+			Status: wishlistv1alpha1.WishStatus{Active: true},
+		}
+	}
+
+	wishes := []wishlistv1alpha1.Wish{
+		newWish("unordered-high", nil, 5),
+		newWish("second", order(2), 1),
+		newWish("unordered-low", nil, 1),
+		newWish("first", order(1), 0),
+		newWish("zeroth", order(0), 3),
+	}
+
+	objs := make([]*wishlistv1alpha1.Wish, len(wishes))
+	for i := range wishes {
+		objs[i] = &wishes[i]
+	}
+
+	srv := newTestServer(t, objs...)
+
+	sorted, _, _, err := srv.listWishes(context.Background(), "", 0)
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(sorted))
+	for _, w := range sorted {
+		names = append(names, w.Name)
+	}
+
+	// Explicit order wins over priority; unordered wishes follow, by priority.
+	assert.Equal(t, []string{"zeroth", "first", "second", "unordered-high", "unordered-low"}, names)
+}
+
+func TestWishLess_NeededSoon(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC)
+	neededIn := func(d time.Duration) *metav1.Time {
+		return &metav1.Time{Time: now.Add(d)}
+	}
+	order := int32(0)
+
+	wishes := []wishlistv1alpha1.Wish{
+		{ObjectMeta: metav1.ObjectMeta{Name: "later"}, Spec: wishlistv1alpha1.WishSpec{Priority: 5, NeededBy: neededIn(30 * 24 * time.Hour)}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "soon"}, Spec: wishlistv1alpha1.WishSpec{Priority: 1, NeededBy: neededIn(3 * 24 * time.Hour)}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "overdue"}, Spec: wishlistv1alpha1.WishSpec{Priority: 1, NeededBy: neededIn(-time.Hour)}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ordered"}, Spec: wishlistv1alpha1.WishSpec{Order: &order}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "plain"}, Spec: wishlistv1alpha1.WishSpec{Priority: 3}},
+	}
+
+	sort.Slice(wishes, func(i, j int) bool {
+		return wishLess(&wishes[i], &wishes[j], now)
+	})
+
+	names := make([]string, 0, len(wishes))
+	for _, w := range wishes {
+		names = append(names, w.Name)
+	}
+
+	// Explicit order still wins; wishes due within the window come next,
+	// earliest first, and the rest keep the priority order.
+	assert.Equal(t, []string{"ordered", "overdue", "soon", "later", "plain"}, names)
+}
+
+func TestWishLess_EffectivePriority(t *testing.T) {
+	t.Parallel()
+
+	wishes := []wishlistv1alpha1.Wish{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "old-favorite"},
+			Spec:       wishlistv1alpha1.WishSpec{Priority: 5},
+			Status:     wishlistv1alpha1.WishStatus{EffectivePriority: 2},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "fresh"},
+			Spec:       wishlistv1alpha1.WishSpec{Priority: 4},
+			Status:     wishlistv1alpha1.WishStatus{EffectivePriority: 4},
+		},
+		{ObjectMeta: metav1.ObjectMeta{Name: "undecayed"}, Spec: wishlistv1alpha1.WishSpec{Priority: 3}},
+	}
+
+	sort.Slice(wishes, func(i, j int) bool {
+		return wishLess(&wishes[i], &wishes[j], time.Now())
+	})
+
+	names := make([]string, 0, len(wishes))
+	for _, w := range wishes {
+		names = append(names, w.Name)
+	}
+
+	// A decayed effective priority sorts in place of the owner's priority.
+	assert.Equal(t, []string{"fresh", "undecayed", "old-favorite"}, names)
+}
+
+func reserveWithNote(handler http.Handler, name, note string) *httptest.ResponseRecorder {
+	form := url.Values{}
+	form.Set("weeks", "2")
+	form.Set("note", note)
+
+	req := httptest.NewRequest(http.MethodPost, "/wishes/"+name+"/reserve", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandleReserve_Note(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("note-wish"))
+	handler := srv.Handler()
+
+	rec := reserveWithNote(handler, "note-wish", "  I'll buy\nthe blue one\t ")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// The public card never shows the note.
+	assert.NotContains(t, rec.Body.String(), "the blue one")
+
+	updated := &wishlistv1alpha1.Wish{}
+	err := srv.client.Get(context.Background(), client.ObjectKey{Name: "note-wish", Namespace: testNamespace}, updated)
+	require.NoError(t, err)
+	require.Len(t, updated.Status.Reservations, 1)
+	assert.Equal(t, "I'll buy the blue one", updated.Status.Reservations[0].Note)
+
+	page := httptest.NewRecorder()
+	handler.ServeHTTP(page, httptest.NewRequest(http.MethodGet, "/wishes", nil))
+	require.Equal(t, http.StatusOK, page.Code)
+	assert.NotContains(t, page.Body.String(), "the blue one")
+}
+
+func TestServer_HandleReserve_NoteTooLong(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("long-note"))
+
+	rec := reserveWithNote(srv.Handler(), "long-note", strings.Repeat("ж", maxNoteLength+1))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = reserveWithNote(srv.Handler(), "long-note", strings.Repeat("ж", maxNoteLength))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestServer_FormEndpoints_BodyTooLarge(t *testing.T) {
+	t.Parallel()
+
+	wish := newFundWish("bike", 1000)
+	wish.Spec.OwnerContact = "@owner"
+
+	srv := newTestServer(t, wish)
+	WithNotifier(&fakeNotifier{})(srv)
+	WithMaxRequestBody(64)(srv)
+
+	handler := srv.Handler()
+	oversized := "note=" + strings.Repeat("a", 128)
+
+	for _, action := range []string{"reserve", "unreserve", "message", "contribute"} {
+		t.Run(action, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, "/wishes/bike/"+action, strings.NewReader(oversized))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.AddCookie(&http.Cookie{Name: reserverCookie, Value: "token"})
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+			assert.Contains(t, rec.Body.String(), "too large")
+		})
+	}
+}
+
+func TestServer_FormEndpoints_BodyWithinLimit(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("small"))
+	WithMaxRequestBody(64)(srv)
+
+	assert.Equal(t, http.StatusOK, reserveWithNote(srv.Handler(), "small", "short").Code)
+}
+
+func htmxReserve(handler http.Handler, name string, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/wishes/"+name+"/reserve", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("HX-Request", "true")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_HandleReserve_HTMXErrorFragment(t *testing.T) {
+	t.Parallel()
+
+	taken := newTaggedWish("balloon-ride", "experience")
+	taken.Spec.Quantity = 2
+
+	srv := newTestServer(t, newIdempotencyWish("gift"), taken)
+	WithTagPolicies(map[string]ReservationPolicy{"experience": PolicySingle})(srv)
+
+	handler := srv.Handler()
+	require.Equal(t, http.StatusOK, reserveWithNote(handler, "balloon-ride", "").Code)
+
+	tests := []struct {
+		name     string
+		wish     string
+		form     url.Values
+		wantCode int
+		wantText string
+	}{
+		{
+			name:     "invalid weeks",
+			wish:     "gift",
+			form:     url.Values{"weeks": {"12"}},
+			wantCode: http.StatusBadRequest,
+			wantText: "Weeks must be between 1 and 8",
+		},
+		{
+			name:     "already reserved",
+			wish:     "balloon-ride",
+			form:     url.Values{"weeks": {"2"}},
+			wantCode: http.StatusConflict,
+			wantText: "Wish is already reserved",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := htmxReserve(handler, tt.wish, tt.form)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+			assert.Equal(t, "#reserve-error-"+tt.wish, rec.Header().Get("HX-Retarget"))
+			assert.Equal(t, "innerHTML", rec.Header().Get("HX-Reswap"))
+			assert.Equal(t, `<div class="reserve-error" role="alert">`+tt.wantText+`</div>`, rec.Body.String())
+		})
+	}
+}
+
+func TestServer_HandleReserve_PlainErrorWithoutHTMX(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("gift"))
+
+	form := url.Values{"weeks": {"12"}}
+	req := httptest.NewRequest(http.MethodPost, "/wishes/gift/reserve", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/plain")
+	assert.Empty(t, rec.Header().Get("HX-Retarget"))
+}
+
+func TestServer_HandleReserve_HTMXSuccessMessage(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("gift"), newIdempotencyWish("plain"))
+	handler := srv.Handler()
+
+	form := url.Values{"weeks": {"2"}}
+	req := httptest.NewRequest(http.MethodPost, "/wishes/gift/reserve", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("HX-Request", "true")
+	req.Header.Set("Accept-Language", "ru-RU,ru;q=0.9")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	expires := getFundWish(t, srv, "gift").Status.Reservations[0].ExpiresAt.Time
+	assert.Contains(t, rec.Body.String(), `<div class="reserve-success" role="status">`)
+	assert.Contains(t, rec.Body.String(), fmt.Sprintf("Забронировано до %s, спасибо!", i18n.FormatDate("ru", expires)))
+
+	// Plain form posts keep getting the bare card
+	assert.NotContains(t, reserveWithNote(handler, "plain", "").Body.String(), "reserve-success")
+}
+
+func TestServer_HandleReserve_ReportsAvailability(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+
+	rec := htmxReserve(srv.Handler(), "lamp", url.Values{"weeks": {"2"}, "quantity": {"2"}})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	assert.Contains(t, rec.Body.String(), "Available: 3/5")
+	assert.JSONEq(t, `{"wishReserved": {"name": "lamp", "available": 3}}`, rec.Header().Get("HX-Trigger"))
+
+	unlimited := newIdempotencyWish("balloons")
+	unlimited.Spec.Quantity = 0
+
+	srv = newTestServer(t, unlimited)
+
+	rec = htmxReserve(srv.Handler(), "balloons", url.Values{"weeks": {"2"}})
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"wishReserved": {"name": "balloons"}}`, rec.Header().Get("HX-Trigger"))
+}
+
+func TestServer_CachedReader(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, wishlistv1alpha1.AddToScheme(scheme))
+
+	wish := newSummaryWish("cached-gift", 1, 0)
+	cached := fake.NewClientBuilder().WithScheme(scheme).WithObjects(wish).Build()
+
+	direct := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+				return errAPIUnavailable
+			},
+		}).
+		Build()
+
+	srv := NewServer(direct, testNamespace, 30, 10, WithCachedReader(cached))
+
+	rec := getWishes(srv)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "cached-gift")
+}
+
+func TestNewServer_ReadsThroughClientByDefault(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newSummaryWish("direct-gift", 1, 0))
+
+	assert.Equal(t, srv.client, srv.reader)
+	assert.Contains(t, getWishes(srv).Body.String(), "direct-gift")
+}
+
+func TestServer_PopularBadge(t *testing.T) {
+	t.Parallel()
+
+	popular := newSummaryWish("popular-gift", 5, 0)
+	popular.Status.Demand = 4
+
+	quiet := newSummaryWish("quiet-gift", 5, 0)
+	quiet.Status.Demand = 1
+
+	srv := newTestServer(t, popular, quiet)
+	assert.NotContains(t, getWishes(srv).Body.String(), "popular-badge")
+
+	WithPopularThreshold(3)(srv)
+
+	body := getWishes(srv).Body.String()
+	assert.Equal(t, 1, strings.Count(body, `class="popular-badge"`))
+	assert.Contains(t, body, "Popular")
+}
+
+func TestServer_PollInterval(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	handler := srv.Handler()
+
+	for _, path := range []string{"/", "/wishes"} {
+		assert.NotContains(t, getPath(handler, path).Body.String(), "data-poll-interval", path)
+	}
+
+	WithPollInterval(45 * time.Second)(srv)
+	handler = srv.Handler()
+
+	for _, path := range []string{"/", "/wishes", "/ru/wishes"} {
+		rec := getPath(handler, path)
+		require.Equal(t, http.StatusOK, rec.Code, path)
+		assert.Contains(t, rec.Body.String(), `data-poll-interval="45"`, path)
+		assert.Contains(t, rec.Body.String(), `hx-trigger="every 45s"`, path)
+	}
+}
+
+func TestServer_Views_UseServerClock(t *testing.T) {
+	t.Parallel()
+
+	lamp := newSummaryWish("lamp", 2, 0)
+	lamp.Status.Reservations = []wishlistv1alpha1.Reservation{
+		{Quantity: 1, ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour))},
+	}
+
+	srv := newTestServer(t, lamp, newArchiveWish("vase", testNamespace, "Glass Vase", time.Hour, 2*time.Hour))
+	WithAdminToken(testAdminToken)(srv)
+	WithClock(clocktesting.NewFakePassiveClock(time.Now().Add(3 * time.Hour)))(srv)
+
+	handler := srv.Handler()
+
+	item := getPublicWish(t, handler, "/wishes/lamp")
+	assert.Empty(t, item.Reservations, "the reservation has lapsed by the server's clock")
+
+	body := getPath(handler, "/wishes/lamp").Body.String()
+	assert.Contains(t, body, "reservation-expiring")
+	assert.NotContains(t, body, "reserved until")
+
+	assert.Contains(t, getPath(handler, "/wishes/vase").Body.String(), "archived", "the wish has expired by the server's clock")
+
+	var summary adminSummary
+	require.NoError(t, json.Unmarshal(adminRequest(t, srv, "/admin/summary", testAdminToken).Body.Bytes(), &summary))
+	assert.Equal(t, 1, summary.Expired)
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// WithWholeSets makes reserving a wish that is part of a set reserve the
+// other active wishes of the set along with it, so a set is never given
+// partially. The reservation is refused if any of them lacks the quantity.
+// Set reservations skip the confirmation step.
+func WithWholeSets() Option {
+	return func(s *Server) {
+		s.wholeSets = true
+	}
+}
+
+// reserveSet adds the reservation to the other active wishes of the wish's
+// set and returns them, so the caller can release them again if reserving the
+// wish itself fails. On failure it writes the error response and returns
+// false.
+func (s *Server) reserveSet(
+	w http.ResponseWriter, r *http.Request, wish *wishlistv1alpha1.Wish, reservation wishlistv1alpha1.Reservation,
+) ([]wishlistv1alpha1.Wish, bool) {
+	lang := i18n.DetectLanguage(r)
+
+	wishList := &wishlistv1alpha1.WishList{}
+	if err := s.client.List(r.Context(), wishList, client.InNamespace(s.namespaceFor(r.Context()))); err != nil {
+		http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
+
+		return nil, false
+	}
+
+	members := make([]wishlistv1alpha1.Wish, 0, len(wishList.Items))
+
+	for i := range wishList.Items {
+		member := &wishList.Items[i]
+		if member.Name == wish.Name || member.Spec.PartOfSet != wish.Spec.PartOfSet ||
+			!member.Status.Active || member.Status.Received {
+			continue
+		}
+
+		if !member.IsUnlimited() && member.AvailableQuantity() < reservation.Quantity {
+			writeReserveError(w, r, i18n.T(lang, "err_set_unavailable"), http.StatusConflict)
+
+			return nil, false
+		}
+
+		members = append(members, *member)
+	}
+
+	for i := range members {
+		members[i].Status.Reservations = append(members[i].Status.Reservations, reservation)
+
+		if err := s.client.Status().Update(r.Context(), &members[i]); err != nil {
+			s.releaseSet(r.Context(), members[:i], reservation)
+			http.Error(w, i18n.T(lang, "err_reserve_failed"), http.StatusInternalServerError)
+
+			return nil, false
+		}
+	}
+
+	return members, true
+}
+
+// releaseSet removes the reservation from set members it was added to. It is
+// best effort: a member that cannot be updated keeps the reservation until it
+// expires.
+func (s *Server) releaseSet(
+	ctx context.Context, members []wishlistv1alpha1.Wish, reservation wishlistv1alpha1.Reservation,
+) {
+	for i := range members {
+		members[i].Status.Reservations = slices.DeleteFunc(members[i].Status.Reservations,
+			func(res wishlistv1alpha1.Reservation) bool {
+				return res.TokenHash == reservation.TokenHash && res.CreatedAt.Equal(&reservation.CreatedAt)
+			})
+
+		_ = s.client.Status().Update(ctx, &members[i])
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func newSetWish(name, set string) *wishlistv1alpha1.Wish {
+	wish := newIdempotencyWish(name)
+	wish.Spec.PartOfSet = set
+
+	return wish
+}
+
+func TestServer_HandleReserve_WholeSet(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t,
+		newSetWish("tent", "camping"), newSetWish("stove", "camping"), newSetWish("book", ""))
+	WithWholeSets()(srv)
+
+	rec := reserveWithKey(srv.Handler(), "tent", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "true", rec.Header().Get("HX-Refresh"))
+
+	tent := getFundWish(t, srv, "tent")
+	stove := getFundWish(t, srv, "stove")
+
+	assert.Equal(t, int32(1), tent.TotalReserved())
+	assert.Equal(t, int32(1), stove.TotalReserved(), "the rest of the set is reserved too")
+	assert.Equal(t, tent.Status.Reservations[0].TokenHash, stove.Status.Reservations[0].TokenHash)
+	assert.Zero(t, getFundWish(t, srv, "book").TotalReserved())
+}
+
+func TestServer_HandleReserve_WholeSetUnavailable(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	stove := newSetWish("stove", "camping")
+	stove.Spec.Quantity = 1
+	stove.Status.Reservations = []wishlistv1alpha1.Reservation{{
+		Quantity:  1,
+		CreatedAt: metav1.NewTime(now),
+		ExpiresAt: metav1.NewTime(now.Add(time.Hour)),
+	}}
+
+	srv := newTestServer(t, newSetWish("tent", "camping"), stove, newSetWish("lamp", "camping"))
+	WithWholeSets()(srv)
+
+	rec := reserveWithKey(srv.Handler(), "tent", "")
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Another wish of this set is no longer available")
+
+	assert.Zero(t, getFundWish(t, srv, "tent").TotalReserved())
+	assert.Zero(t, getFundWish(t, srv, "lamp").TotalReserved())
+}
+
+func TestServer_HandleReserve_SetReservedSeparatelyByDefault(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newSetWish("tent", "camping"), newSetWish("stove", "camping"))
+
+	rec := reserveWithKey(srv.Handler(), "tent", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("HX-Refresh"))
+
+	assert.Equal(t, int32(1), getFundWish(t, srv, "tent").TotalReserved())
+	assert.Zero(t, getFundWish(t, srv, "stove").TotalReserved())
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// IndexSlug registers the cache index that handleSlug looks wishes up by.
+func IndexSlug(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &wishlistv1alpha1.Wish{}, wishlistv1alpha1.SlugField, slugIndexValue); err != nil {
+		return fmt.Errorf("indexing wishes by slug: %w", err)
+	}
+
+	return nil
+}
+
+func slugIndexValue(obj client.Object) []string {
+	wish, ok := obj.(*wishlistv1alpha1.Wish)
+	if !ok || wish.Spec.Slug == "" {
+		return nil
+	}
+
+	return []string{wish.Spec.Slug}
+}
+
+// handleSlug serves the wish with the given slug, falling back to the wish
+// with that object name, so /w/ links work whether or not a slug is set.
+func (s *Server) handleSlug(w http.ResponseWriter, r *http.Request) {
+	lang := i18n.DetectLanguage(r)
+	slug := r.PathValue("slug")
+
+	wishList := &wishlistv1alpha1.WishList{}
+	if err := s.reader.List(r.Context(), wishList, client.InNamespace(s.namespaceFor(r.Context())),
+		client.MatchingFields{wishlistv1alpha1.SlugField: slug}); err != nil {
+		http.Error(w, i18n.T(lang, "err_list_wishes"), http.StatusInternalServerError)
+
+		return
+	}
+
+	if len(wishList.Items) == 0 {
+		r.SetPathValue("name", slug)
+		s.handleWish(w, r)
+
+		return
+	}
+
+	// The validating webhook rejects duplicate slugs, but wishes created
+	// without it, or two created at once, can still collide: then the older
+	// one keeps the link
+	wish := slices.MinFunc(wishList.Items, func(a, b wishlistv1alpha1.Wish) int {
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	s.serveWish(w, r, &wish)
+}
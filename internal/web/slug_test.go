@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func newSlugWish(name, slug string, created time.Time) *wishlistv1alpha1.Wish {
+	wish := newSummaryWish(name, 1, 0)
+	wish.CreationTimestamp = metav1.NewTime(created)
+	wish.Spec.Slug = slug
+
+	return wish
+}
+
+func getPublicWish(t *testing.T, handler http.Handler, path string) publicWish {
+	t.Helper()
+
+	rec := getPath(handler, path+"?format=json")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var wish publicWish
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&wish))
+
+	return wish
+}
+
+func TestServer_HandleSlug(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	srv := newTestServer(t,
+		newSlugWish("mechanical-keyboard-cherry-mx-brown", "keyboard", now),
+		newSlugWish("plain-gift", "", now))
+	handler := srv.Handler()
+
+	wish := getPublicWish(t, handler, "/w/keyboard")
+	assert.Equal(t, "mechanical-keyboard-cherry-mx-brown", wish.Name)
+	assert.Equal(t, "keyboard", wish.Slug)
+
+	assert.Equal(t, "plain-gift", getPublicWish(t, handler, "/w/plain-gift").Name,
+		"without a slug the object name is used")
+
+	assert.Equal(t, http.StatusNotFound, getPath(handler, "/w/missing").Code)
+}
+
+func TestServer_HandleSlug_CollisionKeepsOldest(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	srv := newTestServer(t,
+		newSlugWish("newer", "lamp", now),
+		newSlugWish("older", "lamp", now.Add(-time.Hour)))
+
+	assert.Equal(t, "older", getPublicWish(t, srv.Handler(), "/w/lamp").Name)
+}
+
+func TestServer_CardLinksToSlug(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newSlugWish("mechanical-keyboard", "keyboard", time.Now()), newSummaryWish("lamp", 1, 0))
+	body := getPath(srv.Handler(), "/").Body.String()
+
+	assert.Contains(t, body, `href="/w/keyboard"`)
+	assert.Contains(t, body, `href="/wishes/lamp"`)
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"embed"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path/filepath"
+)
+
+const (
+	staticCacheControl = "public, max-age=86400"
+	iconContentType    = "image/x-icon"
+	faviconName        = "favicon.ico"
+)
+
+//go:embed static
+var staticFiles embed.FS
+
+// staticFS returns the embedded static assets rooted at the static directory.
+func staticFS() fs.FS {
+	sub, err := fs.Sub(staticFiles, "static")
+	if err != nil {
+		// The directory is embedded at build time; failure here is a programming error.
+		panic(err)
+	}
+
+	return sub
+}
+
+// staticHandler serves embedded assets under /static/ with long-lived cache headers.
+func (s *Server) staticHandler() http.Handler {
+	files := http.StripPrefix("/static/", http.FileServerFS(staticFS()))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if filepath.Ext(r.URL.Path) == ".ico" {
+			w.Header().Set("Content-Type", iconContentType)
+		}
+
+		w.Header().Set("Cache-Control", staticCacheControl)
+		files.ServeHTTP(w, r)
+	})
+}
+
+// handleFavicon serves the configured favicon file, or the embedded default.
+func (s *Server) handleFavicon(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", staticCacheControl)
+
+	if s.faviconPath == "" {
+		w.Header().Set("Content-Type", iconContentType)
+		http.ServeFileFS(w, r, staticFS(), faviconName)
+
+		return
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(s.faviconPath))
+	if contentType == "" {
+		contentType = iconContentType
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	http.ServeFile(w, r, s.faviconPath)
+}
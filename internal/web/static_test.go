@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Favicon(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	handler := srv.Handler()
+
+	req := httptest.NewRequest(http.MethodGet, "/favicon.ico", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, iconContentType, rec.Header().Get("Content-Type"))
+	assert.Equal(t, staticCacheControl, rec.Header().Get("Cache-Control"))
+	assert.NotZero(t, rec.Body.Len())
+}
+
+func TestServer_Favicon_CustomPath(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "icon.png")
+	require.NoError(t, os.WriteFile(path, []byte("\x89PNG\r\n\x1a\n"), 0o600))
+
+	srv := newTestServer(t)
+	WithFaviconPath(path)(srv)
+
+	req := httptest.NewRequest(http.MethodGet, "/favicon.ico", nil)
+	rec := httptest.NewRecorder()
+
+	srv.Handler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+}
+
+func TestServer_StaticAssets(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	handler := srv.Handler()
+
+	req := httptest.NewRequest(http.MethodGet, "/static/favicon.ico", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, iconContentType, rec.Header().Get("Content-Type"))
+	assert.Equal(t, staticCacheControl, rec.Header().Get("Cache-Control"))
+
+	missing := httptest.NewRequest(http.MethodGet, "/static/missing.css", nil)
+	missingRec := httptest.NewRecorder()
+	handler.ServeHTTP(missingRec, missing)
+
+	assert.Equal(t, http.StatusNotFound, missingRec.Code)
+}
+
+func TestServer_StaticBypassesRateLimit(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	srv.rateLimit = 1
+	srv.rateBurst = 1
+
+	handler := srv.Handler()
+
+	for range 5 {
+		req := httptest.NewRequest(http.MethodGet, "/favicon.ico", nil)
+		req.RemoteAddr = "192.168.1.2:12345"
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+}
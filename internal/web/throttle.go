@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"golang.org/x/time/rate"
+
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// problemJSON is the media type of RFC 9457 problem details.
+const problemJSON = "application/problem+json"
+
+// problemDetails is an RFC 9457 problem details body, sent to API clients
+// in place of the plain text error browsers get.
+type problemDetails struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail"`
+}
+
+// wantsProblemJSON reports whether an error for r should be problem details
+// rather than plain text: for /api routes and clients asking for JSON.
+func wantsProblemJSON(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, "/api/") || wantsJSON(r) ||
+		strings.Contains(r.Header.Get("Accept"), problemJSON)
+}
+
+// retryAfterSeconds is how many whole seconds until limiter allows another
+// request, at least one.
+func retryAfterSeconds(limiter *rate.Limiter) int {
+	limit := float64(limiter.Limit())
+	if limit <= 0 || math.IsInf(limit, 1) {
+		return 1
+	}
+
+	return max(int(math.Ceil((1-limiter.Tokens())/limit)), 1)
+}
+
+// writeRateLimited refuses a request limiter has throttled with 429 and a
+// Retry-After header, as problem details for API clients and as localized
+// text otherwise.
+func writeRateLimited(w http.ResponseWriter, r *http.Request, limiter *rate.Limiter) {
+	lang := i18n.DetectLanguage(r)
+	detail := i18n.T(lang, "err_rate_limit")
+
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(limiter)))
+
+	if !wantsProblemJSON(r) {
+		http.Error(w, detail, http.StatusTooManyRequests)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", problemJSON)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusTooManyRequests)
+
+	_ = json.NewEncoder(w).Encode(problemDetails{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusTooManyRequests),
+		Status: http.StatusTooManyRequests,
+		Detail: detail,
+	})
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// throttled exhausts a one-request burst, then returns the refused response
+// to a second request built by newRequest.
+func throttled(t *testing.T, newRequest func() *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+
+	srv := newTestServer(t)
+	srv.rateLimit = 0.5
+	srv.rateBurst = 1
+
+	handler := srv.Handler()
+
+	first := httptest.NewRecorder()
+	handler.ServeHTTP(first, newRequest())
+	require.Equal(t, http.StatusOK, first.Code)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest())
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	return rec
+}
+
+func TestServer_RateLimited_ProblemJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		newRequest func() *http.Request
+	}{
+		{
+			name:       "api route",
+			newRequest: func() *http.Request { return httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil) },
+		},
+		{
+			name: "json list",
+			newRequest: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/wishes", nil)
+				req.Header.Set("Accept", "application/json")
+
+				return req
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := throttled(t, tt.newRequest)
+
+			assert.Equal(t, problemJSON, rec.Header().Get("Content-Type"))
+			assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+
+			var problem map[string]any
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+			assert.Equal(t, map[string]any{
+				"type":   "about:blank",
+				"title":  "Too Many Requests",
+				"status": float64(http.StatusTooManyRequests),
+				"detail": "Too many requests",
+			}, problem)
+		})
+	}
+}
+
+func TestServer_RateLimited_Text(t *testing.T) {
+	t.Parallel()
+
+	rec := throttled(t, func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept", "text/html")
+		req.Header.Set("Accept-Language", "ru")
+
+		return req
+	})
+
+	assert.Equal(t, "text/plain; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+	assert.Contains(t, rec.Body.String(), "Слишком много запросов")
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// WithRequestTimeout bounds how long a request may take. Handlers receive a
+// context with this deadline, so Kubernetes API calls and outbound requests
+// are cancelled once it passes, and the client gets 503. Zero disables it.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(s *Server) {
+		s.requestTimeout = timeout
+	}
+}
+
+// timeoutMiddleware applies the request timeout. Long-lived streaming
+// endpoints must be registered outside of it.
+func (s *Server) timeoutMiddleware(next http.Handler) http.Handler {
+	if s.requestTimeout <= 0 {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), s.requestTimeout)
+		defer cancel()
+
+		tw := &timeoutWriter{ResponseWriter: w, ctx: ctx, lang: i18n.DetectLanguage(r)}
+		next.ServeHTTP(tw, r.WithContext(ctx))
+
+		if !tw.wroteHeader && deadlineExceeded(ctx) {
+			tw.writeTimeout()
+		}
+	})
+}
+
+// timeoutWriter replaces error responses written after the deadline with a
+// 503 timeout, since the handler's own error only reflects the cancelled
+// context. Successful responses pass through unchanged.
+type timeoutWriter struct {
+	http.ResponseWriter
+
+	ctx         context.Context //nolint:containedctx // Checked on each write to detect the deadline
+	lang        string
+	wroteHeader bool
+	timedOut    bool
+}
+
+func (tw *timeoutWriter) WriteHeader(status int) {
+	if tw.wroteHeader {
+		return
+	}
+
+	if status >= http.StatusInternalServerError && deadlineExceeded(tw.ctx) {
+		tw.writeTimeout()
+
+		return
+	}
+
+	tw.wroteHeader = true
+	tw.ResponseWriter.WriteHeader(status)
+}
+
+func (tw *timeoutWriter) Write(b []byte) (int, error) {
+	if tw.timedOut {
+		// Swallow the handler's own error body; report it as written.
+		return len(b), nil
+	}
+
+	if !tw.wroteHeader {
+		tw.WriteHeader(http.StatusOK)
+	}
+
+	return tw.ResponseWriter.Write(b)
+}
+
+func (tw *timeoutWriter) writeTimeout() {
+	tw.wroteHeader = true
+	tw.timedOut = true
+
+	tw.ResponseWriter.Header().Del("Content-Length")
+	http.Error(tw.ResponseWriter, i18n.T(tw.lang, "err_timeout"), http.StatusServiceUnavailable)
+}
+
+func deadlineExceeded(ctx context.Context) bool {
+	return errors.Is(ctx.Err(), context.DeadlineExceeded)
+}
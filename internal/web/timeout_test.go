@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// newSlowServer returns a server whose List and Get calls take delay,
+// returning early with the context's error if it is cancelled first.
+func newSlowServer(t *testing.T, delay time.Duration, opts ...Option) *Server {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, wishlistv1alpha1.AddToScheme(scheme))
+
+	wait := func(ctx context.Context) error {
+		select {
+		case <-time.After(delay):
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(newIdempotencyWish("slow")).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				if err := wait(ctx); err != nil {
+					return err
+				}
+
+				return c.List(ctx, list, opts...)
+			},
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object,
+				opts ...client.GetOption,
+			) error {
+				if err := wait(ctx); err != nil {
+					return err
+				}
+
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+
+	return NewServer(fakeClient, testNamespace, 30, 10, opts...)
+}
+
+func TestServer_RequestTimeout_Exceeded(t *testing.T) {
+	t.Parallel()
+
+	srv := newSlowServer(t, time.Minute, WithRequestTimeout(50*time.Millisecond))
+
+	for _, path := range []string{"/wishes", "/wishes/slow"} {
+		t.Run(path, func(t *testing.T) {
+			t.Parallel()
+
+			started := time.Now()
+			rec := httptest.NewRecorder()
+			srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+			assert.Less(t, time.Since(started), 5*time.Second)
+			assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+			assert.Contains(t, rec.Body.String(), "took too long")
+			assert.NotContains(t, rec.Body.String(), "Failed")
+		})
+	}
+}
+
+func TestServer_RequestTimeout_NotExceeded(t *testing.T) {
+	t.Parallel()
+
+	srv := newSlowServer(t, 10*time.Millisecond, WithRequestTimeout(5*time.Second))
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/wishes/slow", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), testTitleGift)
+}
+
+func TestServer_RequestTimeout_Disabled(t *testing.T) {
+	t.Parallel()
+
+	srv := newSlowServer(t, 100*time.Millisecond)
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/wishes", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
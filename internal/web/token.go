@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+)
+
+const (
+	reserverCookie    = "wish_reserver"
+	maxReserverToken  = 64
+	reserverCookieAge = maxDays * 24 * 60 * 60 // outlives the longest reservation
+)
+
+// reserverTokenFromRequest returns the reserver token carried by the request
+// cookie, or an empty string if there is none or it looks malformed.
+func reserverTokenFromRequest(r *http.Request) string {
+	cookie, err := r.Cookie(reserverCookie)
+	if err != nil || cookie.Value == "" || len(cookie.Value) > maxReserverToken {
+		return ""
+	}
+
+	return cookie.Value
+}
+
+// ensureReserverToken returns the request's reserver token, issuing a new one
+// in a cookie if the visitor does not have one yet.
+func ensureReserverToken(w http.ResponseWriter, r *http.Request) string {
+	if token := reserverTokenFromRequest(r); token != "" {
+		return token
+	}
+
+	token := rand.Text()
+	http.SetCookie(w, &http.Cookie{
+		Name:     reserverCookie,
+		Value:    token,
+		Path:     "/",
+		MaxAge:   reserverCookieAge,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return token
+}
+
+// hashToken returns the hex SHA-256 digest stored on reservations.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// hashTokenIfSet is like hashToken but keeps an empty token empty, so
+// visitors without a cookie never match a stored hash.
+func hashTokenIfSet(token string) string {
+	if token == "" {
+		return ""
+	}
+
+	return hashToken(token)
+}
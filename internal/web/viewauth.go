@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/lexfrei/wish-operator/internal/i18n"
+)
+
+// WithViewPassword puts the whole site behind HTTP Basic Auth with a single
+// shared password, for lists meant only for people who were given it. Any
+// username is accepted. The /admin endpoints keep requiring the admin token
+// instead, since both schemes share the Authorization header. An empty
+// password leaves the site open.
+func WithViewPassword(password string) Option {
+	return func(s *Server) {
+		s.viewPassword = password
+	}
+}
+
+// requireViewPassword lets a request through only if it carries the view
+// password via Basic Auth, or is bound for /admin. It passes everything
+// through when no view password is configured.
+func (s *Server) requireViewPassword(next http.Handler) http.Handler {
+	if s.viewPassword == "" {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/admin/") {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		_, password, ok := r.BasicAuth()
+		if !ok || subtle.ConstantTimeCompare([]byte(password), []byte(s.viewPassword)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="wish-operator", charset="UTF-8"`)
+			http.Error(w, i18n.T(i18n.DetectLanguage(r), "err_unauthorized"), http.StatusUnauthorized)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testViewPassword = "family-only"
+
+func viewRequest(handler http.Handler, method, path, password string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(url.Values{"weeks": {"2"}}.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if password != "" {
+		req.SetBasicAuth("guest", password)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_ViewPassword_OpenByDefault(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("gift"))
+
+	rec := viewRequest(srv.Handler(), http.MethodGet, "/", "")
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("WWW-Authenticate"))
+}
+
+func TestServer_ViewPassword_RejectsWrongPassword(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("gift"))
+	WithViewPassword(testViewPassword)(srv)
+	handler := srv.Handler()
+
+	for _, tc := range []struct {
+		name, method, path, password string
+	}{
+		{"missing on index", http.MethodGet, "/", ""},
+		{"wrong on index", http.MethodGet, "/", "guess"},
+		{"wrong on reserve", http.MethodPost, "/wishes/gift/reserve", "guess"},
+		{"wrong on favicon", http.MethodGet, "/favicon.ico", "guess"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := viewRequest(handler, tc.method, tc.path, tc.password)
+
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+			assert.Contains(t, rec.Header().Get("WWW-Authenticate"), `Basic realm="wish-operator"`)
+		})
+	}
+}
+
+func TestServer_ViewPassword_AcceptsCorrectPassword(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("gift"))
+	WithViewPassword(testViewPassword)(srv)
+	handler := srv.Handler()
+
+	rec := viewRequest(handler, http.MethodGet, "/", testViewPassword)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = viewRequest(handler, http.MethodPost, "/wishes/gift/reserve", testViewPassword)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestServer_ViewPassword_AdminKeepsToken(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("gift"))
+	WithViewPassword(testViewPassword)(srv)
+	WithAdminToken(testAdminToken)(srv)
+
+	rec := adminRequest(t, srv, "/admin/summary", testAdminToken)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = viewRequest(srv.Handler(), http.MethodGet, "/admin/summary", testViewPassword)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Header().Get("WWW-Authenticate"), "Bearer")
+}
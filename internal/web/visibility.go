@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"net/http"
+	"time"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// WithReservationDelay shows reservations to the public only once they are
+// delay old, so a last-minute reservation doesn't give away who is about to
+// bring what. Until then the wish looks available, though reserving it is
+// still refused if nothing is actually left. Requests with the admin token
+// see every reservation right away, and givers always see their own.
+func WithReservationDelay(delay time.Duration) Option {
+	return func(s *Server) {
+		s.reservationDelay = delay
+	}
+}
+
+// delayReservations hides reservations made within the reservation delay
+// from a wish about to be shown to r, except the requester's own, and takes
+// them out of its demand so they don't earn the popular badge early either.
+// It must run before redactWish, which drops the token hashes it compares.
+func (s *Server) delayReservations(r *http.Request, wish *wishlistv1alpha1.Wish) {
+	if s.reservationDelay <= 0 || s.isAdmin(r) {
+		return
+	}
+
+	viewer := hashTokenIfSet(reserverTokenFromRequest(r))
+	cutoff := s.clock.Now().Add(-s.reservationDelay)
+	visible := make([]wishlistv1alpha1.Reservation, 0, len(wish.Status.Reservations))
+
+	for _, res := range wish.Status.Reservations {
+		if res.CreatedAt.After(cutoff) && (viewer == "" || res.TokenHash != viewer) {
+			continue
+		}
+
+		visible = append(visible, res)
+	}
+
+	// Status.Demand counts one per reservation
+	hidden := int32(len(wish.Status.Reservations) - len(visible)) //nolint:gosec // bounded by the object size limit
+	wish.Status.Demand = max(wish.Status.Demand-hidden, 0)
+	wish.Status.Reservations = visible
+}
+
+// delayListReservations applies delayReservations to every wish in the
+// slice.
+func (s *Server) delayListReservations(r *http.Request, wishes []wishlistv1alpha1.Wish) {
+	for i := range wishes {
+		s.delayReservations(r, &wishes[i])
+	}
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+const testGiverToken = "giver-token"
+
+// publicReserved returns how much of the wish the JSON view at path reports
+// as reserved to a request prepared by setup.
+func publicReserved(t *testing.T, handler http.Handler, path string, setup func(*http.Request)) int32 {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("Accept", "application/json")
+	setup(req)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, path)
+
+	if path == "/wishes" {
+		var items []publicWish
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &items))
+		require.Len(t, items, 1)
+
+		return items[0].Reserved
+	}
+
+	var item publicWish
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &item))
+
+	return item.Reserved
+}
+
+func TestServer_ReservationDelay(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+	clock := clocktesting.NewFakePassiveClock(now)
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	WithAdminToken(testAdminToken)(srv)
+	WithReservationDelay(time.Hour)(srv)
+	WithClock(clock)(srv)
+	srv.rateBurst = 100
+
+	handler := srv.Handler()
+	require.Equal(t, http.StatusOK, reserveAs(handler, "lamp", testGiverToken).Code)
+
+	public := func(*http.Request) {}
+	owner := func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+testAdminToken) }
+	wrongToken := func(r *http.Request) { r.Header.Set("Authorization", "Bearer wrong") }
+	giver := func(r *http.Request) { r.AddCookie(&http.Cookie{Name: reserverCookie, Value: testGiverToken}) }
+	otherGiver := func(r *http.Request) { r.AddCookie(&http.Cookie{Name: reserverCookie, Value: "someone-else"}) }
+
+	for _, path := range []string{"/wishes", "/wishes/lamp"} {
+		assert.Zero(t, publicReserved(t, handler, path, public), path)
+		assert.Zero(t, publicReserved(t, handler, path, wrongToken), path)
+		assert.Zero(t, publicReserved(t, handler, path, otherGiver), path)
+		assert.Equal(t, int32(1), publicReserved(t, handler, path, owner), path)
+		assert.Equal(t, int32(1), publicReserved(t, handler, path, giver), path)
+	}
+
+	assert.Contains(t, getPath(handler, "/wishes").Body.String(), `class="reserve-form"`,
+		"the wish still looks available")
+	assert.Len(t, getFundWish(t, srv, "lamp").Status.Reservations, 1, "the reservation is stored")
+
+	clock.SetTime(now.Add(time.Hour))
+
+	for _, path := range []string{"/wishes", "/wishes/lamp"} {
+		assert.Equal(t, int32(1), publicReserved(t, handler, path, public), path)
+	}
+}
+
+func TestServer_ReservationDelay_Disabled(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newIdempotencyWish("lamp"))
+	handler := srv.Handler()
+
+	require.Equal(t, http.StatusOK, reserveAs(handler, "lamp", testGiverToken).Code)
+	assert.Equal(t, int32(1), publicReserved(t, handler, "/wishes", func(*http.Request) {}))
+}
+
+func TestServer_ReservationDelay_HidesDemand(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+
+	wish := newIdempotencyWish("lamp")
+	wish.Status.Reservations = []wishlistv1alpha1.Reservation{
+		{Quantity: 1, CreatedAt: metav1.NewTime(now.Add(-2 * time.Hour)), ExpiresAt: metav1.NewTime(now.Add(time.Hour))},
+		{Quantity: 1, CreatedAt: metav1.NewTime(now.Add(-time.Minute)), ExpiresAt: metav1.NewTime(now.Add(time.Hour))},
+	}
+	wish.Status.Demand = 2
+
+	srv := newTestServer(t, wish)
+	WithAdminToken(testAdminToken)(srv)
+	WithPopularThreshold(2)(srv)
+	WithClock(clocktesting.NewFakePassiveClock(now))(srv)
+
+	handler := srv.Handler()
+	assert.Contains(t, getPath(handler, "/wishes").Body.String(), `class="popular-badge"`)
+
+	WithReservationDelay(time.Hour)(srv)
+
+	assert.NotContains(t, getPath(handler, "/wishes").Body.String(), `class="popular-badge"`,
+		"a fresh reservation doesn't earn the badge before it shows")
+	assert.NotContains(t, getPath(handler, "/wishes/lamp").Body.String(), `class="popular-badge"`)
+
+	req := httptest.NewRequest(http.MethodGet, "/wishes", nil)
+	req.Header.Set("Authorization", "Bearer "+testAdminToken)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Contains(t, rec.Body.String(), `class="popular-badge"`, "the admin sees every reservation")
+}
+
+func TestServer_ReservationDelay_GroupCard(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, newGroupWish("tv"))
+	WithReservationDelay(time.Hour)(srv)
+
+	handler := srv.Handler()
+
+	rec := groupPost(handler, "/wishes/tv/reserve", "alice")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "reserved until", "the coordinator sees their own reservation")
+
+	rec = groupPost(handler, "/wishes/tv/reserve", "bob")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "1 joined")
+	assert.NotContains(t, rec.Body.String(), "reserved until", "a pledger doesn't see the coordinator's fresh reservation")
+
+	rec = groupPost(handler, "/wishes/tv/close-group", "alice")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "reserved until")
+}
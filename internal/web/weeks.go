@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// defaultWeeks is preselected in the reserve form for wishes whose priority
+// has no configured default.
+const defaultWeeks = 4
+
+// ParsePriorityWeeks parses a comma-separated list of priority=weeks pairs,
+// e.g. "5=1,4=2", setting the reserve form's default weeks per wish priority.
+// An empty string yields no mapping.
+func ParsePriorityWeeks(value string) (map[int32]int, error) {
+	mapping := make(map[int32]int)
+
+	for pair := range strings.SplitSeq(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		priorityStr, weeksStr, found := strings.Cut(pair, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid priority weeks %q: want priority=weeks", pair)
+		}
+
+		priority, err := strconv.Atoi(strings.TrimSpace(priorityStr))
+		if err != nil || priority < minPriority || priority > maxPriority {
+			return nil, fmt.Errorf("invalid priority %q: want %d to %d", priorityStr, minPriority, maxPriority)
+		}
+
+		weeks, err := strconv.Atoi(strings.TrimSpace(weeksStr))
+		if err != nil || weeks < minWeeks || weeks > maxWeeks {
+			return nil, fmt.Errorf("invalid weeks %q for priority %d: want %d to %d",
+				weeksStr, priority, minWeeks, maxWeeks)
+		}
+
+		mapping[int32(priority)] = weeks
+	}
+
+	return mapping, nil
+}
+
+// WithPriorityWeeks sets the reserve form's default weeks per wish priority,
+// e.g. shorter holds for high-priority wishes so they come back sooner if the
+// giver stalls. Other priorities keep the usual default.
+func WithPriorityWeeks(mapping map[int32]int) Option {
+	return func(s *Server) {
+		s.priorityWeeks = mapping
+	}
+}
+
+// suggestWeeks returns the weeks preselected when reserving the wish, kept
+// within the accepted range.
+func (s *Server) suggestWeeks(wish *wishlistv1alpha1.Wish) int {
+	weeks, ok := s.priorityWeeks[wish.Spec.Priority]
+	if !ok {
+		return defaultWeeks
+	}
+
+	return min(max(weeks, minWeeks), maxWeeks)
+}
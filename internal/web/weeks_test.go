@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePriorityWeeks(t *testing.T) {
+	t.Parallel()
+
+	mapping, err := ParsePriorityWeeks(" 5=1, 4 = 2 ,1=8,")
+	require.NoError(t, err)
+	assert.Equal(t, map[int32]int{5: 1, 4: 2, 1: 8}, mapping)
+
+	mapping, err = ParsePriorityWeeks("")
+	require.NoError(t, err)
+	assert.Empty(t, mapping)
+
+	for _, value := range []string{"5", "=1", "5=", "0=2", "6=2", "5=0", "5=9", "high=1"} {
+		_, err := ParsePriorityWeeks(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestServer_SuggestWeeks(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+	WithPriorityWeeks(map[int32]int{5: 1, 4: 2, 1: 8, 2: 12, 3: -1})(srv)
+
+	tests := []struct {
+		priority int32
+		want     int
+	}{
+		{0, defaultWeeks},
+		{1, 8},
+		{2, maxWeeks},
+		{3, minWeeks},
+		{4, 2},
+		{5, 1},
+		{6, defaultWeeks},
+	}
+
+	for _, tt := range tests {
+		wish := newIdempotencyWish("lamp")
+		wish.Spec.Priority = tt.priority
+
+		assert.Equal(t, tt.want, srv.suggestWeeks(wish), "priority %d", tt.priority)
+	}
+
+	assert.Equal(t, defaultWeeks, newTestServer(t).suggestWeeks(newIdempotencyWish("lamp")))
+}
+
+func TestServer_ReserveForm_SuggestedWeeks(t *testing.T) {
+	t.Parallel()
+
+	wish := newIdempotencyWish("lamp")
+	wish.Spec.Priority = 5
+
+	plain := newTestServer(t, wish.DeepCopy())
+	assert.Contains(t, getPath(plain.Handler(), "/").Body.String(), `<option value="4" selected>`)
+
+	srv := newTestServer(t, wish)
+	WithPriorityWeeks(map[int32]int{5: 1})(srv)
+
+	body := getPath(srv.Handler(), "/").Body.String()
+	assert.Contains(t, body, `<option value="1" selected>`)
+	assert.NotContains(t, body, `<option value="4" selected>`)
+}
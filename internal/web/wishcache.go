@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// staleHeader marks responses served from the last known wish list because
+// the Kubernetes API could not be reached.
+const staleHeader = "X-Wishlist-Stale"
+
+// wishCache keeps the last successfully listed wishes so pages can still be
+// served while the Kubernetes API is briefly unreachable.
+type wishCache struct {
+	mu      sync.RWMutex
+	items   []wishlistv1alpha1.Wish
+	fetched time.Time
+}
+
+// store remembers a deep copy of items as the latest good list.
+func (c *wishCache) store(items []wishlistv1alpha1.Wish) {
+	list := (&wishlistv1alpha1.WishList{Items: items}).DeepCopy()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = list.Items
+	c.fetched = time.Now()
+}
+
+// load returns a deep copy of the cached list if it is no older than maxAge.
+func (c *wishCache) load(maxAge time.Duration) ([]wishlistv1alpha1.Wish, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.fetched.IsZero() || time.Since(c.fetched) > maxAge {
+		return nil, false
+	}
+
+	return (&wishlistv1alpha1.WishList{Items: c.items}).DeepCopy().Items, true
+}
+
+// listAllWishes lists every wish in the request's namespace. If the live list fails and
+// the stale cache is enabled, it falls back to the last good list within the
+// staleness bound and reports stale as true.
+func (s *Server) listAllWishes(ctx context.Context) ([]wishlistv1alpha1.Wish, bool, error) {
+	wishList := &wishlistv1alpha1.WishList{}
+	namespace := s.namespaceFor(ctx)
+
+	err := s.reader.List(ctx, wishList, client.InNamespace(namespace))
+	if err == nil {
+		if s.staleMaxAge > 0 {
+			s.cacheFor(namespace).store(wishList.Items)
+		}
+
+		return wishList.Items, false, nil
+	}
+
+	if s.staleMaxAge > 0 {
+		if items, ok := s.cacheFor(namespace).load(s.staleMaxAge); ok {
+			return items, true, nil
+		}
+	}
+
+	return nil, false, err
+}
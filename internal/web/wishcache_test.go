@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+var errAPIUnavailable = errors.New("api server unavailable")
+
+// newFlakyServer returns a server whose List calls fail while the returned
+// flag is set.
+func newFlakyServer(t *testing.T, maxAge time.Duration, wishes ...*wishlistv1alpha1.Wish) (*Server, *atomic.Bool) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, wishlistv1alpha1.AddToScheme(scheme))
+
+	objs := make([]client.Object, len(wishes))
+	for i, w := range wishes {
+		objs[i] = w
+	}
+
+	failing := &atomic.Bool{}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				if failing.Load() {
+					return errAPIUnavailable
+				}
+
+				return c.List(ctx, list, opts...)
+			},
+		}).
+		Build()
+
+	return NewServer(fakeClient, testNamespace, 30, 10, WithStaleCache(maxAge)), failing
+}
+
+func getWishes(srv *Server) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/wishes", nil))
+
+	return rec
+}
+
+func TestServer_StaleCache_ServesLastGoodList(t *testing.T) {
+	t.Parallel()
+
+	srv, failing := newFlakyServer(t, time.Minute, newSummaryWish("cached-gift", 1, 0))
+
+	fresh := getWishes(srv)
+	require.Equal(t, http.StatusOK, fresh.Code)
+	assert.Empty(t, fresh.Header().Get(staleHeader))
+	assert.NotContains(t, fresh.Body.String(), "stale-banner")
+
+	failing.Store(true)
+
+	stale := getWishes(srv)
+	require.Equal(t, http.StatusOK, stale.Code)
+	assert.Equal(t, "true", stale.Header().Get(staleHeader))
+	assert.Contains(t, stale.Body.String(), "stale-banner")
+	assert.Contains(t, stale.Body.String(), "wish-cached-gift")
+}
+
+func TestServer_StaleCache_TooStale(t *testing.T) {
+	t.Parallel()
+
+	srv, failing := newFlakyServer(t, time.Minute, newSummaryWish("old-gift", 1, 0))
+
+	require.Equal(t, http.StatusOK, getWishes(srv).Code)
+
+	// Age the cached list past the staleness bound.
+	srv.wishCache.mu.Lock()
+	srv.wishCache.fetched = time.Now().Add(-2 * time.Minute)
+	srv.wishCache.mu.Unlock()
+
+	failing.Store(true)
+
+	assert.Equal(t, http.StatusInternalServerError, getWishes(srv).Code)
+}
+
+func TestServer_StaleCache_Disabled(t *testing.T) {
+	t.Parallel()
+
+	srv, failing := newFlakyServer(t, 0, newSummaryWish("gift", 1, 0))
+
+	require.Equal(t, http.StatusOK, getWishes(srv).Code)
+
+	failing.Store(true)
+
+	assert.Equal(t, http.StatusInternalServerError, getWishes(srv).Code)
+}
+
+func TestWishCache_ReturnsCopies(t *testing.T) {
+	t.Parallel()
+
+	var cache wishCache
+
+	cache.store([]wishlistv1alpha1.Wish{*newNotedWish("noted")})
+
+	first, ok := cache.load(time.Minute)
+	require.True(t, ok)
+	anonymizeWishes(first)
+
+	second, ok := cache.load(time.Minute)
+	require.True(t, ok)
+	assert.Equal(t, testSecretNote, second[0].Status.Reservations[0].Note, "callers must not mutate the cache")
+}
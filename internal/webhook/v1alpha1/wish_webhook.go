@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+// SetupWishWebhookWithManager registers the Wish defaulting and validating
+// webhooks with the manager's webhook server. New wishes without a priority
+// get defaultPriority; 0 leaves them unset. Wishes are validated with opts,
+// and their slugs against the other wishes read through the manager's client.
+func SetupWishWebhookWithManager(mgr ctrl.Manager, defaultPriority int32, opts wishlistv1alpha1.ValidationOptions) error {
+	return ctrl.NewWebhookManagedBy(mgr, &wishlistv1alpha1.Wish{}).
+		WithDefaulter(&WishDefaulter{Priority: defaultPriority}).
+		WithValidator(&WishValidator{Reader: mgr.GetClient(), Options: opts}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-wishlist-k8s-lex-la-v1alpha1-wish,mutating=true,failurePolicy=ignore,sideEffects=None,groups=wishlist.k8s.lex.la,resources=wishes,verbs=create;update,versions=v1alpha1,name=mwish-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// WishDefaulter fills defaults on Wishes as they are created or updated.
+// It fails open: a wish it cannot improve is admitted unchanged.
+type WishDefaulter struct {
+	// Priority is given to wishes created without one, unless they carry
+	// the explicit-priority annotation. 0 disables it.
+	Priority int32
+}
+
+// Default populates the structured price from a legacy MSRP string when the
+// structured fields are empty, so wishes written before they existed sort
+// and display like new ones. A zero TTL is dropped, as it means the same as
+// none. On creation it also fills in a missing priority.
+func (d *WishDefaulter) Default(ctx context.Context, wish *wishlistv1alpha1.Wish) error {
+	log := logf.FromContext(ctx)
+
+	if wish.DefaultPrice() {
+		log.V(1).Info("Defaulted structured price from MSRP",
+			"wish", wish.Name, "msrp", wish.Spec.MSRP, "priceMin", *wish.Spec.PriceMin, "currency", wish.Spec.Currency)
+	}
+
+	if wish.DefaultTTL() {
+		log.V(1).Info("Dropped zero TTL", "wish", wish.Name)
+	}
+
+	// Only new wishes: clearing the priority of an existing one is a choice.
+	if req, err := admission.RequestFromContext(ctx); err == nil && req.Operation != admissionv1.Create {
+		return nil
+	}
+
+	if wish.DefaultPriority(d.Priority) {
+		log.V(1).Info("Defaulted priority", "wish", wish.Name, "priority", wish.Spec.Priority)
+	}
+
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-wishlist-k8s-lex-la-v1alpha1-wish,mutating=false,failurePolicy=fail,sideEffects=None,groups=wishlist.k8s.lex.la,resources=wishes,verbs=create;update,versions=v1alpha1,name=vwish-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// WishValidator rejects Wishes whose spec breaks the deployment's rules, such
+// as the configured text length limits or shop domain allowlist, beyond what
+// the CRD schema checks, and wishes whose slug another wish of the namespace
+// already uses.
+type WishValidator struct {
+	// Reader lists the namespace's wishes to find slug collisions. Nil only
+	// checks that slugs are DNS labels.
+	Reader client.Reader
+
+	Options wishlistv1alpha1.ValidationOptions
+}
+
+// ValidateCreate validates a new wish.
+func (v *WishValidator) ValidateCreate(ctx context.Context, wish *wishlistv1alpha1.Wish) (admission.Warnings, error) {
+	return nil, v.validate(ctx, wish)
+}
+
+// ValidateUpdate validates a wish whose spec changed. Updates that leave the
+// spec alone, such as the controller's label changes, are always admitted,
+// so tightening a limit never blocks wishes that already exist.
+func (v *WishValidator) ValidateUpdate(ctx context.Context, oldWish, wish *wishlistv1alpha1.Wish) (admission.Warnings, error) {
+	if equality.Semantic.DeepEqual(oldWish.Spec, wish.Spec) {
+		return nil, nil
+	}
+
+	return nil, v.validate(ctx, wish)
+}
+
+// ValidateDelete admits every deletion.
+func (v *WishValidator) ValidateDelete(context.Context, *wishlistv1alpha1.Wish) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *WishValidator) validate(ctx context.Context, wish *wishlistv1alpha1.Wish) error {
+	errs := wish.Validate(v.Options)
+
+	slugErrs, err := v.validateSlug(ctx, wish)
+	if err != nil {
+		return err
+	}
+
+	errs = append(errs, slugErrs...)
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(wishlistv1alpha1.GroupVersion.WithKind("Wish").GroupKind(), wish.Name, errs)
+}
+
+// validateSlug checks the wish's slug against every other wish of its
+// namespace. Two wishes created at the same moment can still both pass; the
+// permalink then goes to the older one.
+func (v *WishValidator) validateSlug(ctx context.Context, wish *wishlistv1alpha1.Wish) (field.ErrorList, error) {
+	if wish.Spec.Slug == "" || v.Reader == nil {
+		return wish.ValidateSlug(nil), nil
+	}
+
+	wishList := &wishlistv1alpha1.WishList{}
+	if err := v.Reader.List(ctx, wishList, client.InNamespace(wish.Namespace)); err != nil {
+		return nil, fmt.Errorf("listing wishes to check the slug: %w", err)
+	}
+
+	return wish.ValidateSlug(wishList.Items), nil
+}
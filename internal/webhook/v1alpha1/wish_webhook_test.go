@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Aleksei Sviridkin
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	wishlistv1alpha1 "github.com/lexfrei/wish-operator/api/v1alpha1"
+)
+
+func TestWishDefaulter_Default(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		spec         wishlistv1alpha1.WishSpec
+		wantPriceMin *int64
+		wantCurrency string
+	}{
+		{
+			name:         "legacy msrp",
+			spec:         wishlistv1alpha1.WishSpec{MSRP: "₽ 19900"},
+			wantPriceMin: ptr.To[int64](19900),
+			wantCurrency: "₽",
+		},
+		{
+			name:         "structured price kept",
+			spec:         wishlistv1alpha1.WishSpec{MSRP: "₽ 19900", PriceMin: ptr.To[int64](15000), Currency: "RUB"},
+			wantPriceMin: ptr.To[int64](15000),
+			wantCurrency: "RUB",
+		},
+		{
+			name: "unreadable msrp left alone",
+			spec: wishlistv1alpha1.WishSpec{MSRP: "depends on size"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wish := &wishlistv1alpha1.Wish{Spec: tt.spec}
+			require.NoError(t, (&WishDefaulter{}).Default(context.Background(), wish))
+
+			assert.Equal(t, tt.wantPriceMin, wish.Spec.PriceMin)
+			assert.Equal(t, tt.wantCurrency, wish.Spec.Currency)
+		})
+	}
+}
+
+func TestWishDefaulter_DefaultPriority(t *testing.T) {
+	t.Parallel()
+
+	defaulter := &WishDefaulter{Priority: 3}
+
+	admit := func(operation admissionv1.Operation) context.Context {
+		return admission.NewContextWithRequest(context.Background(),
+			admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: operation}})
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		wish *wishlistv1alpha1.Wish
+		want int32
+	}{
+		{name: "unset on create", ctx: admit(admissionv1.Create), wish: &wishlistv1alpha1.Wish{}, want: 3},
+		{
+			name: "explicit priority kept",
+			ctx:  admit(admissionv1.Create),
+			wish: &wishlistv1alpha1.Wish{Spec: wishlistv1alpha1.WishSpec{Priority: 1}},
+			want: 1,
+		},
+		{
+			name: "annotated zero kept",
+			ctx:  admit(admissionv1.Create),
+			wish: &wishlistv1alpha1.Wish{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{wishlistv1alpha1.AnnotationExplicitPriority: "true"},
+			}},
+			want: 0,
+		},
+		{name: "unset on update", ctx: admit(admissionv1.Update), wish: &wishlistv1alpha1.Wish{}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.NoError(t, defaulter.Default(tt.ctx, tt.wish))
+			assert.Equal(t, tt.want, tt.wish.Spec.Priority)
+		})
+	}
+
+	wish := &wishlistv1alpha1.Wish{}
+	require.NoError(t, (&WishDefaulter{}).Default(admit(admissionv1.Create), wish))
+	assert.Zero(t, wish.Spec.Priority, "no default configured")
+}
+
+func TestWishDefaulter_DefaultTTL(t *testing.T) {
+	t.Parallel()
+
+	wish := &wishlistv1alpha1.Wish{Spec: wishlistv1alpha1.WishSpec{TTL: &metav1.Duration{}}}
+	require.NoError(t, (&WishDefaulter{}).Default(context.Background(), wish))
+	assert.Nil(t, wish.Spec.TTL, "a zero TTL is dropped")
+
+	wish = &wishlistv1alpha1.Wish{Spec: wishlistv1alpha1.WishSpec{TTL: &metav1.Duration{Duration: time.Second}}}
+	require.NoError(t, (&WishDefaulter{}).Default(context.Background(), wish))
+	assert.Equal(t, &metav1.Duration{Duration: time.Second}, wish.Spec.TTL)
+}
+
+func TestWishValidator_Lengths(t *testing.T) {
+	t.Parallel()
+
+	validator := &WishValidator{Options: wishlistv1alpha1.ValidationOptions{
+		Lengths: wishlistv1alpha1.LengthLimits{MaxTitle: 5, MaxDescription: 10},
+	}}
+
+	//nolint:gosmopolitan // CJK input is the point of the test
+	valid := &wishlistv1alpha1.Wish{Spec: wishlistv1alpha1.WishSpec{Title: "愿望清单好"}}
+	_, err := validator.ValidateCreate(context.Background(), valid)
+	require.NoError(t, err, "counted in runes, not bytes")
+
+	//nolint:gosmopolitan // CJK input is the point of the test
+	long := &wishlistv1alpha1.Wish{
+		ObjectMeta: metav1.ObjectMeta{Name: "long"},
+		Spec:       wishlistv1alpha1.WishSpec{Title: "愿望清单好吗"},
+	}
+	_, err = validator.ValidateCreate(context.Background(), long)
+	require.Error(t, err)
+	assert.True(t, apierrors.IsInvalid(err))
+	assert.Contains(t, err.Error(), "spec.title")
+
+	_, err = validator.ValidateUpdate(context.Background(), valid, long)
+	require.Error(t, err, "a spec change is validated")
+
+	relabeled := long.DeepCopy()
+	relabeled.Labels = map[string]string{"state": "reserved"}
+	_, err = validator.ValidateUpdate(context.Background(), long, relabeled)
+	require.NoError(t, err, "an unchanged spec is admitted")
+
+	_, err = validator.ValidateDelete(context.Background(), long)
+	require.NoError(t, err)
+}
+
+func TestWishValidator_URLDomains(t *testing.T) {
+	t.Parallel()
+
+	validator := &WishValidator{Options: wishlistv1alpha1.ValidationOptions{AllowedURLDomains: []string{"example.com"}}}
+
+	allowed := &wishlistv1alpha1.Wish{Spec: wishlistv1alpha1.WishSpec{
+		Title:        "Lamp",
+		OfficialURL:  "https://example.com/lamp",
+		PurchaseURLs: []string{"https://shop.example.com/lamp"},
+	}}
+	_, err := validator.ValidateCreate(context.Background(), allowed)
+	require.NoError(t, err, "subdomains are allowed")
+
+	disallowed := allowed.DeepCopy()
+	disallowed.Spec.PurchaseURLs = append(disallowed.Spec.PurchaseURLs, "https://badexample.com/lamp")
+	_, err = validator.ValidateCreate(context.Background(), disallowed)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `domain "badexample.com" is not in the allowed list`)
+
+	_, err = (&WishValidator{}).ValidateCreate(context.Background(), disallowed)
+	require.NoError(t, err, "an empty allowlist allows every domain")
+}
+
+func TestWishValidator_Slug(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, wishlistv1alpha1.AddToScheme(scheme))
+
+	slugWish := func(namespace, name, slug string) *wishlistv1alpha1.Wish {
+		return &wishlistv1alpha1.Wish{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       wishlistv1alpha1.WishSpec{Title: "Lamp", Slug: slug},
+		}
+	}
+
+	reader := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(slugWish("alice", "lamp", "lamp"), slugWish("bob", "lamp", "desk")).
+		Build()
+	validator := &WishValidator{Reader: reader}
+
+	tests := []struct {
+		name    string
+		wish    *wishlistv1alpha1.Wish
+		wantErr string
+	}{
+		{name: "unique", wish: slugWish("alice", "desk", "desk")},
+		{name: "no slug", wish: slugWish("alice", "chair", "")},
+		{name: "taken", wish: slugWish("alice", "lamp-2", "lamp"), wantErr: "Duplicate value"},
+		{name: "own slug kept", wish: slugWish("alice", "lamp", "lamp")},
+		{name: "other namespace", wish: slugWish("bob", "lamp-2", "lamp")},
+		{name: "not a DNS label", wish: slugWish("alice", "desk", "Desk_1"), wantErr: "spec.slug"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := validator.ValidateCreate(context.Background(), tt.wish)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.True(t, apierrors.IsInvalid(err))
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestWishValidator_Price(t *testing.T) {
+	t.Parallel()
+
+	validator := &WishValidator{}
+
+	ranged := &wishlistv1alpha1.Wish{Spec: wishlistv1alpha1.WishSpec{
+		Title:    "Lamp",
+		PriceMin: ptr.To[int64](1500),
+		PriceMax: ptr.To[int64](2500),
+	}}
+	_, err := validator.ValidateCreate(context.Background(), ranged)
+	require.NoError(t, err)
+
+	inverted := ranged.DeepCopy()
+	inverted.Spec.PriceMin = ptr.To[int64](3000)
+	_, err = validator.ValidateCreate(context.Background(), inverted)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.priceMin")
+
+	fund := &wishlistv1alpha1.Wish{Spec: wishlistv1alpha1.WishSpec{Title: "Bike", Fund: true}}
+	_, err = validator.ValidateUpdate(context.Background(), ranged, fund)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.fundTarget")
+}
+
+func TestWishValidator_Links(t *testing.T) {
+	t.Parallel()
+
+	validator := &WishValidator{}
+
+	wish := &wishlistv1alpha1.Wish{Spec: wishlistv1alpha1.WishSpec{
+		Title: "Lamp",
+		Links: []wishlistv1alpha1.Link{{Label: "Review", URL: "https://reviews.example.com/lamp"}},
+	}}
+	_, err := validator.ValidateCreate(context.Background(), wish)
+	require.NoError(t, err)
+
+	wish.Spec.Links = append(wish.Spec.Links, wishlistv1alpha1.Link{URL: "https://"})
+	_, err = validator.ValidateCreate(context.Background(), wish)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.links[1].url")
+}